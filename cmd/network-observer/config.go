@@ -1,14 +1,23 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/auth"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/internal/kube/client"
 	"github.com/skupperproject/skupper/internal/utils/tlscfg"
+	"sigs.k8s.io/yaml"
 )
 
 type Config struct {
@@ -24,7 +33,25 @@ type Config struct {
 	RouterTLS     TLSSpec
 	FlowRecordTTL time.Duration
 
+	NetworkName    string
+	NetworksConfig string
+
 	VanflowLoggingProfile string
+	VanflowReplay         string
+	VanflowReplaySpeed    float64
+
+	SLOConfig string
+
+	AnomalyThreshold      float64
+	AnomalyLearningPeriod time.Duration
+
+	PolicyConfig string
+
+	AuthConfig string
+
+	AlertWebhookURL       string
+	AlertLinkDownFor      time.Duration
+	AlertLinkFlapsPerHour int
 
 	EnableProfile bool
 	CORSAllowAll  bool
@@ -80,3 +107,202 @@ func parsePrometheusAPI(base string) (*url.URL, error) {
 	targetPromAPI = targetPromAPI.JoinPath("/api/v1/")
 	return targetPromAPI, nil
 }
+
+// sloConfig is the format of the file service level objectives are
+// declared in
+type sloConfig struct {
+	Objectives []struct {
+		Name             string  `json:"name"`
+		Address          string  `json:"address"`
+		Objective        float64 `json:"objective"`
+		Window           string  `json:"window"`
+		LatencyThreshold string  `json:"latencyThreshold,omitempty"`
+	} `json:"objectives"`
+}
+
+func loadServiceLevelObjectives(path string) ([]collector.ServiceLevelObjective, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config sloConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	objectives := make([]collector.ServiceLevelObjective, 0, len(config.Objectives))
+	names := make(map[[2]string]struct{}, len(config.Objectives))
+	for i, in := range config.Objectives {
+		objective := collector.ServiceLevelObjective{
+			Name:      in.Name,
+			Address:   in.Address,
+			Objective: in.Objective,
+		}
+		if objective.Window, err = parseWindow(in.Window); err != nil {
+			return nil, fmt.Errorf("objective %d: invalid window: %s", i, err)
+		}
+		if in.LatencyThreshold != "" {
+			if objective.LatencyThreshold, err = time.ParseDuration(in.LatencyThreshold); err != nil {
+				return nil, fmt.Errorf("objective %d: invalid latencyThreshold: %s", i, err)
+			}
+		}
+		if err := objective.Validate(); err != nil {
+			return nil, fmt.Errorf("objective %d: %s", i, err)
+		}
+		key := [2]string{objective.Address, objective.Name}
+		if _, ok := names[key]; ok {
+			return nil, fmt.Errorf("objective %d: name %q already used for address %q", i, objective.Name, objective.Address)
+		}
+		names[key] = struct{}{}
+		objectives = append(objectives, objective)
+	}
+	return objectives, nil
+}
+
+// parseWindow parses a duration, also accepting a whole number of days such
+// as 28d
+func parseWindow(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// policyConfig is the format of the file the communication allowed between
+// sites is declared in
+type policyConfig struct {
+	Allow []struct {
+		From        string   `json:"from"`
+		To          string   `json:"to"`
+		RoutingKeys []string `json:"routingKeys,omitempty"`
+	} `json:"allow"`
+}
+
+func loadPolicy(path string) (*collector.Policy, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config policyConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	policy := &collector.Policy{
+		Allow: make([]collector.PolicyRule, 0, len(config.Allow)),
+	}
+	for i, in := range config.Allow {
+		rule := collector.PolicyRule{
+			From:        in.From,
+			To:          in.To,
+			RoutingKeys: in.RoutingKeys,
+		}
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %s", i, err)
+		}
+		policy.Allow = append(policy.Allow, rule)
+	}
+	return policy, nil
+}
+
+// authConfig is the format of the file the authentication of API requests
+// and the roles granting users access to namespaces are declared in
+type authConfig struct {
+	Htpasswd *struct {
+		File  string `json:"file"`
+		Realm string `json:"realm,omitempty"`
+	} `json:"htpasswd,omitempty"`
+	OIDC *struct {
+		Issuer        string `json:"issuer"`
+		ClientID      string `json:"clientID"`
+		UsernameClaim string `json:"usernameClaim,omitempty"`
+		GroupsClaim   string `json:"groupsClaim,omitempty"`
+		CA            string `json:"ca,omitempty"`
+	} `json:"oidc,omitempty"`
+	TokenReview *struct {
+		Audiences  []string `json:"audiences,omitempty"`
+		Kubeconfig string   `json:"kubeconfig,omitempty"`
+		CacheTTL   string   `json:"cacheTTL,omitempty"`
+	} `json:"tokenReview,omitempty"`
+	Roles []auth.Role `json:"roles,omitempty"`
+}
+
+func loadAuth(ctx context.Context, logger *slog.Logger, path string) (*auth.Auth, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config authConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	for i, role := range config.Roles {
+		if err := role.Validate(); err != nil {
+			return nil, fmt.Errorf("role %d: %s", i, err)
+		}
+	}
+
+	var authenticators []auth.Authenticator
+	if in := config.Htpasswd; in != nil {
+		realm := in.Realm
+		if realm == "" {
+			realm = "network-observer"
+		}
+		htpasswd, err := auth.LoadHtpasswd(in.File, realm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load htpasswd: %s", err)
+		}
+		authenticators = append(authenticators, htpasswd)
+	}
+	if in := config.OIDC; in != nil {
+		if in.Issuer == "" || in.ClientID == "" {
+			return nil, fmt.Errorf("oidc: issuer and clientID are required")
+		}
+		tlsConfig, err := TLSSpec{CA: in.CA}.config()
+		if err != nil {
+			return nil, fmt.Errorf("oidc: failed to load ca: %s", err)
+		}
+		oidc, err := auth.NewOIDC(ctx, auth.OIDCConfig{
+			Issuer:        in.Issuer,
+			ClientID:      in.ClientID,
+			UsernameClaim: in.UsernameClaim,
+			GroupsClaim:   in.GroupsClaim,
+			Client: &http.Client{
+				Timeout:   10 * time.Second,
+				Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("oidc: %s", err)
+		}
+		authenticators = append(authenticators, oidc)
+	}
+	if in := config.TokenReview; in != nil {
+		cacheTTL := time.Minute
+		if in.CacheTTL != "" {
+			if cacheTTL, err = time.ParseDuration(in.CacheTTL); err != nil {
+				return nil, fmt.Errorf("tokenReview: invalid cacheTTL: %s", err)
+			}
+		}
+		cli, err := client.NewClient("", "", in.Kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("tokenReview: error creating kubernetes client: %s", err)
+		}
+		authenticators = append(authenticators, auth.NewTokenReview(cli.Kube, in.Audiences, cacheTTL))
+	}
+	if len(authenticators) == 0 {
+		return nil, fmt.Errorf("at least one of htpasswd, oidc or tokenReview is required")
+	}
+	return auth.New(logger, authenticators, config.Roles), nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadServiceLevelObjectives(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   string
+		Expected []collector.ServiceLevelObjective
+		Err      string
+	}{
+		{
+			Name: "valid",
+			Config: `
+objectives:
+- name: availability
+  address: backend
+  objective: 99.9
+  window: 28d
+- name: latency
+  address: backend
+  objective: 95
+  window: 1h
+  latencyThreshold: 200ms
+`,
+			Expected: []collector.ServiceLevelObjective{
+				{Name: "availability", Address: "backend", Objective: 99.9, Window: 28 * 24 * time.Hour},
+				{Name: "latency", Address: "backend", Objective: 95, Window: time.Hour, LatencyThreshold: 200 * time.Millisecond},
+			},
+		}, {
+			Name: "invalid window",
+			Config: `
+objectives:
+- name: availability
+  address: backend
+  objective: 99.9
+  window: 4w
+`,
+			Err: `objective 0: invalid window: time: unknown unit "w" in duration "4w"`,
+		}, {
+			Name: "invalid objective",
+			Config: `
+objectives:
+- name: availability
+  address: backend
+  objective: 100
+  window: 1h
+`,
+			Err: "objective 0: objective must be a percentage between 0 and 100 exclusive",
+		}, {
+			Name: "repeated name",
+			Config: `
+objectives:
+- {name: availability, address: backend, objective: 99, window: 1h}
+- {name: availability, address: backend, objective: 95, window: 2h}
+`,
+			Err: `objective 1: name "availability" already used for address "backend"`,
+		}, {
+			Name:   "unknown field",
+			Config: `objectives: [{name: a, address: b, objective: 99, window: 1h, target: 99}]`,
+			Err:    `error unmarshaling JSON: while decoding JSON: json: unknown field "target"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "slo.yaml")
+			assert.Assert(t, os.WriteFile(path, []byte(tc.Config), 0o644))
+			objectives, err := loadServiceLevelObjectives(path)
+			if tc.Err != "" {
+				assert.Error(t, err, tc.Err)
+				return
+			}
+			assert.Check(t, err)
+			assert.DeepEqual(t, objectives, tc.Expected)
+		})
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   string
+		Expected *collector.Policy
+		Err      string
+	}{
+		{
+			Name: "valid",
+			Config: `
+allow:
+- from: west
+  to: east
+  routingKeys: [backend, database]
+- from: "*"
+  to: west
+`,
+			Expected: &collector.Policy{Allow: []collector.PolicyRule{
+				{From: "west", To: "east", RoutingKeys: []string{"backend", "database"}},
+				{From: "*", To: "west"},
+			}},
+		}, {
+			Name:     "empty",
+			Config:   `allow: []`,
+			Expected: &collector.Policy{Allow: []collector.PolicyRule{}},
+		}, {
+			Name:   "missing to",
+			Config: `allow: [{from: west}]`,
+			Err:    "rule 0: to is required",
+		}, {
+			Name:   "empty routing key",
+			Config: `allow: [{from: west, to: east, routingKeys: [""]}]`,
+			Err:    "rule 0: routing keys cannot be empty",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "policy.yaml")
+			assert.Assert(t, os.WriteFile(path, []byte(tc.Config), 0o644))
+			policy, err := loadPolicy(path)
+			if tc.Err != "" {
+				assert.Error(t, err, tc.Err)
+				return
+			}
+			assert.Check(t, err)
+			assert.DeepEqual(t, policy, tc.Expected)
+		})
+	}
+}
+
+func TestLoadAuth(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Config       string
+		Htpasswd     string
+		ExpectStatus map[string]int
+		Err          string
+	}{
+		{
+			Name: "htpasswd",
+			Config: `
+htpasswd:
+  file: HTPASSWD
+roles:
+- groups: [admins]
+  namespaces: ["*"]
+- users: [alice]
+  namespaces: [east]
+`,
+			Htpasswd: "alice:{PLAIN}secret\nbob:{PLAIN}secret\n",
+			ExpectStatus: map[string]int{
+				"":             401,
+				"alice:guess":  401,
+				"alice:secret": 200,
+				"bob:secret":   403,
+			},
+		}, {
+			Name:   "no authenticator",
+			Config: `roles: []`,
+			Err:    "at least one of htpasswd, oidc or tokenReview is required",
+		}, {
+			Name: "invalid role",
+			Config: `
+htpasswd:
+  file: HTPASSWD
+roles:
+- namespaces: [east]
+`,
+			Err: "role 0: at least one of users or groups is required",
+		}, {
+			Name: "invalid htpasswd",
+			Config: `
+htpasswd:
+  file: HTPASSWD
+`,
+			Htpasswd: "alice:$apr1$salt$hash\n",
+			Err:      `failed to load htpasswd: line 1: unsupported password format for user "alice"`,
+		}, {
+			Name:   "oidc without issuer",
+			Config: `oidc: {clientID: network-observer}`,
+			Err:    "oidc: issuer and clientID are required",
+		}, {
+			Name:   "invalid cache ttl",
+			Config: `tokenReview: {cacheTTL: soon}`,
+			Err:    `tokenReview: invalid cacheTTL: time: invalid duration "soon"`,
+		}, {
+			Name:   "unknown field",
+			Config: `ldap: {}`,
+			Err:    `error unmarshaling JSON: while decoding JSON: json: unknown field "ldap"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			htpasswdPath := filepath.Join(dir, "htpasswd")
+			assert.Assert(t, os.WriteFile(htpasswdPath, []byte(tc.Htpasswd), 0o644))
+			path := filepath.Join(dir, "auth.yaml")
+			assert.Assert(t, os.WriteFile(path, []byte(strings.ReplaceAll(tc.Config, "HTPASSWD", htpasswdPath)), 0o644))
+			authn, err := loadAuth(context.Background(), slog.Default(), path)
+			if tc.Err != "" {
+				assert.Error(t, err, tc.Err)
+				return
+			}
+			assert.Assert(t, err)
+			handler := authn.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			for credentials, status := range tc.ExpectStatus {
+				req := httptest.NewRequest(http.MethodGet, "/api/v2alpha1/sites", nil)
+				if user, password, ok := strings.Cut(credentials, ":"); ok {
+					req.SetBasicAuth(user, password)
+				}
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, req)
+				assert.Equal(t, rec.Code, status, credentials)
+			}
+		})
+	}
+
+	authn, err := loadAuth(context.Background(), slog.Default(), "")
+	assert.Assert(t, err)
+	assert.Assert(t, authn == nil)
+}
@@ -10,6 +10,9 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/auth"
 )
 
 func handleMetrics(reg *prometheus.Registry) http.Handler {
@@ -75,6 +78,12 @@ func handleGetUser() http.Handler {
 	handleEmpty := handleNoContent()
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var response UserResponse
+		if access, ok := auth.FromContext(r.Context()); ok {
+			response.Username = access.User.Name
+			response.AuthMode = access.User.Method
+			json.NewEncoder(w).Encode(response)
+			return
+		}
 		if cookie, err := r.Cookie("_oauth_proxy"); err == nil && cookie != nil {
 			if cookieDecoded, _ := base64.StdEncoding.DecodeString(cookie.Value); cookieDecoded != nil {
 				response.Username = string(cookieDecoded)
@@ -104,3 +113,34 @@ func handleUserLogout() http.Handler {
 		handleEmpty.ServeHTTP(w, r)
 	})
 }
+
+func handleGetAlerts(evaluator *alerts.Evaluator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(evaluator.Alerts())
+	})
+}
+
+func handleAlertRules(evaluator *alerts.Evaluator) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var rules alerts.Rules
+			if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if err := evaluator.SetRules(rules); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(evaluator.Rules())
+	})
+}
@@ -3,10 +3,15 @@ package main
 import (
 	"bytes"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector/metrics"
 )
 
 func TestHandleProxyPrometheusAPI(t *testing.T) {
@@ -119,3 +124,64 @@ func TestHandleProxyPrometheusAPI(t *testing.T) {
 		}
 	}
 }
+
+type noLinks struct{}
+
+func (noLinks) LinkHealth() []metrics.LinkHealth { return nil }
+
+func TestHandleAlertRules(t *testing.T) {
+	evaluator := alerts.New(slog.Default(), noLinks{}, alerts.Config{
+		Rules: alerts.Rules{LinkDownFor: time.Minute, LinkFlapsPerHour: 5},
+	})
+	srv := httptest.NewServer(handleAlertRules(evaluator))
+	defer srv.Close()
+
+	testCases := []struct {
+		Method  string
+		Body    string
+		Status  int
+		Content string
+	}{
+		{
+			Method:  http.MethodGet,
+			Status:  200,
+			Content: `{"linkDownFor":"1m0s","linkFlapsPerHour":5}`,
+		}, {
+			Method:  http.MethodPut,
+			Body:    `{"linkDownFor":"5m","linkFlapsPerHour":0}`,
+			Status:  200,
+			Content: `{"linkDownFor":"5m0s","linkFlapsPerHour":0}`,
+		}, {
+			Method: http.MethodPut,
+			Body:   `{"linkDownFor":"-5m"}`,
+			Status: 400,
+		}, {
+			Method: http.MethodPost,
+			Status: 405,
+		}, {
+			Method:  http.MethodGet,
+			Status:  200,
+			Content: `{"linkDownFor":"5m0s","linkFlapsPerHour":0}`,
+		},
+	}
+	for _, tc := range testCases {
+		req, _ := http.NewRequest(tc.Method, srv.URL, strings.NewReader(tc.Body))
+		r, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Body.Close()
+		if r.StatusCode != tc.Status {
+			t.Errorf("%s: expected status %d but got %d", tc.Method, tc.Status, r.StatusCode)
+		}
+		if tc.Content != "" {
+			content, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual := strings.TrimSpace(string(content)); actual != tc.Content {
+				t.Errorf("%s: expected response body %q but got %q", tc.Method, tc.Content, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,307 @@
+// Package alerts evaluates alerting rules against the health of the network
+// and notifies a webhook as alerts start and stop firing.
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector/metrics"
+)
+
+const (
+	RuleLinkDown     = "LinkDown"
+	RuleLinkFlapping = "LinkFlapping"
+
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+
+	// maxPendingNotifications bounds the alerts held while the webhook is
+	// unavailable
+	maxPendingNotifications = 1024
+)
+
+// Rules are the thresholds alerts fire at. A zero threshold disables its
+// rule.
+type Rules struct {
+	// LinkDownFor is how long a link is down before alerting
+	LinkDownFor time.Duration
+	// LinkFlapsPerHour is the number of times a link can go down in an hour
+	// before alerting
+	LinkFlapsPerHour int
+}
+
+type rulesJSON struct {
+	LinkDownFor      string `json:"linkDownFor"`
+	LinkFlapsPerHour int    `json:"linkFlapsPerHour"`
+}
+
+func (r Rules) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rulesJSON{
+		LinkDownFor:      r.LinkDownFor.String(),
+		LinkFlapsPerHour: r.LinkFlapsPerHour,
+	})
+}
+
+func (r *Rules) UnmarshalJSON(data []byte) error {
+	var raw rulesJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var linkDownFor time.Duration
+	if raw.LinkDownFor != "" {
+		var err error
+		linkDownFor, err = time.ParseDuration(raw.LinkDownFor)
+		if err != nil {
+			return fmt.Errorf("invalid linkDownFor: %s", err)
+		}
+	}
+	r.LinkDownFor, r.LinkFlapsPerHour = linkDownFor, raw.LinkFlapsPerHour
+	return r.Validate()
+}
+
+func (r Rules) Validate() error {
+	if r.LinkDownFor < 0 {
+		return fmt.Errorf("linkDownFor cannot be negative")
+	}
+	if r.LinkFlapsPerHour < 0 {
+		return fmt.Errorf("linkFlapsPerHour cannot be negative")
+	}
+	return nil
+}
+
+// Alert raised by a rule for a link
+type Alert struct {
+	Rule     string    `json:"rule"`
+	Status   string    `json:"status"`
+	Network  string    `json:"network,omitempty"`
+	LinkID   string    `json:"linkId"`
+	LinkName string    `json:"linkName,omitempty"`
+	SiteID   string    `json:"siteId,omitempty"`
+	Since    time.Time `json:"since"`
+	Message  string    `json:"message"`
+}
+
+// Notification is the body of the requests posted to the webhook
+type Notification struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+// LinkHealthSource provides the health of the links alerts are evaluated
+// against.
+type LinkHealthSource interface {
+	LinkHealth() []metrics.LinkHealth
+}
+
+type Config struct {
+	Rules Rules
+	// WebhookURL is where notifications are posted. Alerts are evaluated
+	// without notifying anyone when empty.
+	WebhookURL string
+	// Interval between evaluations
+	Interval time.Duration
+	Client   *http.Client
+}
+
+func New(logger *slog.Logger, source LinkHealthSource, cfg Config) *Evaluator {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 15 * time.Second
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Evaluator{
+		logger: logger,
+		source: source,
+		cfg:    cfg,
+		rules:  cfg.Rules,
+		firing: make(map[alertKey]Alert),
+		now:    time.Now,
+	}
+}
+
+// Evaluator periodically evaluates the alerting Rules against the health of
+// links.
+type Evaluator struct {
+	logger *slog.Logger
+	source LinkHealthSource
+	cfg    Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	rules   Rules
+	firing  map[alertKey]Alert
+	pending []Alert
+}
+
+type alertKey struct {
+	Rule    string
+	Network string
+	LinkID  string
+}
+
+func (e *Evaluator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			e.Evaluate(ctx)
+		}
+	}
+}
+
+// Rules returns the rules currently evaluated
+func (e *Evaluator) Rules() Rules {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.rules
+}
+
+// SetRules replaces the rules evaluated from the next evaluation onwards
+func (e *Evaluator) SetRules(rules Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rules = rules
+	return nil
+}
+
+// Alerts returns the alerts currently firing
+func (e *Evaluator) Alerts() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return sortedAlerts(e.firing)
+}
+
+// Evaluate the rules against the current health of the links and notify the
+// webhook of any alerts that started or stopped firing since the previous
+// evaluation.
+func (e *Evaluator) Evaluate(ctx context.Context) {
+	now := e.now()
+	links := e.source.LinkHealth()
+
+	e.mu.Lock()
+	firing := evaluate(e.rules, links, now)
+	var changes []Alert
+	for key, alert := range firing {
+		if prev, ok := e.firing[key]; ok {
+			firing[key] = prev
+			continue
+		}
+		changes = append(changes, alert)
+	}
+	for key, alert := range e.firing {
+		if _, ok := firing[key]; !ok {
+			alert.Status = StatusResolved
+			changes = append(changes, alert)
+		}
+	}
+	e.firing = firing
+	pending := append(e.pending, changes...)
+	if overflow := len(pending) - maxPendingNotifications; overflow > 0 {
+		pending = pending[overflow:]
+	}
+	e.pending = nil
+	e.mu.Unlock()
+
+	if len(pending) == 0 || e.cfg.WebhookURL == "" {
+		return
+	}
+	if err := e.notify(ctx, pending); err != nil {
+		e.logger.Error("failed to notify alert webhook",
+			slog.String("url", e.cfg.WebhookURL),
+			slog.Int("alerts", len(pending)),
+			slog.Any("error", err))
+		// retried with the next evaluation
+		e.mu.Lock()
+		e.pending = append(pending, e.pending...)
+		e.mu.Unlock()
+	}
+}
+
+func (e *Evaluator) notify(ctx context.Context, alerts []Alert) error {
+	body, err := json.Marshal(Notification{Alerts: alerts})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.cfg.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+func evaluate(rules Rules, links []metrics.LinkHealth, now time.Time) map[alertKey]Alert {
+	firing := make(map[alertKey]Alert)
+	for _, link := range links {
+		alert := Alert{
+			Status:   StatusFiring,
+			Network:  link.Network,
+			LinkID:   link.ID,
+			LinkName: link.Name,
+			SiteID:   link.SiteID,
+		}
+		if rules.LinkDownFor > 0 && link.Status != "" && !link.Up {
+			if down := now.Sub(link.Since); down >= rules.LinkDownFor {
+				alert := alert
+				alert.Rule, alert.Since = RuleLinkDown, link.Since
+				alert.Message = fmt.Sprintf("link %s has been down for %s", linkName(link), down.Truncate(time.Second))
+				firing[alertKey{Rule: RuleLinkDown, Network: link.Network, LinkID: link.ID}] = alert
+			}
+		}
+		if rules.LinkFlapsPerHour > 0 && link.Flaps > rules.LinkFlapsPerHour {
+			alert := alert
+			alert.Rule, alert.Since = RuleLinkFlapping, now
+			alert.Message = fmt.Sprintf("link %s went down %d times in the last hour", linkName(link), link.Flaps)
+			firing[alertKey{Rule: RuleLinkFlapping, Network: link.Network, LinkID: link.ID}] = alert
+		}
+	}
+	return firing
+}
+
+func linkName(link metrics.LinkHealth) string {
+	if link.Name != "" {
+		return link.Name
+	}
+	return link.ID
+}
+
+func sortedAlerts(alerts map[alertKey]Alert) []Alert {
+	keys := slices.SortedFunc(maps.Keys(alerts), func(a, b alertKey) int {
+		if c := strings.Compare(a.Network, b.Network); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.LinkID, b.LinkID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Rule, b.Rule)
+	})
+	out := make([]Alert, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, alerts[key])
+	}
+	return out
+}
@@ -0,0 +1,199 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector/metrics"
+	"gotest.tools/v3/assert"
+)
+
+type fakeSource struct {
+	links []metrics.LinkHealth
+}
+
+func (s *fakeSource) LinkHealth() []metrics.LinkHealth {
+	return s.links
+}
+
+type webhookStub struct {
+	mu            sync.Mutex
+	status        int
+	notifications []Notification
+}
+
+func (s *webhookStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	var notification Notification
+	if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.notifications = append(s.notifications, notification)
+}
+
+func (s *webhookStub) received() []Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	received := s.notifications
+	s.notifications = nil
+	return received
+}
+
+func (s *webhookStub) respond(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+func TestEvaluator(t *testing.T) {
+	stub := &webhookStub{}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := &fakeSource{}
+	evaluator := New(slog.Default(), source, Config{
+		WebhookURL: srv.URL,
+		Rules: Rules{
+			LinkDownFor:      time.Minute,
+			LinkFlapsPerHour: 3,
+		},
+	})
+	evaluator.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	downSince := now
+	source.links = []metrics.LinkHealth{
+		{ID: "l01", Name: "west-east", SiteID: "s01", Status: "down", Since: downSince},
+		{ID: "l02", SiteID: "s01", Status: "up", Up: true, Since: downSince, Flaps: 3},
+	}
+	evaluator.Evaluate(ctx)
+	assert.Equal(t, len(stub.received()), 0)
+	assert.Equal(t, len(evaluator.Alerts()), 0)
+
+	now = now.Add(90 * time.Second)
+	source.links[1].Flaps = 4
+	evaluator.Evaluate(ctx)
+	linkDown := Alert{
+		Rule:     RuleLinkDown,
+		Status:   StatusFiring,
+		LinkID:   "l01",
+		LinkName: "west-east",
+		SiteID:   "s01",
+		Since:    downSince,
+		Message:  "link west-east has been down for 1m30s",
+	}
+	linkFlapping := Alert{
+		Rule:    RuleLinkFlapping,
+		Status:  StatusFiring,
+		LinkID:  "l02",
+		SiteID:  "s01",
+		Since:   now,
+		Message: "link l02 went down 4 times in the last hour",
+	}
+	assert.DeepEqual(t, evaluator.Alerts(), []Alert{linkDown, linkFlapping})
+	received := stub.received()
+	assert.Equal(t, len(received), 1)
+	assert.Equal(t, len(received[0].Alerts), 2)
+
+	// alerts already firing are not notified again
+	now = now.Add(time.Minute)
+	evaluator.Evaluate(ctx)
+	assert.Equal(t, len(stub.received()), 0)
+	assert.DeepEqual(t, evaluator.Alerts(), []Alert{linkDown, linkFlapping})
+
+	// notifications are retried when the webhook fails
+	stub.respond(http.StatusServiceUnavailable)
+	source.links[0].Status, source.links[0].Up = "up", true
+	evaluator.Evaluate(ctx)
+	assert.DeepEqual(t, evaluator.Alerts(), []Alert{linkFlapping})
+	assert.Equal(t, len(stub.received()), 0)
+
+	stub.respond(0)
+	evaluator.Evaluate(ctx)
+	received = stub.received()
+	assert.Equal(t, len(received), 1)
+	resolved := linkDown
+	resolved.Status = StatusResolved
+	assert.DeepEqual(t, received[0].Alerts, []Alert{resolved})
+
+	// rules can be disabled
+	assert.Check(t, evaluator.SetRules(Rules{LinkDownFor: time.Minute}))
+	evaluator.Evaluate(ctx)
+	assert.Equal(t, len(evaluator.Alerts()), 0)
+	received = stub.received()
+	assert.Equal(t, len(received), 1)
+	assert.Equal(t, received[0].Alerts[0].Rule, RuleLinkFlapping)
+	assert.Equal(t, received[0].Alerts[0].Status, StatusResolved)
+}
+
+func TestEvaluateNetworks(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	since := now.Add(-time.Hour)
+	// link identities are only unique within a network
+	firing := evaluate(Rules{LinkDownFor: time.Minute}, []metrics.LinkHealth{
+		{Network: "west", ID: "l01", Status: "down", Since: since},
+		{Network: "east", ID: "l01", Status: "down", Since: since},
+	}, now)
+	alerts := sortedAlerts(firing)
+	assert.Equal(t, len(alerts), 2)
+	assert.Equal(t, alerts[0].Network, "east")
+	assert.Equal(t, alerts[1].Network, "west")
+}
+
+func TestRulesJSON(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		JSON     string
+		Expected Rules
+		Err      string
+	}{
+		{
+			Name:     "valid",
+			JSON:     `{"linkDownFor":"2m","linkFlapsPerHour":10}`,
+			Expected: Rules{LinkDownFor: 2 * time.Minute, LinkFlapsPerHour: 10},
+		}, {
+			Name:     "disabled",
+			JSON:     `{}`,
+			Expected: Rules{},
+		}, {
+			Name: "invalid duration",
+			JSON: `{"linkDownFor":"soon"}`,
+			Err:  `invalid linkDownFor: time: invalid duration "soon"`,
+		}, {
+			Name: "negative flaps",
+			JSON: `{"linkFlapsPerHour":-1}`,
+			Err:  "linkFlapsPerHour cannot be negative",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var rules Rules
+			err := json.Unmarshal([]byte(tc.JSON), &rules)
+			if tc.Err != "" {
+				assert.Error(t, err, tc.Err)
+				return
+			}
+			assert.Check(t, err)
+			assert.DeepEqual(t, rules, tc.Expected)
+
+			out, err := json.Marshal(rules)
+			assert.Check(t, err)
+			var roundTrip Rules
+			assert.Check(t, json.Unmarshal(out, &roundTrip))
+			assert.DeepEqual(t, roundTrip, tc.Expected)
+		})
+	}
+}
@@ -4,6 +4,21 @@ package api
 // Implements ResponseSetter and CollectionResponseSetter for the generated
 // response objects
 
+// SetCount
+func (r *AnomalyListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *AnomalyListResponse) SetResults(v []AnomalyRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *AnomalyListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
 // SetCount
 func (r *ApplicationFlowResponse) SetCount(v int64) {
 	r.Count = v
@@ -114,6 +129,21 @@ func (r *ListenerResponse) SetResults(v ListenerRecord) {
 	r.Results = v
 }
 
+// SetCount
+func (r *PolicyViolationListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *PolicyViolationListResponse) SetResults(v []PolicyViolationRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *PolicyViolationListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
 // SetCount
 func (r *ProcessListResponse) SetCount(v int64) {
 	r.Count = v
@@ -194,6 +224,21 @@ func (r *RouterResponse) SetResults(v RouterRecord) {
 	r.Results = v
 }
 
+// SetCount
+func (r *ServiceLevelObjectiveListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *ServiceLevelObjectiveListResponse) SetResults(v []ServiceLevelObjectiveRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *ServiceLevelObjectiveListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
 // SetCount
 func (r *ServiceListResponse) SetCount(v int64) {
 	r.Count = v
@@ -234,6 +279,11 @@ func (r *SiteResponse) SetResults(v SiteRecord) {
 	r.Results = v
 }
 
+// SetResults
+func (r *TcpStatsResponse) SetResults(v TcpStatsRecord) {
+	r.Results = v
+}
+
 // SetCount
 func (r *CollectionResponse) SetCount(v int64) {
 	r.Count = v
@@ -246,6 +296,16 @@ func (r *CollectionResponse) SetTimeRangeCount(v int64) {
 
 // Implements Record interface for the generated record objects
 
+// GetEndTime
+func (r AnomalyRecord) GetEndTime() uint64 {
+	return r.EndTime
+}
+
+// GetStartTime
+func (r AnomalyRecord) GetStartTime() uint64 {
+	return r.StartTime
+}
+
 // GetEndTime
 func (r ApplicationFlowRecord) GetEndTime() uint64 {
 	return r.EndTime
@@ -306,6 +366,16 @@ func (r ListenerRecord) GetStartTime() uint64 {
 	return r.StartTime
 }
 
+// GetEndTime
+func (r PolicyViolationRecord) GetEndTime() uint64 {
+	return r.EndTime
+}
+
+// GetStartTime
+func (r PolicyViolationRecord) GetStartTime() uint64 {
+	return r.StartTime
+}
+
 // GetEndTime
 func (r ProcessRecord) GetEndTime() uint64 {
 	return r.EndTime
@@ -346,6 +416,16 @@ func (r RouterRecord) GetStartTime() uint64 {
 	return r.StartTime
 }
 
+// GetEndTime
+func (r ServiceLevelObjectiveRecord) GetEndTime() uint64 {
+	return r.EndTime
+}
+
+// GetStartTime
+func (r ServiceLevelObjectiveRecord) GetStartTime() uint64 {
+	return r.StartTime
+}
+
 // GetEndTime
 func (r ServiceRecord) GetEndTime() uint64 {
 	return r.EndTime
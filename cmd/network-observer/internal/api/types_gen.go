@@ -23,6 +23,21 @@ const (
 	Remote   ProcessRecordRole = "remote"
 )
 
+// Defines values for AnomalyKindType.
+const (
+	Bytes          AnomalyKindType = "bytes"
+	ConnectionRate AnomalyKindType = "connectionRate"
+	ErrorRatio     AnomalyKindType = "errorRatio"
+	NewSourceSite  AnomalyKindType = "newSourceSite"
+)
+
+// Defines values for ErrorBudgetStatusType.
+const (
+	Burning   ErrorBudgetStatusType = "burning"
+	Exhausted ErrorBudgetStatusType = "exhausted"
+	Ok        ErrorBudgetStatusType = "ok"
+)
+
 // Defines values for FlowAggregatePairType.
 const (
 	PROCESS      FlowAggregatePairType = "PROCESS"
@@ -49,6 +64,12 @@ const (
 	Unbound ProcessBindingType = "unbound"
 )
 
+// Defines values for ServiceLevelIndicatorType.
+const (
+	Availability ServiceLevelIndicatorType = "availability"
+	Latency      ServiceLevelIndicatorType = "latency"
+)
+
 // Defines values for SitePlatformType.
 const (
 	SitePlatformTypeDocker     SitePlatformType = "docker"
@@ -58,6 +79,64 @@ const (
 	SitePlatformTypeUnknown    SitePlatformType = "unknown"
 )
 
+// Defines values for TerminationReasonType.
+const (
+	Closed      TerminationReasonType = "closed"
+	Error       TerminationReasonType = "error"
+	Refused     TerminationReasonType = "refused"
+	Reset       TerminationReasonType = "reset"
+	Timeout     TerminationReasonType = "timeout"
+	Unreachable TerminationReasonType = "unreachable"
+)
+
+// AnomalyListResponse defines model for AnomalyListResponse.
+type AnomalyListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []AnomalyRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// AnomalyRecord defines model for AnomalyRecord.
+type AnomalyRecord struct {
+	Description string `json:"description"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Expected The mean of the baseline for the hour of the week
+	Expected float64 `json:"expected"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Kind The traffic an anomaly deviated in. connectionRate, bytes and errorRatio are counted over each minute and compared to the baseline learned for the same hour of the week, and newSourceSite is traffic from a site not seen before once the sites traffic to an address comes from are learned.
+	Kind AnomalyKindType `json:"kind"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+
+	// Observed The value observed over the last minute of the anomaly
+	Observed   float64 `json:"observed"`
+	Protocol   string  `json:"protocol"`
+	RoutingKey string  `json:"routingKey"`
+
+	// Score The number of standard deviations the traffic was from the baseline, at its furthest while the anomaly lasted
+	Score float64 `json:"score"`
+
+	// SourceSiteId The site traffic not seen before came from, or that most connections came from for connectionRate anomalies
+	SourceSiteId   *string `json:"sourceSiteId"`
+	SourceSiteName *string `json:"sourceSiteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+
+	// StdDev The standard deviation of the baseline for the hour of the week
+	StdDev float64 `json:"stdDev"`
+}
+
 // ApplicationFlowRecord defines model for ApplicationFlowRecord.
 type ApplicationFlowRecord struct {
 	ConnectionId    string  `json:"connectionId"`
@@ -71,16 +150,19 @@ type ApplicationFlowRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity          string `json:"identity"`
-	Method            string `json:"method"`
-	OctetCount        uint64 `json:"octetCount"`
-	OctetReverseCount uint64 `json:"octetReverseCount"`
-	Protocol          string `json:"protocol"`
-	RoutingKey        string `json:"routingKey"`
-	SourceProcessId   string `json:"sourceProcessId"`
-	SourceProcessName string `json:"sourceProcessName"`
-	SourceSiteId      string `json:"sourceSiteId"`
-	SourceSiteName    string `json:"sourceSiteName"`
+	Identity string `json:"identity"`
+	Method   string `json:"method"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
+	Protocol          string  `json:"protocol"`
+	RoutingKey        string  `json:"routingKey"`
+	SourceProcessId   string  `json:"sourceProcessId"`
+	SourceProcessName string  `json:"sourceProcessName"`
+	SourceSiteId      string  `json:"sourceSiteId"`
+	SourceSiteName    string  `json:"sourceSiteName"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -119,10 +201,13 @@ type ComponentRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity     string `json:"identity"`
-	Name         string `json:"name"`
-	ProcessCount int    `json:"processCount"`
-	Role         string `json:"role"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network      *string `json:"network,omitempty"`
+	ProcessCount int     `json:"processCount"`
+	Role         string  `json:"role"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -160,13 +245,23 @@ type ConnectionRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity          string  `json:"identity"`
-	Latency           uint64  `json:"latency"`
-	LatencyReverse    uint64  `json:"latencyReverse"`
-	ListenerError     *string `json:"listenerError"`
-	ListenerId        string  `json:"listenerId"`
+	Identity string `json:"identity"`
+
+	// Latency Time to first byte in microseconds observed from the listener (client) side
+	Latency uint64 `json:"latency"`
+
+	// LatencyReverse Time to first byte in microseconds observed from the connector (server) side
+	LatencyReverse uint64  `json:"latencyReverse"`
+	ListenerError  *string `json:"listenerError"`
+	ListenerId     string  `json:"listenerId"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network           *string `json:"network,omitempty"`
 	OctetCount        uint64  `json:"octetCount"`
 	OctetReverseCount uint64  `json:"octetReverseCount"`
+
+	// OctetsUnacked Bytes sent that have not been acknowledged
+	OctetsUnacked     *uint64 `json:"octetsUnacked"`
 	ProcessPairId     *string `json:"processPairId"`
 	Protocol          string  `json:"protocol"`
 	ProxyHost         string  `json:"proxyHost"`
@@ -183,11 +278,20 @@ type ConnectionRecord struct {
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
 
+	// TerminationReason Why the connection terminated. Null while the connection is open
+	TerminationReason *TerminationReasonType `json:"terminationReason"`
+
 	// TraceRouters Ordered array of the names of routers involved in proxying the connection
 	TraceRouters []string `json:"traceRouters"`
 
 	// TraceSites Ordered array of the names of sites involved in proxying the connection
 	TraceSites []string `json:"traceSites"`
+
+	// WindowClosures Number of times the receive window closed, applying back-pressure
+	WindowClosures *uint64 `json:"windowClosures"`
+
+	// WindowSize Size of the receive window in bytes
+	WindowSize *uint64 `json:"windowSize"`
 }
 
 // ConnectorListResponse defines model for ConnectorListResponse.
@@ -209,8 +313,11 @@ type ConnectorRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity   string  `json:"identity"`
-	Name       string  `json:"name"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network    *string `json:"network,omitempty"`
 	ProcessId  string  `json:"processId"`
 	Protocol   string  `json:"protocol"`
 	RouterId   string  `json:"routerId"`
@@ -256,7 +363,10 @@ type FlowAggregateRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity       string                `json:"identity"`
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network        *string               `json:"network,omitempty"`
 	PairType       FlowAggregatePairType `json:"pairType"`
 	Protocol       string                `json:"protocol"`
 	RecordCount    uint64                `json:"recordCount"`
@@ -293,8 +403,11 @@ type ListenerRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity   string  `json:"identity"`
-	Name       string  `json:"name"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network    *string `json:"network,omitempty"`
 	Protocol   string  `json:"protocol"`
 	RouterId   string  `json:"routerId"`
 	RoutingKey string  `json:"routingKey"`
@@ -311,6 +424,41 @@ type ListenerResponse struct {
 	Results ListenerRecord `json:"results"`
 }
 
+// PolicyViolationListResponse defines model for PolicyViolationListResponse.
+type PolicyViolationListResponse struct {
+	// Count number of results in response
+	Count   int64                   `json:"count"`
+	Results []PolicyViolationRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// PolicyViolationRecord defines model for PolicyViolationRecord.
+type PolicyViolationRecord struct {
+	// DestSiteId The site the routing key was served from
+	DestSiteId   string `json:"destSiteId"`
+	DestSiteName string `json:"destSiteName"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network    *string `json:"network,omitempty"`
+	Protocol   string  `json:"protocol"`
+	RoutingKey string  `json:"routingKey"`
+
+	// SourceSiteId The site the clients connected from
+	SourceSiteId   string `json:"sourceSiteId"`
+	SourceSiteName string `json:"sourceSiteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
 // ProcessListResponse defines model for ProcessListResponse.
 type ProcessListResponse struct {
 	// Count number of results in response
@@ -341,6 +489,9 @@ type ProcessRecord struct {
 	ImageName *string `json:"imageName"`
 	Name      string  `json:"name"`
 
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+
 	// Role Internal processes are processes related to Skupper. Remote processes are processes indirectly connected, such as a proxy
 	Role     ProcessRecordRole        `json:"role"`
 	Services *[]ServiceIdentifierType `json:"services"`
@@ -383,8 +534,11 @@ type RouterAccessRecord struct {
 	Identity  string `json:"identity"`
 	LinkCount uint64 `json:"linkCount"`
 	Name      string `json:"name"`
-	Role      string `json:"role"`
-	RouterId  string `json:"routerId"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network  *string `json:"network,omitempty"`
+	Role     string  `json:"role"`
+	RouterId string  `json:"routerId"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -426,10 +580,13 @@ type RouterLinkRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity          string `json:"identity"`
-	Name              string `json:"name"`
-	OctetCount        uint64 `json:"octetCount"`
-	OctetReverseCount uint64 `json:"octetReverseCount"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
 
 	// Role The class of skupper link
 	Role LinkRoleType `json:"role"`
@@ -476,7 +633,10 @@ type RouterRecord struct {
 	Mode         string  `json:"mode"`
 	Name         string  `json:"name"`
 	Namespace    *string `json:"namespace,omitempty"`
-	SiteId       string  `json:"siteId"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+	SiteId  string  `json:"siteId"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -487,6 +647,63 @@ type RouterResponse struct {
 	Results RouterRecord `json:"results"`
 }
 
+// ServiceLevelObjectiveListResponse defines model for ServiceLevelObjectiveListResponse.
+type ServiceLevelObjectiveListResponse struct {
+	// Count number of results in response
+	Count   int64                         `json:"count"`
+	Results []ServiceLevelObjectiveRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ServiceLevelObjectiveRecord defines model for ServiceLevelObjectiveRecord.
+type ServiceLevelObjectiveRecord struct {
+	// BurnRate1h The rate the error budget was spent at over the last hour, relative to the rate that spends it over exactly the window
+	BurnRate1h float64 `json:"burnRate1h"`
+
+	// BurnRate6h The rate the error budget was spent at over the last six hours
+	BurnRate6h float64 `json:"burnRate6h"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// ErrorBudgetRemaining The ratio of the error budget for the window not yet spent
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"`
+	GoodEvents           uint64  `json:"goodEvents"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Indicator The events a service level objective is measured against. availability counts requests that did not end with a 5xx response class as good, and latency counts connections with a time to first byte within the latency threshold as good.
+	Indicator ServiceLevelIndicatorType `json:"indicator"`
+
+	// LatencyThreshold The time to first byte of good events for latency objectives
+	LatencyThreshold *string `json:"latencyThreshold"`
+	Name             string  `json:"name"`
+
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+
+	// Objective The percentage of good events targeted over the window
+	Objective float64 `json:"objective"`
+	Protocol  string  `json:"protocol"`
+
+	// Ratio The ratio of good events to total events over the window
+	Ratio      float64 `json:"ratio"`
+	RoutingKey string  `json:"routingKey"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+
+	// Status ok while the error budget lasts, burning when it is consumed at a rate that would exhaust it early and exhausted once it is spent.
+	Status      ErrorBudgetStatusType `json:"status"`
+	TotalEvents uint64                `json:"totalEvents"`
+
+	// Window The duration of the compliance window
+	Window string `json:"window"`
+}
+
 // ServiceListResponse defines model for ServiceListResponse.
 type ServiceListResponse struct {
 	// Count number of results in response
@@ -515,6 +732,9 @@ type ServiceRecord struct {
 	ListenerCount int    `json:"listenerCount"`
 	Name          string `json:"name"`
 
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+
 	// ObservedApplicationProtocols Array of the observed application level protocols
 	ObservedApplicationProtocols []string `json:"observedApplicationProtocols"`
 	Protocol                     string   `json:"protocol"`
@@ -548,6 +768,9 @@ type SiteRecord struct {
 	Name      string  `json:"name"`
 	Namespace *string `json:"namespace"`
 
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+
 	// Platform The platform used for the site.
 	Platform SitePlatformType `json:"platform"`
 
@@ -567,6 +790,39 @@ type SiteResponse struct {
 	Results SiteRecord `json:"results"`
 }
 
+// TcpStatsRecord TCP statistics aggregated over the connections to a service the collector retains.
+type TcpStatsRecord struct {
+	ActiveConnectionCount uint64 `json:"activeConnectionCount"`
+	ConnectionCount       uint64 `json:"connectionCount"`
+
+	// OctetsUnacked Bytes sent over open connections that have not been acknowledged
+	OctetsUnacked uint64 `json:"octetsUnacked"`
+
+	// TerminationReasons Number of terminated connections by terminationReasonType
+	TerminationReasons map[string]uint64 `json:"terminationReasons"`
+
+	// TimeToFirstByteMax Maximum time to first byte in microseconds observed from the listener (client) side
+	TimeToFirstByteMax uint64 `json:"timeToFirstByteMax"`
+
+	// TimeToFirstByteP50 Median time to first byte in microseconds observed from the listener (client) side
+	TimeToFirstByteP50 uint64 `json:"timeToFirstByteP50"`
+
+	// TimeToFirstByteP95 95th percentile time to first byte in microseconds observed from the listener (client) side
+	TimeToFirstByteP95 uint64 `json:"timeToFirstByteP95"`
+
+	// WindowClosures Number of times the receive window of the connections closed
+	WindowClosures uint64 `json:"windowClosures"`
+}
+
+// TcpStatsResponse defines model for TcpStatsResponse.
+type TcpStatsResponse struct {
+	// Results TCP statistics aggregated over the connections to a service the collector retains.
+	Results TcpStatsRecord `json:"results"`
+}
+
+// AnomalyKindType The traffic an anomaly deviated in. connectionRate, bytes and errorRatio are counted over each minute and compared to the baseline learned for the same hour of the week, and newSourceSite is traffic from a site not seen before once the sites traffic to an address comes from are learned.
+type AnomalyKindType string
+
 // BaseRecord defines model for baseRecord.
 type BaseRecord struct {
 	// EndTime The end time in microseconds of the record in Unix timestamp format.
@@ -575,6 +831,9 @@ type BaseRecord struct {
 	// Identity The unique identifier for the record.
 	Identity string `json:"identity"`
 
+	// Network The name of the network the record was collected from. Only set when the observer collects from more than one network.
+	Network *string `json:"network,omitempty"`
+
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
 }
@@ -588,6 +847,9 @@ type CollectionResponse struct {
 	TimeRangeCount int64 `json:"timeRangeCount"`
 }
 
+// ErrorBudgetStatusType ok while the error budget lasts, burning when it is consumed at a rate that would exhaust it early and exhausted once it is spent.
+type ErrorBudgetStatusType string
+
 // FlowAggregatePairType defines model for flowAggregatePairType.
 type FlowAggregatePairType string
 
@@ -603,9 +865,15 @@ type ProcessBindingType string
 // ServiceIdentifierType a special string for identifying services uses the form `name@identity@protocol`
 type ServiceIdentifierType = AtmarkDelimitedString
 
+// ServiceLevelIndicatorType The events a service level objective is measured against. availability counts requests that did not end with a 5xx response class as good, and latency counts connections with a time to first byte within the latency threshold as good.
+type ServiceLevelIndicatorType string
+
 // SitePlatformType The platform used for the site.
 type SitePlatformType string
 
+// TerminationReasonType Why a connection terminated, classified from the errors reported by its connector and listener sides. closed connections ended without error, and error connections with an error of no other class.
+type TerminationReasonType string
+
 // PathID defines model for pathID.
 type PathID = string
 
@@ -615,6 +883,9 @@ type ErrorBadRequest = ErrorResponse
 // ErrorNotFound defines model for errorNotFound.
 type ErrorNotFound = ErrorResponse
 
+// GetAnomalies defines model for getAnomalies.
+type GetAnomalies = AnomalyListResponse
+
 // GetApplicationFlows defines model for getApplicationFlows.
 type GetApplicationFlows = ApplicationFlowResponse
 
@@ -645,6 +916,9 @@ type GetListenerByID = ListenerResponse
 // GetListeners defines model for getListeners.
 type GetListeners = ListenerListResponse
 
+// GetPolicyViolations defines model for getPolicyViolations.
+type GetPolicyViolations = PolicyViolationListResponse
+
 // GetProcessByID defines model for getProcessByID.
 type GetProcessByID = ProcessResponse
 
@@ -672,6 +946,9 @@ type GetRouters = RouterListResponse
 // GetServiceByID defines model for getServiceByID.
 type GetServiceByID = ServiceResponse
 
+// GetServiceLevelObjectives defines model for getServiceLevelObjectives.
+type GetServiceLevelObjectives = ServiceLevelObjectiveListResponse
+
 // GetServices defines model for getServices.
 type GetServices = ServiceListResponse
 
@@ -681,6 +958,9 @@ type GetSiteByID = SiteResponse
 // GetSites defines model for getSites.
 type GetSites = SiteListResponse
 
+// GetTCPStats defines model for getTCPStats.
+type GetTCPStats = TcpStatsResponse
+
 // NotSupported defines model for notSupported.
 type NotSupported = ErrorResponse
 
@@ -757,6 +1037,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// Anomalies request
+	Anomalies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Applicationflows request
 	Applicationflows(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -793,6 +1076,9 @@ type ClientInterface interface {
 	// ListenerByID request
 	ListenerByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PolicyViolations request
+	PolicyViolations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Processes request
 	Processes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -829,6 +1115,9 @@ type ClientInterface interface {
 	// ServiceByID request
 	ServiceByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AnomaliesByService request
+	AnomaliesByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ConnectionsByService request
 	ConnectionsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -838,6 +1127,12 @@ type ClientInterface interface {
 	// ProcessPairsByService request
 	ProcessPairsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ServiceLevelObjectivesByService request
+	ServiceLevelObjectivesByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TcpStatsByService request
+	TcpStatsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Sitepairs request
 	Sitepairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -860,6 +1155,18 @@ type ClientInterface interface {
 	RoutersBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) Anomalies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnomaliesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) Applicationflows(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewApplicationflowsRequest(c.Server)
 	if err != nil {
@@ -1004,6 +1311,18 @@ func (c *Client) ListenerByID(ctx context.Context, id PathID, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
+func (c *Client) PolicyViolations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPolicyViolationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) Processes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewProcessesRequest(c.Server)
 	if err != nil {
@@ -1148,6 +1467,18 @@ func (c *Client) ServiceByID(ctx context.Context, id PathID, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
+func (c *Client) AnomaliesByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnomaliesByServiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ConnectionsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewConnectionsByServiceRequest(c.Server, id)
 	if err != nil {
@@ -1184,6 +1515,30 @@ func (c *Client) ProcessPairsByService(ctx context.Context, id PathID, reqEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) ServiceLevelObjectivesByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewServiceLevelObjectivesByServiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TcpStatsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTcpStatsByServiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) Sitepairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSitepairsRequest(c.Server)
 	if err != nil {
@@ -1268,6 +1623,33 @@ func (c *Client) RoutersBySite(ctx context.Context, id PathID, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
+// NewAnomaliesRequest generates requests for Anomalies
+func NewAnomaliesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/anomalies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewApplicationflowsRequest generates requests for Applicationflows
 func NewApplicationflowsRequest(server string) (*http.Request, error) {
 	var err error
@@ -1627,6 +2009,33 @@ func NewListenerByIDRequest(server string, id PathID) (*http.Request, error) {
 	return req, nil
 }
 
+// NewPolicyViolationsRequest generates requests for PolicyViolations
+func NewPolicyViolationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/policy/violations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewProcessesRequest generates requests for Processes
 func NewProcessesRequest(server string) (*http.Request, error) {
 	var err error
@@ -1993,8 +2402,8 @@ func NewServiceByIDRequest(server string, id PathID) (*http.Request, error) {
 	return req, nil
 }
 
-// NewConnectionsByServiceRequest generates requests for ConnectionsByService
-func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, error) {
+// NewAnomaliesByServiceRequest generates requests for AnomaliesByService
+func NewAnomaliesByServiceRequest(server string, id PathID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2009,7 +2418,7 @@ func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/connections", pathParam0)
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/anomalies", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2027,8 +2436,8 @@ func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, er
 	return req, nil
 }
 
-// NewProcessesByServiceRequest generates requests for ProcessesByService
-func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, error) {
+// NewConnectionsByServiceRequest generates requests for ConnectionsByService
+func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2043,7 +2452,7 @@ func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/processes", pathParam0)
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/connections", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2061,8 +2470,42 @@ func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, erro
 	return req, nil
 }
 
-// NewProcessPairsByServiceRequest generates requests for ProcessPairsByService
-func NewProcessPairsByServiceRequest(server string, id PathID) (*http.Request, error) {
+// NewProcessesByServiceRequest generates requests for ProcessesByService
+func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/processes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessPairsByServiceRequest generates requests for ProcessPairsByService
+func NewProcessPairsByServiceRequest(server string, id PathID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2095,6 +2538,74 @@ func NewProcessPairsByServiceRequest(server string, id PathID) (*http.Request, e
 	return req, nil
 }
 
+// NewServiceLevelObjectivesByServiceRequest generates requests for ServiceLevelObjectivesByService
+func NewServiceLevelObjectivesByServiceRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/slo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTcpStatsByServiceRequest generates requests for TcpStatsByService
+func NewTcpStatsByServiceRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/tcpstats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewSitepairsRequest generates requests for Sitepairs
 func NewSitepairsRequest(server string) (*http.Request, error) {
 	var err error
@@ -2362,6 +2873,9 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// AnomaliesWithResponse request
+	AnomaliesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AnomaliesResponse, error)
+
 	// ApplicationflowsWithResponse request
 	ApplicationflowsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error)
 
@@ -2398,6 +2912,9 @@ type ClientWithResponsesInterface interface {
 	// ListenerByIDWithResponse request
 	ListenerByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ListenerByIDResponse, error)
 
+	// PolicyViolationsWithResponse request
+	PolicyViolationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PolicyViolationsResponse, error)
+
 	// ProcessesWithResponse request
 	ProcessesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProcessesResponse, error)
 
@@ -2434,6 +2951,9 @@ type ClientWithResponsesInterface interface {
 	// ServiceByIDWithResponse request
 	ServiceByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ServiceByIDResponse, error)
 
+	// AnomaliesByServiceWithResponse request
+	AnomaliesByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*AnomaliesByServiceResponse, error)
+
 	// ConnectionsByServiceWithResponse request
 	ConnectionsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error)
 
@@ -2443,6 +2963,12 @@ type ClientWithResponsesInterface interface {
 	// ProcessPairsByServiceWithResponse request
 	ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error)
 
+	// ServiceLevelObjectivesByServiceWithResponse request
+	ServiceLevelObjectivesByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ServiceLevelObjectivesByServiceResponse, error)
+
+	// TcpStatsByServiceWithResponse request
+	TcpStatsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*TcpStatsByServiceResponse, error)
+
 	// SitepairsWithResponse request
 	SitepairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SitepairsResponse, error)
 
@@ -2465,6 +2991,29 @@ type ClientWithResponsesInterface interface {
 	RoutersBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error)
 }
 
+type AnomaliesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAnomalies
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r AnomaliesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnomaliesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ApplicationflowsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2514,6 +3063,7 @@ type ComponentpairByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetFlowAggregateByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2560,6 +3110,7 @@ type ComponentByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetComponentByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2628,6 +3179,7 @@ type ConnectorByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetConnectorByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2673,6 +3225,7 @@ type HostsByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *NotSupported
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2719,6 +3272,7 @@ type ListenerByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetListenerByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2738,6 +3292,29 @@ func (r ListenerByIDResponse) StatusCode() int {
 	return 0
 }
 
+type PolicyViolationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetPolicyViolations
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PolicyViolationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PolicyViolationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ProcessesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2765,6 +3342,7 @@ type ProcessByIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetProcessByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2811,6 +3389,7 @@ type ProcesspairByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetFlowAggregateByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2857,6 +3436,7 @@ type RouteraccessByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetRouterAccessByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2903,6 +3483,7 @@ type RouterlinkByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetRouterLinkByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2949,6 +3530,7 @@ type RouterByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetRouterByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -2995,6 +3577,7 @@ type ServiceByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetServiceByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3014,10 +3597,35 @@ func (r ServiceByIDResponse) StatusCode() int {
 	return 0
 }
 
+type AnomaliesByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAnomalies
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AnomaliesByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnomaliesByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ConnectionsByServiceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetConnections
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3064,6 +3672,7 @@ type ProcessPairsByServiceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetFlowAggregates
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3083,6 +3692,54 @@ func (r ProcessPairsByServiceResponse) StatusCode() int {
 	return 0
 }
 
+type ServiceLevelObjectivesByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetServiceLevelObjectives
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ServiceLevelObjectivesByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ServiceLevelObjectivesByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TcpStatsByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetTCPStats
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r TcpStatsByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TcpStatsByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type SitepairsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3110,6 +3767,7 @@ type SitepairByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetFlowAggregateByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3156,6 +3814,7 @@ type SiteByIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetSiteByID
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3201,6 +3860,7 @@ type ProcessesBySiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetProcesses
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3224,6 +3884,7 @@ type RoutersBySiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *GetRouters
+	JSON400      *ErrorBadRequest
 	JSON404      *ErrorNotFound
 }
 
@@ -3243,6 +3904,15 @@ func (r RoutersBySiteResponse) StatusCode() int {
 	return 0
 }
 
+// AnomaliesWithResponse request returning *AnomaliesResponse
+func (c *ClientWithResponses) AnomaliesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AnomaliesResponse, error) {
+	rsp, err := c.Anomalies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnomaliesResponse(rsp)
+}
+
 // ApplicationflowsWithResponse request returning *ApplicationflowsResponse
 func (c *ClientWithResponses) ApplicationflowsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error) {
 	rsp, err := c.Applicationflows(ctx, reqEditors...)
@@ -3351,6 +4021,15 @@ func (c *ClientWithResponses) ListenerByIDWithResponse(ctx context.Context, id P
 	return ParseListenerByIDResponse(rsp)
 }
 
+// PolicyViolationsWithResponse request returning *PolicyViolationsResponse
+func (c *ClientWithResponses) PolicyViolationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PolicyViolationsResponse, error) {
+	rsp, err := c.PolicyViolations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePolicyViolationsResponse(rsp)
+}
+
 // ProcessesWithResponse request returning *ProcessesResponse
 func (c *ClientWithResponses) ProcessesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProcessesResponse, error) {
 	rsp, err := c.Processes(ctx, reqEditors...)
@@ -3459,6 +4138,15 @@ func (c *ClientWithResponses) ServiceByIDWithResponse(ctx context.Context, id Pa
 	return ParseServiceByIDResponse(rsp)
 }
 
+// AnomaliesByServiceWithResponse request returning *AnomaliesByServiceResponse
+func (c *ClientWithResponses) AnomaliesByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*AnomaliesByServiceResponse, error) {
+	rsp, err := c.AnomaliesByService(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnomaliesByServiceResponse(rsp)
+}
+
 // ConnectionsByServiceWithResponse request returning *ConnectionsByServiceResponse
 func (c *ClientWithResponses) ConnectionsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error) {
 	rsp, err := c.ConnectionsByService(ctx, id, reqEditors...)
@@ -3486,6 +4174,24 @@ func (c *ClientWithResponses) ProcessPairsByServiceWithResponse(ctx context.Cont
 	return ParseProcessPairsByServiceResponse(rsp)
 }
 
+// ServiceLevelObjectivesByServiceWithResponse request returning *ServiceLevelObjectivesByServiceResponse
+func (c *ClientWithResponses) ServiceLevelObjectivesByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ServiceLevelObjectivesByServiceResponse, error) {
+	rsp, err := c.ServiceLevelObjectivesByService(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseServiceLevelObjectivesByServiceResponse(rsp)
+}
+
+// TcpStatsByServiceWithResponse request returning *TcpStatsByServiceResponse
+func (c *ClientWithResponses) TcpStatsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*TcpStatsByServiceResponse, error) {
+	rsp, err := c.TcpStatsByService(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTcpStatsByServiceResponse(rsp)
+}
+
 // SitepairsWithResponse request returning *SitepairsResponse
 func (c *ClientWithResponses) SitepairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SitepairsResponse, error) {
 	rsp, err := c.Sitepairs(ctx, reqEditors...)
@@ -3549,34 +4255,67 @@ func (c *ClientWithResponses) RoutersBySiteWithResponse(ctx context.Context, id
 	return ParseRoutersBySiteResponse(rsp)
 }
 
-// ParseApplicationflowsResponse parses an HTTP response from a ApplicationflowsWithResponse call
-func ParseApplicationflowsResponse(rsp *http.Response) (*ApplicationflowsResponse, error) {
+// ParseAnomaliesResponse parses an HTTP response from a AnomaliesWithResponse call
+func ParseAnomaliesResponse(rsp *http.Response) (*AnomaliesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApplicationflowsResponse{
+	response := &AnomaliesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetApplicationFlows
+		var dest GetAnomalies
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseComponentpairsResponse parses an HTTP response from a ComponentpairsWithResponse call
-func ParseComponentpairsResponse(rsp *http.Response) (*ComponentpairsResponse, error) {
+// ParseApplicationflowsResponse parses an HTTP response from a ApplicationflowsWithResponse call
+func ParseApplicationflowsResponse(rsp *http.Response) (*ApplicationflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplicationflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetApplicationFlows
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseComponentpairsResponse parses an HTTP response from a ComponentpairsWithResponse call
+func ParseComponentpairsResponse(rsp *http.Response) (*ComponentpairsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
@@ -3629,6 +4368,13 @@ func ParseComponentpairByIDResponse(rsp *http.Response) (*ComponentpairByIDRespo
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3695,6 +4441,13 @@ func ParseComponentByIDResponse(rsp *http.Response) (*ComponentByIDResponse, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3787,6 +4540,13 @@ func ParseConnectorByIDResponse(rsp *http.Response) (*ConnectorByIDResponse, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3846,6 +4606,13 @@ func ParseHostsByIDResponse(rsp *http.Response) (*HostsByIDResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3912,6 +4679,13 @@ func ParseListenerByIDResponse(rsp *http.Response) (*ListenerByIDResponse, error
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3924,6 +4698,39 @@ func ParseListenerByIDResponse(rsp *http.Response) (*ListenerByIDResponse, error
 	return response, nil
 }
 
+// ParsePolicyViolationsResponse parses an HTTP response from a PolicyViolationsWithResponse call
+func ParsePolicyViolationsResponse(rsp *http.Response) (*PolicyViolationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PolicyViolationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetPolicyViolations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseProcessesResponse parses an HTTP response from a ProcessesWithResponse call
 func ParseProcessesResponse(rsp *http.Response) (*ProcessesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3978,6 +4785,13 @@ func ParseProcessByIdResponse(rsp *http.Response) (*ProcessByIdResponse, error)
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4044,6 +4858,13 @@ func ParseProcesspairByIDResponse(rsp *http.Response) (*ProcesspairByIDResponse,
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4110,6 +4931,13 @@ func ParseRouteraccessByIDResponse(rsp *http.Response) (*RouteraccessByIDRespons
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4176,6 +5004,13 @@ func ParseRouterlinkByIDResponse(rsp *http.Response) (*RouterlinkByIDResponse, e
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4242,6 +5077,13 @@ func ParseRouterByIDResponse(rsp *http.Response) (*RouterByIDResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4308,6 +5150,53 @@ func ParseServiceByIDResponse(rsp *http.Response) (*ServiceByIDResponse, error)
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAnomaliesByServiceResponse parses an HTTP response from a AnomaliesByServiceWithResponse call
+func ParseAnomaliesByServiceResponse(rsp *http.Response) (*AnomaliesByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnomaliesByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAnomalies
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4341,6 +5230,13 @@ func ParseConnectionsByServiceResponse(rsp *http.Response) (*ConnectionsByServic
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4407,6 +5303,93 @@ func ParseProcessPairsByServiceResponse(rsp *http.Response) (*ProcessPairsByServ
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseServiceLevelObjectivesByServiceResponse parses an HTTP response from a ServiceLevelObjectivesByServiceWithResponse call
+func ParseServiceLevelObjectivesByServiceResponse(rsp *http.Response) (*ServiceLevelObjectivesByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ServiceLevelObjectivesByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetServiceLevelObjectives
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTcpStatsByServiceResponse parses an HTTP response from a TcpStatsByServiceWithResponse call
+func ParseTcpStatsByServiceResponse(rsp *http.Response) (*TcpStatsByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TcpStatsByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTCPStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4473,6 +5456,13 @@ func ParseSitepairByIDResponse(rsp *http.Response) (*SitepairByIDResponse, error
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4539,6 +5529,13 @@ func ParseSiteByIdResponse(rsp *http.Response) (*SiteByIdResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4598,6 +5595,13 @@ func ParseProcessesBySiteResponse(rsp *http.Response) (*ProcessesBySiteResponse,
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4631,6 +5635,13 @@ func ParseRoutersBySiteResponse(rsp *http.Response) (*RoutersBySiteResponse, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4646,6 +5657,9 @@ func ParseRoutersBySiteResponse(rsp *http.Response) (*RoutersBySiteResponse, err
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /api/v2alpha1/anomalies)
+	Anomalies(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v2alpha1/applicationflows)
 	Applicationflows(w http.ResponseWriter, r *http.Request)
 
@@ -4682,6 +5696,9 @@ type ServerInterface interface {
 	// (GET /api/v2alpha1/listeners/{id})
 	ListenerByID(w http.ResponseWriter, r *http.Request, id PathID)
 
+	// (GET /api/v2alpha1/policy/violations)
+	PolicyViolations(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v2alpha1/processes)
 	Processes(w http.ResponseWriter, r *http.Request)
 
@@ -4718,6 +5735,9 @@ type ServerInterface interface {
 	// (GET /api/v2alpha1/services/{id})
 	ServiceByID(w http.ResponseWriter, r *http.Request, id PathID)
 
+	// (GET /api/v2alpha1/services/{id}/anomalies)
+	AnomaliesByService(w http.ResponseWriter, r *http.Request, id PathID)
+
 	// (GET /api/v2alpha1/services/{id}/connections)
 	ConnectionsByService(w http.ResponseWriter, r *http.Request, id PathID)
 
@@ -4727,6 +5747,12 @@ type ServerInterface interface {
 	// (GET /api/v2alpha1/services/{id}/processpairs)
 	ProcessPairsByService(w http.ResponseWriter, r *http.Request, id PathID)
 
+	// (GET /api/v2alpha1/services/{id}/slo)
+	ServiceLevelObjectivesByService(w http.ResponseWriter, r *http.Request, id PathID)
+
+	// (GET /api/v2alpha1/services/{id}/tcpstats)
+	TcpStatsByService(w http.ResponseWriter, r *http.Request, id PathID)
+
 	// (GET /api/v2alpha1/sitepairs)
 	Sitepairs(w http.ResponseWriter, r *http.Request)
 
@@ -4758,6 +5784,21 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// Anomalies operation middleware
+func (siw *ServerInterfaceWrapper) Anomalies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Anomalies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Applicationflows operation middleware
 func (siw *ServerInterfaceWrapper) Applicationflows(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -4993,6 +6034,21 @@ func (siw *ServerInterfaceWrapper) ListenerByID(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// PolicyViolations operation middleware
+func (siw *ServerInterfaceWrapper) PolicyViolations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PolicyViolations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Processes operation middleware
 func (siw *ServerInterfaceWrapper) Processes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -5239,6 +6295,32 @@ func (siw *ServerInterfaceWrapper) ServiceByID(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// AnomaliesByService operation middleware
+func (siw *ServerInterfaceWrapper) AnomaliesByService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnomaliesByService(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ConnectionsByService operation middleware
 func (siw *ServerInterfaceWrapper) ConnectionsByService(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -5317,6 +6399,58 @@ func (siw *ServerInterfaceWrapper) ProcessPairsByService(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ServiceLevelObjectivesByService operation middleware
+func (siw *ServerInterfaceWrapper) ServiceLevelObjectivesByService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ServiceLevelObjectivesByService(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// TcpStatsByService operation middleware
+func (siw *ServerInterfaceWrapper) TcpStatsByService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TcpStatsByService(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Sitepairs operation middleware
 func (siw *ServerInterfaceWrapper) Sitepairs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -5590,6 +6724,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/anomalies", wrapper.Anomalies).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/applicationflows", wrapper.Applicationflows).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/componentpairs", wrapper.Componentpairs).Methods("GET")
@@ -5614,6 +6750,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/listeners/{id}", wrapper.ListenerByID).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/policy/violations", wrapper.PolicyViolations).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/processes", wrapper.Processes).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/processes/{id}", wrapper.ProcessById).Methods("GET")
@@ -5638,12 +6776,18 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}", wrapper.ServiceByID).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/anomalies", wrapper.AnomaliesByService).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/connections", wrapper.ConnectionsByService).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/processes", wrapper.ProcessesByService).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/processpairs", wrapper.ProcessPairsByService).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/slo", wrapper.ServiceLevelObjectivesByService).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/tcpstats", wrapper.TcpStatsByService).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sitepairs", wrapper.Sitepairs).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sitepairs/{id}", wrapper.SitepairByID).Methods("GET")
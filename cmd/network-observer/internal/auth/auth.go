@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Methods users are authenticated with
+const (
+	MethodBasic       = "basic"
+	MethodOIDC        = "oidc"
+	MethodTokenReview = "kubernetes"
+)
+
+// AllNamespaces grants a role access to the sites in any namespace
+const AllNamespaces = "*"
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when a request does
+	// not carry the credentials it authenticates
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned by an Authenticator when the
+	// credentials of a request are not valid
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// User is an authenticated user of the API
+type User struct {
+	Name   string
+	Groups []string
+	// Method is the method the user was authenticated with
+	Method string
+}
+
+// Authenticator authenticates the users of API requests
+type Authenticator interface {
+	// Authenticate returns the user the credentials of the request belong
+	// to, or ErrNoCredentials when the request does not carry credentials
+	// the Authenticator handles.
+	Authenticate(r *http.Request) (User, error)
+	// Challenge is the WWW-Authenticate header value sent to
+	// unauthenticated requests
+	Challenge() string
+}
+
+// Role grants the users and groups it names access to the records of the
+// sites in its namespaces
+type Role struct {
+	Name       string   `json:"name,omitempty"`
+	Users      []string `json:"users,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+	Namespaces []string `json:"namespaces"`
+}
+
+func (r Role) Validate() error {
+	if len(r.Users) == 0 && len(r.Groups) == 0 {
+		return errors.New("at least one of users or groups is required")
+	}
+	if len(r.Namespaces) == 0 {
+		return errors.New("namespaces is required")
+	}
+	return nil
+}
+
+func (r Role) grants(user User) bool {
+	if slices.Contains(r.Users, user.Name) {
+		return true
+	}
+	for _, group := range user.Groups {
+		if slices.Contains(r.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// Access is what an authenticated user is allowed to see
+type Access struct {
+	User User
+	// AllNamespaces is set when the user can see the sites in every
+	// namespace, otherwise the user can only see the sites in Namespaces
+	AllNamespaces bool
+	Namespaces    []string
+}
+
+// Authorize returns the access the roles grant the user. Without roles every
+// user can see the sites in all namespaces. It returns false when no role
+// grants the user access.
+func Authorize(roles []Role, user User) (Access, bool) {
+	access := Access{User: user}
+	if len(roles) == 0 {
+		access.AllNamespaces = true
+		return access, true
+	}
+	var granted bool
+	for _, role := range roles {
+		if !role.grants(user) {
+			continue
+		}
+		granted = true
+		for _, namespace := range role.Namespaces {
+			if namespace == AllNamespaces {
+				access.AllNamespaces = true
+				access.Namespaces = nil
+				return access, true
+			}
+			access.Namespaces = append(access.Namespaces, namespace)
+		}
+	}
+	access.Namespaces = slices.Compact(slices.Sorted(slices.Values(access.Namespaces)))
+	return access, granted
+}
+
+type accessKey struct{}
+
+// NewContext returns a context carrying the access of the user a request was
+// authenticated for
+func NewContext(ctx context.Context, access Access) context.Context {
+	return context.WithValue(ctx, accessKey{}, access)
+}
+
+// FromContext returns the access of the user a request was authenticated
+// for. It returns false when the request was not authenticated.
+func FromContext(ctx context.Context) (Access, bool) {
+	access, ok := ctx.Value(accessKey{}).(Access)
+	return access, ok
+}
+
+// Auth authenticates API requests and authorizes them against a set of
+// roles
+type Auth struct {
+	logger         *slog.Logger
+	authenticators []Authenticator
+	roles          []Role
+}
+
+// New returns an Auth authenticating requests with the first of the
+// authenticators that accepts their credentials.
+func New(logger *slog.Logger, authenticators []Authenticator, roles []Role) *Auth {
+	return &Auth{
+		logger:         logger,
+		authenticators: authenticators,
+		roles:          roles,
+	}
+}
+
+// Handler serves authorized requests with next, adding the access of their
+// user to their context
+func (a *Auth) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := a.authenticate(r)
+		if err != nil {
+			if !errors.Is(err, ErrNoCredentials) {
+				a.logger.Debug("authentication failed",
+					slog.String("path", r.URL.Path),
+					slog.Any("error", err))
+			}
+			var challenges []string
+			for _, authenticator := range a.authenticators {
+				if challenge := authenticator.Challenge(); !slices.Contains(challenges, challenge) {
+					challenges = append(challenges, challenge)
+					w.Header().Add("WWW-Authenticate", challenge)
+				}
+			}
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		access, ok := Authorize(a.roles, user)
+		if !ok {
+			a.logger.Debug("user not granted any role",
+				slog.String("user", user.Name),
+				slog.String("path", r.URL.Path))
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), access)))
+	})
+}
+
+func (a *Auth) authenticate(r *http.Request) (User, error) {
+	var errs []error
+	for _, authenticator := range a.authenticators {
+		user, err := authenticator.Authenticate(r)
+		if err == nil {
+			return user, nil
+		}
+		if !errors.Is(err, ErrNoCredentials) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return User{}, ErrNoCredentials
+	}
+	return User{}, errors.Join(errs...)
+}
+
+// RequireAllNamespaces serves requests with next only when their user can
+// see the sites in all namespaces. It is used for the routes that cannot be
+// scoped to namespaces.
+func RequireAllNamespaces(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if access, ok := FromContext(r.Context()); ok && !access.AllNamespaces {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns the bearer token of a request
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
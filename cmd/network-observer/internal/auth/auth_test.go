@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	roles := []Role{
+		{Name: "admins", Groups: []string{"admins"}, Namespaces: []string{AllNamespaces}},
+		{Name: "east", Users: []string{"alice"}, Groups: []string{"team-east"}, Namespaces: []string{"east", "shared"}},
+		{Name: "west", Users: []string{"alice"}, Namespaces: []string{"west", "shared"}},
+	}
+	testCases := []struct {
+		Name         string
+		Roles        []Role
+		User         User
+		ExpectOK     bool
+		ExpectAccess Access
+	}{
+		{
+			Name:         "no roles",
+			User:         User{Name: "bob"},
+			ExpectOK:     true,
+			ExpectAccess: Access{User: User{Name: "bob"}, AllNamespaces: true},
+		}, {
+			Name:  "no role granted",
+			Roles: roles,
+			User:  User{Name: "bob", Groups: []string{"team-west"}},
+		}, {
+			Name:         "all namespaces",
+			Roles:        roles,
+			User:         User{Name: "carol", Groups: []string{"team-east", "admins"}},
+			ExpectOK:     true,
+			ExpectAccess: Access{User: User{Name: "carol", Groups: []string{"team-east", "admins"}}, AllNamespaces: true},
+		}, {
+			Name:         "by group",
+			Roles:        roles,
+			User:         User{Name: "dave", Groups: []string{"team-east"}},
+			ExpectOK:     true,
+			ExpectAccess: Access{User: User{Name: "dave", Groups: []string{"team-east"}}, Namespaces: []string{"east", "shared"}},
+		}, {
+			Name:         "union of roles",
+			Roles:        roles,
+			User:         User{Name: "alice"},
+			ExpectOK:     true,
+			ExpectAccess: Access{User: User{Name: "alice"}, Namespaces: []string{"east", "shared", "west"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			access, ok := Authorize(tc.Roles, tc.User)
+			assert.Equal(t, ok, tc.ExpectOK)
+			if tc.ExpectOK {
+				assert.DeepEqual(t, access, tc.ExpectAccess)
+			}
+		})
+	}
+}
+
+func TestRoleValidate(t *testing.T) {
+	assert.ErrorContains(t, Role{Namespaces: []string{"east"}}.Validate(), "users or groups")
+	assert.ErrorContains(t, Role{Users: []string{"alice"}}.Validate(), "namespaces")
+	assert.Assert(t, Role{Groups: []string{"team-east"}, Namespaces: []string{"east"}}.Validate())
+}
+
+type staticAuthenticator struct {
+	token string
+	user  User
+}
+
+func (a staticAuthenticator) Authenticate(r *http.Request) (User, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return User{}, ErrNoCredentials
+	}
+	if token != a.token {
+		return User{}, ErrInvalidCredentials
+	}
+	return a.user, nil
+}
+
+func (a staticAuthenticator) Challenge() string { return "Bearer" }
+
+func TestHandler(t *testing.T) {
+	htpasswd, err := ParseHtpasswd(strings.NewReader("admin:{PLAIN}secret\n"), "observer")
+	assert.Assert(t, err)
+	a := New(slog.Default(), []Authenticator{
+		htpasswd,
+		staticAuthenticator{token: "alice-token", user: User{Name: "alice", Method: MethodOIDC}},
+		staticAuthenticator{token: "bob-token", user: User{Name: "bob", Method: MethodTokenReview}},
+	}, []Role{
+		{Users: []string{"admin"}, Namespaces: []string{AllNamespaces}},
+		{Users: []string{"alice"}, Namespaces: []string{"east"}},
+	})
+	handler := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		access, ok := FromContext(r.Context())
+		assert.Assert(t, ok)
+		w.Write([]byte(access.User.Name + ":" + strings.Join(access.Namespaces, ",")))
+	}))
+
+	testCases := []struct {
+		Name            string
+		Request         func(r *http.Request)
+		ExpectStatus    int
+		ExpectBody      string
+		ExpectChallenge []string
+	}{
+		{
+			Name:            "no credentials",
+			Request:         func(r *http.Request) {},
+			ExpectStatus:    401,
+			ExpectChallenge: []string{`Basic realm="observer"`, "Bearer"},
+		}, {
+			Name:            "invalid password",
+			Request:         func(r *http.Request) { r.SetBasicAuth("admin", "guess") },
+			ExpectStatus:    401,
+			ExpectChallenge: []string{`Basic realm="observer"`, "Bearer"},
+		}, {
+			Name:         "basic",
+			Request:      func(r *http.Request) { r.SetBasicAuth("admin", "secret") },
+			ExpectStatus: 200,
+			ExpectBody:   "admin:",
+		}, {
+			Name:         "bearer",
+			Request:      func(r *http.Request) { r.Header.Set("Authorization", "Bearer alice-token") },
+			ExpectStatus: 200,
+			ExpectBody:   "alice:east",
+		}, {
+			Name:         "no role",
+			Request:      func(r *http.Request) { r.Header.Set("Authorization", "Bearer bob-token") },
+			ExpectStatus: 403,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2alpha1/sites", nil)
+			tc.Request(req)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, rec.Code, tc.ExpectStatus)
+			if tc.ExpectBody != "" {
+				assert.Equal(t, rec.Body.String(), tc.ExpectBody)
+			}
+			if tc.ExpectChallenge != nil {
+				assert.DeepEqual(t, rec.Header().Values("WWW-Authenticate"), tc.ExpectChallenge)
+			}
+		})
+	}
+}
+
+func TestRequireAllNamespaces(t *testing.T) {
+	handler := RequireAllNamespaces(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	testCases := []struct {
+		Name         string
+		Access       *Access
+		ExpectStatus int
+	}{
+		{Name: "unauthenticated", ExpectStatus: 200},
+		{Name: "all namespaces", Access: &Access{AllNamespaces: true}, ExpectStatus: 200},
+		{Name: "scoped", Access: &Access{Namespaces: []string{"east"}}, ExpectStatus: 403},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2alpha1/internal/alerts", nil)
+			if tc.Access != nil {
+				req = req.WithContext(NewContext(req.Context(), *tc.Access))
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, rec.Code, tc.ExpectStatus)
+		})
+	}
+}
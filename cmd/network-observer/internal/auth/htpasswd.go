@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	htpasswdSHAPrefix   = "{SHA}"
+	htpasswdPlainPrefix = "{PLAIN}"
+)
+
+// Htpasswd authenticates basic auth credentials against the users of an
+// htpasswd file. Passwords hashed with bcrypt or SHA-1, and plain text
+// passwords prefixed with {PLAIN} are supported.
+type Htpasswd struct {
+	realm string
+	users map[string]string
+}
+
+// LoadHtpasswd reads the users of the htpasswd file at path
+func LoadHtpasswd(path string, realm string) (*Htpasswd, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseHtpasswd(file, realm)
+}
+
+// ParseHtpasswd reads the users of an htpasswd file
+func ParseHtpasswd(r io.Reader, realm string) (*Htpasswd, error) {
+	h := &Htpasswd{
+		realm: realm,
+		users: make(map[string]string),
+	}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, hash, ok := strings.Cut(line, ":")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("line %d: expected user:password", n)
+		}
+		if !supportedHash(hash) {
+			return nil, fmt.Errorf("line %d: unsupported password format for user %q", n, name)
+		}
+		h.users[name] = hash
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func supportedHash(hash string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$", htpasswdSHAPrefix, htpasswdPlainPrefix} {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Htpasswd) Authenticate(r *http.Request) (User, error) {
+	name, password, ok := r.BasicAuth()
+	if !ok {
+		return User{}, ErrNoCredentials
+	}
+	hash, ok := h.users[name]
+	if !ok || !checkPassword(hash, password) {
+		return User{}, ErrInvalidCredentials
+	}
+	return User{Name: name, Method: MethodBasic}, nil
+}
+
+func (h *Htpasswd) Challenge() string {
+	return fmt.Sprintf("Basic realm=%q", h.realm)
+}
+
+func checkPassword(hash string, password string) bool {
+	switch {
+	case strings.HasPrefix(hash, htpasswdSHAPrefix):
+		sum := sha1.Sum([]byte(password))
+		expected := htpasswdSHAPrefix + base64.StdEncoding.EncodeToString(sum[:])
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) == 1
+	case strings.HasPrefix(hash, htpasswdPlainPrefix):
+		return subtle.ConstantTimeCompare([]byte(hash), []byte(htpasswdPlainPrefix+password)) == 1
+	default:
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+}
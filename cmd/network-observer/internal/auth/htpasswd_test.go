@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+	"gotest.tools/v3/assert"
+)
+
+func TestHtpasswd(t *testing.T) {
+	bcrypted, err := bcrypt.GenerateFromPassword([]byte("bcrypt-password"), bcrypt.MinCost)
+	assert.Assert(t, err)
+	htpasswd, err := ParseHtpasswd(strings.NewReader(strings.Join([]string{
+		"# users",
+		"alice:" + string(bcrypted),
+		// htpasswd -s
+		"bob:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=",
+		"",
+		"skupper:{PLAIN}plain-password",
+	}, "\n")), "observer")
+	assert.Assert(t, err)
+
+	testCases := []struct {
+		Name        string
+		User        string
+		Password    string
+		NoAuth      bool
+		ExpectError error
+	}{
+		{Name: "bcrypt", User: "alice", Password: "bcrypt-password"},
+		{Name: "bcrypt wrong password", User: "alice", Password: "password", ExpectError: ErrInvalidCredentials},
+		{Name: "sha", User: "bob", Password: "password"},
+		{Name: "sha wrong password", User: "bob", Password: "Password", ExpectError: ErrInvalidCredentials},
+		{Name: "plain", User: "skupper", Password: "plain-password"},
+		{Name: "unknown user", User: "carol", Password: "password", ExpectError: ErrInvalidCredentials},
+		{Name: "no credentials", NoAuth: true, ExpectError: ErrNoCredentials},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if !tc.NoAuth {
+				req.SetBasicAuth(tc.User, tc.Password)
+			}
+			user, err := htpasswd.Authenticate(req)
+			if tc.ExpectError != nil {
+				assert.Assert(t, errors.Is(err, tc.ExpectError))
+				return
+			}
+			assert.Assert(t, err)
+			assert.DeepEqual(t, user, User{Name: tc.User, Method: MethodBasic})
+		})
+	}
+}
+
+func TestParseHtpasswdErrors(t *testing.T) {
+	_, err := ParseHtpasswd(strings.NewReader("alice:$apr1$salt$hash"), "observer")
+	assert.ErrorContains(t, err, `line 1: unsupported password format for user "alice"`)
+	_, err = ParseHtpasswd(strings.NewReader("# users\nalice"), "observer")
+	assert.ErrorContains(t, err, "line 2: expected user:password")
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// OIDCConfig configures the verification of OIDC bearer tokens
+type OIDCConfig struct {
+	// Issuer is the URL of the OIDC issuer tokens are verified against
+	Issuer string
+	// ClientID is the audience tokens must be issued for
+	ClientID string
+	// UsernameClaim is the claim holding the name of the user. Defaults to
+	// sub.
+	UsernameClaim string
+	// GroupsClaim is the claim holding the groups of the user. Defaults to
+	// groups.
+	GroupsClaim string
+	// Client is the client used to reach the issuer
+	Client *http.Client
+}
+
+// OIDC authenticates bearer tokens issued by an OIDC provider
+type OIDC struct {
+	verifier      *oidc.IDTokenVerifier
+	usernameClaim string
+	groupsClaim   string
+}
+
+// NewOIDC discovers the provider configuration of the issuer, and returns an
+// OIDC verifying tokens with its keys
+func NewOIDC(ctx context.Context, cfg OIDCConfig) (*OIDC, error) {
+	if cfg.Client != nil {
+		ctx = oidc.ClientContext(ctx, cfg.Client)
+	}
+	provider, err := oidc.NewProvider(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover oidc provider: %w", err)
+	}
+	o := &OIDC{
+		verifier:      provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+		usernameClaim: cfg.UsernameClaim,
+		groupsClaim:   cfg.GroupsClaim,
+	}
+	if o.usernameClaim == "" {
+		o.usernameClaim = "sub"
+	}
+	if o.groupsClaim == "" {
+		o.groupsClaim = "groups"
+	}
+	return o, nil
+}
+
+func (o *OIDC) Authenticate(r *http.Request) (User, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return User{}, ErrNoCredentials
+	}
+	idToken, err := o.verifier.Verify(r.Context(), token)
+	if err != nil {
+		return User{}, fmt.Errorf("%w: %s", ErrInvalidCredentials, err)
+	}
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return User{}, fmt.Errorf("%w: %s", ErrInvalidCredentials, err)
+	}
+	name, _ := claims[o.usernameClaim].(string)
+	if name == "" {
+		return User{}, fmt.Errorf("%w: token has no %s claim", ErrInvalidCredentials, o.usernameClaim)
+	}
+	user := User{Name: name, Method: MethodOIDC}
+	groups, _ := claims[o.groupsClaim].([]any)
+	for _, group := range groups {
+		if group, ok := group.(string); ok {
+			user.Groups = append(user.Groups, group)
+		}
+	}
+	return user, nil
+}
+
+func (o *OIDC) Challenge() string {
+	return "Bearer"
+}
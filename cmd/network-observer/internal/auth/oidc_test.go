@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"gotest.tools/v3/assert"
+)
+
+// testIssuer is a stub OIDC issuer serving its discovery document and keys
+type testIssuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Assert(t, err)
+	issuer := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                issuer.URL,
+			"jwks_uri":                              issuer.URL + "/keys",
+			"authorization_endpoint":                issuer.URL + "/auth",
+			"token_endpoint":                        issuer.URL + "/token",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       key.Public(),
+			KeyID:     "key-1",
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	return issuer
+}
+
+func (i *testIssuer) sign(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key-1"),
+	)
+	assert.Assert(t, err)
+	payload, err := json.Marshal(claims)
+	assert.Assert(t, err)
+	signed, err := signer.Sign(payload)
+	assert.Assert(t, err)
+	token, err := signed.CompactSerialize()
+	assert.Assert(t, err)
+	return token
+}
+
+func TestOIDC(t *testing.T) {
+	issuer := newTestIssuer(t)
+	defer issuer.Close()
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Assert(t, err)
+
+	o, err := NewOIDC(context.Background(), OIDCConfig{
+		Issuer:        issuer.URL,
+		ClientID:      "network-observer",
+		UsernameClaim: "preferred_username",
+	})
+	assert.Assert(t, err)
+
+	now := time.Now()
+	claims := func(overrides map[string]any) map[string]any {
+		out := map[string]any{
+			"iss":                issuer.URL,
+			"aud":                "network-observer",
+			"sub":                "1234",
+			"preferred_username": "alice",
+			"groups":             []string{"team-east", "admins"},
+			"iat":                now.Unix(),
+			"exp":                now.Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			out[k] = v
+		}
+		return out
+	}
+
+	testCases := []struct {
+		Name          string
+		Authorization string
+		ExpectError   error
+		ExpectUser    User
+	}{
+		{
+			Name:          "valid",
+			Authorization: "Bearer " + issuer.sign(t, issuer.key, claims(nil)),
+			ExpectUser:    User{Name: "alice", Groups: []string{"team-east", "admins"}, Method: MethodOIDC},
+		}, {
+			Name:          "no credentials",
+			Authorization: "Basic YWxpY2U6c2VjcmV0",
+			ExpectError:   ErrNoCredentials,
+		}, {
+			Name:          "expired",
+			Authorization: "Bearer " + issuer.sign(t, issuer.key, claims(map[string]any{"exp": now.Add(-time.Minute).Unix()})),
+			ExpectError:   ErrInvalidCredentials,
+		}, {
+			Name:          "wrong audience",
+			Authorization: "Bearer " + issuer.sign(t, issuer.key, claims(map[string]any{"aud": "console"})),
+			ExpectError:   ErrInvalidCredentials,
+		}, {
+			Name:          "wrong issuer",
+			Authorization: "Bearer " + issuer.sign(t, issuer.key, claims(map[string]any{"iss": "https://issuer.example.com"})),
+			ExpectError:   ErrInvalidCredentials,
+		}, {
+			Name:          "wrong key",
+			Authorization: "Bearer " + issuer.sign(t, otherKey, claims(nil)),
+			ExpectError:   ErrInvalidCredentials,
+		}, {
+			Name:          "no username",
+			Authorization: "Bearer " + issuer.sign(t, issuer.key, claims(map[string]any{"preferred_username": nil})),
+			ExpectError:   ErrInvalidCredentials,
+		}, {
+			Name:          "malformed",
+			Authorization: "Bearer token",
+			ExpectError:   ErrInvalidCredentials,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", tc.Authorization)
+			user, err := o.Authenticate(req)
+			if tc.ExpectError != nil {
+				assert.Assert(t, errors.Is(err, tc.ExpectError), "unexpected error: %v", err)
+				return
+			}
+			assert.Assert(t, err)
+			assert.DeepEqual(t, user, tc.ExpectUser)
+		})
+	}
+}
+
+func TestOIDCDiscoveryError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	_, err := NewOIDC(context.Background(), OIDCConfig{Issuer: srv.URL, ClientID: "network-observer"})
+	assert.ErrorContains(t, err, "failed to discover oidc provider")
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// maxCachedReviews bounds the number of token reviews cached
+	maxCachedReviews = 1024
+	// maxFailedReviewTTL bounds how long tokens that failed a review are
+	// cached, so that requests with unique invalid tokens do not fill the
+	// cache
+	maxFailedReviewTTL = 5 * time.Second
+)
+
+// TokenReview authenticates bearer tokens with the Kubernetes TokenReview
+// API. Reviews are cached for ttl so that clients polling the API do not
+// create a review per request.
+type TokenReview struct {
+	client    kubernetes.Interface
+	audiences []string
+	ttl       time.Duration
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]cachedReview
+}
+
+type cachedReview struct {
+	user    User
+	err     error
+	expires time.Time
+}
+
+// NewTokenReview returns a TokenReview authenticating tokens issued for the
+// audiences, or for the API server when none are set
+func NewTokenReview(client kubernetes.Interface, audiences []string, ttl time.Duration) *TokenReview {
+	return &TokenReview{
+		client:    client,
+		audiences: audiences,
+		ttl:       ttl,
+		cache:     make(map[[sha256.Size]byte]cachedReview),
+	}
+}
+
+func (t *TokenReview) Authenticate(r *http.Request) (User, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return User{}, ErrNoCredentials
+	}
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	t.mu.Lock()
+	cached, ok := t.cache[key]
+	t.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.user, cached.err
+	}
+
+	user, err := t.review(r, token)
+	if err != nil && !errors.Is(err, ErrInvalidCredentials) {
+		// failures to review the token are not cached
+		return user, err
+	}
+	ttl := t.ttl
+	if err != nil {
+		ttl = min(ttl, maxFailedReviewTTL)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for k, review := range t.cache {
+		if now.After(review.expires) {
+			delete(t.cache, k)
+		}
+	}
+	if len(t.cache) >= maxCachedReviews {
+		if err != nil {
+			return user, err
+		}
+		t.evictNextExpiring()
+	}
+	t.cache[key] = cachedReview{user: user, err: err, expires: now.Add(ttl)}
+	return user, err
+}
+
+// evictNextExpiring removes the cached review expiring first
+func (t *TokenReview) evictNextExpiring() {
+	var (
+		next    [sha256.Size]byte
+		expires time.Time
+	)
+	for k, review := range t.cache {
+		if expires.IsZero() || review.expires.Before(expires) {
+			next, expires = k, review.expires
+		}
+	}
+	delete(t.cache, next)
+}
+
+func (t *TokenReview) review(r *http.Request, token string) (User, error) {
+	review, err := t.client.AuthenticationV1().TokenReviews().Create(r.Context(), &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: t.audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return User{}, fmt.Errorf("token review failed: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return User{}, fmt.Errorf("%w: %s", ErrInvalidCredentials, review.Status.Error)
+	}
+	return User{
+		Name:   review.Status.User.Username,
+		Groups: review.Status.User.Groups,
+		Method: MethodTokenReview,
+	}, nil
+}
+
+func (t *TokenReview) Challenge() string {
+	return "Bearer"
+}
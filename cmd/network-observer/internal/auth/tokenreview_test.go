@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestTokenReview(t *testing.T) {
+	client := fake.NewClientset()
+	var reviews int
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "sa-token":
+			assert.DeepEqual(t, review.Spec.Audiences, []string{"network-observer"})
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:east:reader",
+					Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:east"},
+				},
+			}
+		case "unavailable":
+			return true, nil, errors.New("connection refused")
+		default:
+			review.Status = authenticationv1.TokenReviewStatus{Error: "invalid bearer token"}
+		}
+		return true, review, nil
+	})
+	tokenReview := NewTokenReview(client, []string{"network-observer"}, time.Minute)
+
+	authenticate := func(authorization string) (User, error) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return tokenReview.Authenticate(req)
+	}
+
+	_, err := authenticate("")
+	assert.Assert(t, errors.Is(err, ErrNoCredentials))
+	assert.Equal(t, reviews, 0)
+
+	user, err := authenticate("Bearer sa-token")
+	assert.Assert(t, err)
+	assert.DeepEqual(t, user, User{
+		Name:   "system:serviceaccount:east:reader",
+		Groups: []string{"system:serviceaccounts", "system:serviceaccounts:east"},
+		Method: MethodTokenReview,
+	})
+	_, err = authenticate("Bearer sa-token")
+	assert.Assert(t, err)
+	assert.Equal(t, reviews, 1, "expected review to be cached")
+
+	for range 2 {
+		_, err = authenticate("Bearer expired-token")
+		assert.ErrorContains(t, err, "invalid bearer token")
+		assert.Assert(t, errors.Is(err, ErrInvalidCredentials))
+	}
+	assert.Equal(t, reviews, 2, "expected failed review to be cached")
+
+	for range 2 {
+		_, err = authenticate("Bearer unavailable")
+		assert.ErrorContains(t, err, "token review failed")
+		assert.Assert(t, !errors.Is(err, ErrInvalidCredentials))
+	}
+	assert.Equal(t, reviews, 4, "expected review errors not to be cached")
+
+	tokenReview.mu.Lock()
+	assert.Assert(t, tokenReview.cache[sha256.Sum256([]byte("expired-token"))].expires.Before(time.Now().Add(maxFailedReviewTTL+time.Second)),
+		"expected failed review to be cached briefly")
+	tokenReview.mu.Unlock()
+
+	for i := range maxCachedReviews + 8 {
+		_, err = authenticate(fmt.Sprintf("Bearer invalid-%d", i))
+		assert.Assert(t, errors.Is(err, ErrInvalidCredentials))
+	}
+	tokenReview.mu.Lock()
+	assert.Equal(t, len(tokenReview.cache), maxCachedReviews)
+	tokenReview.mu.Unlock()
+	_, err = authenticate("Bearer sa-token")
+	assert.Assert(t, err)
+	assert.Equal(t, reviews, 4+maxCachedReviews+8, "expected valid review to stay cached when the cache is full")
+}
@@ -21,14 +21,14 @@ type addressManager struct {
 	source              store.SourceRef
 }
 
-func newAddressManager(logger *slog.Logger, stor store.Interface) *addressManager {
+func newAddressManager(logger *slog.Logger, stor store.Interface, idp idProvider) *addressManager {
 	return &addressManager{
 		logger:              logger,
 		stor:                stor,
 		addresses:           make(map[string]struct{}),
 		updateAddresses:     make(chan AddressRecord, 16),
 		maybePurgeAddresses: make(chan AddressRecord, 16),
-		idp:                 newStableIdentityProvider(),
+		idp:                 idp,
 		source: store.SourceRef{
 			Version: "0.1",
 			ID:      "self",
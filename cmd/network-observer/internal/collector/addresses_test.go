@@ -99,7 +99,7 @@ func TestAddressManager(t *testing.T) {
 
 			testCtx, cancel := context.WithCancel(context.Background())
 			defer cancel()
-			manager := newAddressManager(tlog, stor)
+			manager := newAddressManager(tlog, stor, newStableIdentityProvider())
 			go manager.run(testCtx)()
 
 			for _, phase := range tc.Phases {
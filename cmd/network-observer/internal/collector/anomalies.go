@@ -0,0 +1,424 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+const (
+	AnomalyConnectionRate = "connectionRate"
+	AnomalyBytes          = "bytes"
+	AnomalyErrorRatio     = "errorRatio"
+	AnomalyNewSourceSite  = "newSourceSite"
+
+	// anomalyInterval is the period traffic is counted over before being
+	// compared to the baseline
+	anomalyInterval = time.Minute
+	// anomalyRetention is how long anomalies are kept after they end
+	anomalyRetention = 24 * time.Hour
+	// maxAnomalyRecords bounds the anomalies kept once ended
+	maxAnomalyRecords = 1000
+
+	hoursPerWeek = 7 * 24
+	// baselineMinSamples is the number of intervals observed in an hour of
+	// the week before deviations from it are flagged
+	baselineMinSamples = 30
+	// baselineMinWeight is the weight of each new interval once the baseline
+	// has seen enough of them, so that it keeps adapting to the traffic
+	// over a few weeks
+	baselineMinWeight = 0.01
+)
+
+// AnomalyDetection configures how traffic to each address is compared to
+// the baselines learned for it.
+type AnomalyDetection struct {
+	// Threshold is the number of standard deviations from the baseline
+	// traffic is flagged at. Zero disables anomaly detection.
+	Threshold float64
+	// LearningPeriod is how long the sites traffic to an address comes from
+	// are learned before traffic from new sites is flagged.
+	LearningPeriod time.Duration
+}
+
+// baselineStat is an exponentially weighted mean and variance of the values
+// observed for an hour of the week.
+type baselineStat struct {
+	N        uint64
+	Mean     float64
+	Variance float64
+}
+
+func (s *baselineStat) observe(v float64) {
+	s.N++
+	weight := max(1/float64(s.N), baselineMinWeight)
+	delta := v - s.Mean
+	s.Mean += weight * delta
+	s.Variance = (1 - weight) * (s.Variance + weight*delta*delta)
+}
+
+// score is the number of standard deviations v is from the mean, with the
+// deviation floored at minStdDev or a tenth of the mean so that baselines
+// that barely vary do not flag every small change.
+func (s *baselineStat) score(v float64, minStdDev float64) (score float64, stdDev float64) {
+	stdDev = max(math.Sqrt(s.Variance), minStdDev, math.Abs(s.Mean)/10)
+	return (v - s.Mean) / stdDev, stdDev
+}
+
+// anomalyStats are the traffic statistics baselines are kept for
+var anomalyStats = []struct {
+	Kind      string
+	MinStdDev float64
+	// Increases only flags values above the baseline
+	Increases bool
+}{
+	{Kind: AnomalyConnectionRate, MinStdDev: 1},
+	{Kind: AnomalyBytes, MinStdDev: 1024},
+	{Kind: AnomalyErrorRatio, MinStdDev: 0.01, Increases: true},
+}
+
+type sourceSite struct {
+	Name        string
+	Connections uint64
+}
+
+type addressTraffic struct {
+	RoutingKey string
+	Protocol   string
+	FirstSeen  time.Time
+
+	// baselines for each of anomalyStats by hour of the week
+	baselines [hoursPerWeek][3]baselineStat
+	// knownSources are the IDs of the sites traffic came from
+	knownSources map[string]struct{}
+
+	// traffic counted over the current interval
+	connections uint64
+	bytes       uint64
+	requests    uint64
+	errors      uint64
+	sources     map[string]*sourceSite
+}
+
+func (t *addressTraffic) values() [3]float64 {
+	var errorRatio float64 = -1
+	if t.requests > 0 {
+		errorRatio = float64(t.errors) / float64(t.requests)
+	}
+	return [3]float64{float64(t.connections), float64(t.bytes), errorRatio}
+}
+
+// topSource is the site most connections came from over the interval
+func (t *addressTraffic) topSource() NamedReference {
+	var (
+		top         NamedReference
+		connections uint64
+	)
+	for id, source := range t.sources {
+		if source.Connections > connections || (source.Connections == connections && id < top.ID) {
+			top, connections = NamedReference{ID: id, Name: source.Name}, source.Connections
+		}
+	}
+	return top
+}
+
+func (t *addressTraffic) reset() {
+	t.connections, t.bytes, t.requests, t.errors = 0, 0, 0, 0
+	clear(t.sources)
+}
+
+type anomalyKey struct {
+	RoutingKey string
+	Kind       string
+	SiteID     string
+}
+
+// anomalyDetector learns baselines of the connections, bytes and request
+// errors for each address by hour of the week from the flows handled by the
+// connection managers of each event source, and maintains an AnomalyRecord
+// for each significant deviation from them.
+type anomalyDetector struct {
+	logger *slog.Logger
+	stor   store.Interface
+	idp    idProvider
+	source store.SourceRef
+	cfg    AnomalyDetection
+	now    func() time.Time
+
+	detected *prometheus.CounterVec
+	scores   *prometheus.GaugeVec
+
+	mu        sync.Mutex
+	addresses map[string]*addressTraffic
+	// active anomalies, only accessed from evaluate
+	active map[anomalyKey]AnomalyRecord
+	ended  []AnomalyRecord
+}
+
+func newAnomalyDetector(logger *slog.Logger, stor store.Interface, idp idProvider, reg prometheus.Registerer, cfg AnomalyDetection) *anomalyDetector {
+	d := &anomalyDetector{
+		logger: logger,
+		stor:   stor,
+		idp:    idp,
+		cfg:    cfg,
+		now:    time.Now,
+		source: store.SourceRef{
+			Version: "0.1",
+			ID:      "self",
+		},
+		detected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "skupper",
+			Name:      "anomalies_total",
+			Help:      "Count of the deviations from the baseline traffic to each address",
+		}, []string{"routing_key", "kind"}),
+		scores: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "skupper",
+			Name:      "anomaly_score",
+			Help:      "Number of standard deviations the traffic to each address was from its baseline over the last interval",
+		}, []string{"routing_key", "kind"}),
+		addresses: make(map[string]*addressTraffic),
+		active:    make(map[anomalyKey]AnomalyRecord),
+	}
+	if !d.disabled() {
+		reg.MustRegister(d.detected, d.scores)
+	}
+	return d
+}
+
+func (d *anomalyDetector) disabled() bool {
+	return d == nil || d.cfg.Threshold <= 0
+}
+
+func (d *anomalyDetector) run(ctx context.Context) func() error {
+	return func() error {
+		if d.disabled() {
+			return nil
+		}
+		ticker := time.NewTicker(anomalyInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				d.evaluate()
+			}
+		}
+	}
+}
+
+// observeConnection counts a connection opened to an address from a site
+func (d *anomalyDetector) observeConnection(routingKey, protocol, siteID, siteName string) {
+	d.observe(routingKey, protocol, func(t *addressTraffic) {
+		t.connections++
+		if siteID == "" {
+			return
+		}
+		source, ok := t.sources[siteID]
+		if !ok {
+			source = &sourceSite{Name: siteName}
+			t.sources[siteID] = source
+		}
+		source.Connections++
+	})
+}
+
+// observeBytes counts the bytes sent and received by connections to an
+// address
+func (d *anomalyDetector) observeBytes(routingKey, protocol string, bytes uint64) {
+	d.observe(routingKey, protocol, func(t *addressTraffic) {
+		t.bytes += bytes
+	})
+}
+
+// observeRequest counts a completed request to an address
+func (d *anomalyDetector) observeRequest(routingKey, protocol string, responseClass string) {
+	d.observe(routingKey, protocol, func(t *addressTraffic) {
+		t.requests++
+		if responseClass == "5xx" {
+			t.errors++
+		}
+	})
+}
+
+func (d *anomalyDetector) observe(routingKey, protocol string, count func(*addressTraffic)) {
+	if d.disabled() || routingKey == "" {
+		return
+	}
+	now := d.now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	traffic, ok := d.addresses[routingKey]
+	if !ok {
+		traffic = &addressTraffic{
+			RoutingKey:   routingKey,
+			FirstSeen:    now,
+			knownSources: make(map[string]struct{}),
+			sources:      make(map[string]*sourceSite),
+		}
+		d.addresses[routingKey] = traffic
+	}
+	traffic.Protocol = protocol
+	count(traffic)
+}
+
+func hourOfWeek(t time.Time) int {
+	t = t.UTC()
+	return int(t.Weekday())*24 + t.Hour()
+}
+
+// detect compares the traffic counted over the last interval to the
+// baselines, learns it and resets the counts for the next interval.
+func (d *anomalyDetector) detect(now time.Time) map[anomalyKey]AnomalyRecord {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	slot := hourOfWeek(now.Add(-anomalyInterval))
+	found := make(map[anomalyKey]AnomalyRecord)
+	for _, traffic := range d.addresses {
+		for i, value := range traffic.values() {
+			stat := anomalyStats[i]
+			if value < 0 {
+				continue
+			}
+			baseline := &traffic.baselines[slot][i]
+			if baseline.N >= baselineMinSamples {
+				score, stdDev := baseline.score(value, stat.MinStdDev)
+				d.scores.WithLabelValues(traffic.RoutingKey, stat.Kind).Set(score)
+				if score >= d.cfg.Threshold || (!stat.Increases && -score >= d.cfg.Threshold) {
+					anomaly := AnomalyRecord{
+						RoutingKey: traffic.RoutingKey,
+						Protocol:   traffic.Protocol,
+						Kind:       stat.Kind,
+						Observed:   value,
+						Expected:   baseline.Mean,
+						StdDev:     stdDev,
+						Score:      score,
+					}
+					if stat.Kind == AnomalyConnectionRate {
+						anomaly.SourceSite = traffic.topSource()
+					}
+					found[anomalyKey{RoutingKey: traffic.RoutingKey, Kind: stat.Kind}] = anomaly
+				}
+			}
+			baseline.observe(value)
+		}
+
+		learning := now.Sub(traffic.FirstSeen) < d.cfg.LearningPeriod
+		for siteID, source := range traffic.sources {
+			if _, ok := traffic.knownSources[siteID]; ok {
+				continue
+			}
+			traffic.knownSources[siteID] = struct{}{}
+			if learning {
+				continue
+			}
+			found[anomalyKey{RoutingKey: traffic.RoutingKey, Kind: AnomalyNewSourceSite, SiteID: siteID}] = AnomalyRecord{
+				RoutingKey: traffic.RoutingKey,
+				Protocol:   traffic.Protocol,
+				Kind:       AnomalyNewSourceSite,
+				Observed:   float64(source.Connections),
+				SourceSite: NamedReference{ID: siteID, Name: source.Name},
+			}
+		}
+		traffic.reset()
+	}
+	return found
+}
+
+// evaluate flags the deviations from the baselines over the last interval,
+// and updates the records of anomalies that started, continued or ended.
+func (d *anomalyDetector) evaluate() {
+	now := d.now()
+	found := d.detect(now)
+
+	for key, anomaly := range found {
+		if prev, ok := d.active[key]; ok {
+			anomaly.ID, anomaly.Start = prev.ID, prev.Start
+			anomaly.Score = maxAbs(prev.Score, anomaly.Score)
+			anomaly.Description = describeAnomaly(anomaly)
+			d.stor.Update(anomaly)
+			d.active[key] = anomaly
+			continue
+		}
+		anomaly.ID = d.idp.ID("anomaly", key.RoutingKey, key.Kind, key.SiteID, now.Format(time.RFC3339Nano))
+		anomaly.Start = now.Add(-anomalyInterval)
+		anomaly.Description = describeAnomaly(anomaly)
+		d.logger.Info("anomaly detected",
+			slog.String("routing_key", anomaly.RoutingKey),
+			slog.String("kind", anomaly.Kind),
+			slog.String("description", anomaly.Description))
+		d.detected.WithLabelValues(anomaly.RoutingKey, anomaly.Kind).Inc()
+		d.stor.Add(anomaly, d.source)
+		d.active[key] = anomaly
+	}
+	for key, anomaly := range d.active {
+		if _, ok := found[key]; ok {
+			continue
+		}
+		anomaly.End = now.Add(-anomalyInterval)
+		d.stor.Update(anomaly)
+		d.ended = append(d.ended, anomaly)
+		delete(d.active, key)
+	}
+
+	// ended anomalies are kept in the order they ended
+	retained := now.Add(-anomalyRetention)
+	expired := len(d.ended) - maxAnomalyRecords
+	for i, anomaly := range d.ended {
+		if i >= expired && anomaly.End.After(retained) {
+			d.ended = d.ended[i:]
+			return
+		}
+		d.stor.Delete(anomaly.ID)
+	}
+	d.ended = nil
+}
+
+func describeAnomaly(anomaly AnomalyRecord) string {
+	if anomaly.Kind == AnomalyNewSourceSite {
+		return fmt.Sprintf("%.0f connections to %s from site %s not seen before",
+			anomaly.Observed, anomaly.RoutingKey, siteName(anomaly.SourceSite))
+	}
+	var from string
+	if anomaly.SourceSite.ID != "" {
+		from = fmt.Sprintf(", mostly from site %s", siteName(anomaly.SourceSite))
+	}
+	direction := "above"
+	if anomaly.Observed < anomaly.Expected {
+		direction = "below"
+	}
+	var observed, expected string
+	switch anomaly.Kind {
+	case AnomalyConnectionRate:
+		observed = fmt.Sprintf("%.0f connections", anomaly.Observed)
+		expected = fmt.Sprintf("%.1f", anomaly.Expected)
+	case AnomalyBytes:
+		observed = fmt.Sprintf("%.0f bytes", anomaly.Observed)
+		expected = fmt.Sprintf("%.0f", anomaly.Expected)
+	case AnomalyErrorRatio:
+		observed = fmt.Sprintf("%.1f%% of requests failed", 100*anomaly.Observed)
+		expected = fmt.Sprintf("%.1f%%", 100*anomaly.Expected)
+	}
+	return fmt.Sprintf("%s to %s in the last %s%s, %.1f standard deviations %s the expected %s",
+		observed, anomaly.RoutingKey, anomalyInterval, from, math.Abs(anomaly.Score), direction, expected)
+}
+
+func siteName(site NamedReference) string {
+	if site.Name != "" {
+		return site.Name
+	}
+	return site.ID
+}
+
+func maxAbs(a, b float64) float64 {
+	if math.Abs(a) > math.Abs(b) {
+		return a
+	}
+	return b
+}
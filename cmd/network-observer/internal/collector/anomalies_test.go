@@ -0,0 +1,114 @@
+package collector
+
+import (
+	"log/slog"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestBaselineStat(t *testing.T) {
+	var stat baselineStat
+	for _, v := range []float64{4, 6, 4, 6} {
+		stat.observe(v)
+	}
+	assert.Equal(t, stat.N, uint64(4))
+	assert.Equal(t, stat.Mean, 5.0)
+	assert.Equal(t, stat.Variance, 1.0)
+
+	score, stdDev := stat.score(9, 0)
+	assert.Equal(t, score, 4.0)
+	assert.Equal(t, stdDev, 1.0)
+	// deviations are floored for baselines that barely vary
+	score, stdDev = stat.score(9, 2)
+	assert.Equal(t, score, 2.0)
+	assert.Equal(t, stdDev, 2.0)
+
+	// old values lose weight once enough have been observed
+	for i := 0; i < 1000; i++ {
+		stat.observe(100)
+	}
+	assert.Assert(t, math.Abs(stat.Mean-100) < 0.01)
+}
+
+func TestAnomalyDetector(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: RecordIndexers()})
+	detector := newAnomalyDetector(slog.Default(), stor, newStableIdentityProvider(), reg, AnomalyDetection{
+		Threshold:      4,
+		LearningPeriod: 15 * time.Minute,
+	})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	detector.now = func() time.Time { return now }
+	listAnomalies := func() map[string]AnomalyRecord {
+		byKind := make(map[string]AnomalyRecord)
+		for _, entry := range stor.Index(IndexFlowByAddress, store.Entry{Record: AnomalyRecord{RoutingKey: "database", Protocol: "tcp"}}) {
+			anomaly := entry.Record.(AnomalyRecord)
+			byKind[anomaly.Kind] = anomaly
+		}
+		return byKind
+	}
+	interval := func(connections map[string]int, errors int) {
+		for site, n := range connections {
+			for i := 0; i < n; i++ {
+				detector.observeConnection("database", "tcp", site, site+"-name")
+				detector.observeBytes("database", "tcp", 1000)
+			}
+		}
+		for i := 0; i < 10; i++ {
+			class := "2xx"
+			if i < errors {
+				class = "5xx"
+			}
+			detector.observeRequest("database", "tcp", class)
+		}
+		now = now.Add(anomalyInterval)
+		detector.evaluate()
+	}
+
+	for i := 0; i < baselineMinSamples; i++ {
+		interval(map[string]int{"west": 4 + 2*(i%2)}, 0)
+	}
+	assert.Equal(t, len(listAnomalies()), 0)
+
+	// a flood of connections with errors from a site not seen before
+	interval(map[string]int{"west": 5, "east": 250}, 8)
+	anomalies := listAnomalies()
+	assert.Equal(t, len(anomalies), 4)
+	flood := anomalies[AnomalyConnectionRate]
+	assert.Equal(t, flood.Observed, 255.0)
+	assert.Equal(t, flood.Expected, 5.0)
+	assert.Equal(t, flood.StdDev, 1.0)
+	assert.Equal(t, flood.Score, 250.0)
+	assert.Equal(t, flood.SourceSite, NamedReference{ID: "east", Name: "east-name"})
+	assert.Equal(t, flood.Start, now.Add(-anomalyInterval))
+	assert.Assert(t, flood.End.IsZero())
+	assert.Equal(t, flood.Description, "255 connections to database in the last 1m0s, mostly from site east-name, 250.0 standard deviations above the expected 5.0")
+	assert.Equal(t, anomalies[AnomalyBytes].Observed, 255000.0)
+	assert.Equal(t, anomalies[AnomalyErrorRatio].Observed, 0.8)
+	newSite := anomalies[AnomalyNewSourceSite]
+	assert.Equal(t, newSite.Observed, 250.0)
+	assert.Equal(t, newSite.SourceSite, NamedReference{ID: "east", Name: "east-name"})
+	assert.Equal(t, newSite.Description, "250 connections to database from site east-name not seen before")
+	assert.Equal(t, prom_testutil.ToFloat64(detector.detected.WithLabelValues("database", AnomalyConnectionRate)), 1.0)
+	assert.Equal(t, prom_testutil.ToFloat64(detector.scores.WithLabelValues("database", AnomalyConnectionRate)), 250.0)
+
+	// anomalies end once traffic is back to the baseline
+	interval(map[string]int{"west": 5, "east": 1}, 0)
+	anomalies = listAnomalies()
+	assert.Equal(t, len(anomalies), 4)
+	for _, anomaly := range anomalies {
+		assert.Equal(t, anomaly.End, now.Add(-anomalyInterval))
+	}
+	assert.Equal(t, anomalies[AnomalyConnectionRate].ID, flood.ID)
+
+	// and are removed after the retention period
+	now = now.Add(anomalyRetention)
+	detector.evaluate()
+	assert.Equal(t, len(listAnomalies()), 0)
+}
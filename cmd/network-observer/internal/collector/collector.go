@@ -23,7 +23,21 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func New(logger *slog.Logger, factory session.ContainerFactory, reg *prometheus.Registry, flowRecordTTL time.Duration, flowLogger func(vanflow.RecordMessage)) *Collector {
+// Options holds the optional features of a Collector
+type Options struct {
+	// Network is the name of the network records are collected from.
+	// Identities the collector derives from record attributes are
+	// namespaced by it, so that they are unique across networks.
+	Network string
+	// Objectives are the service level objectives tracked
+	Objectives []ServiceLevelObjective
+	// Anomalies configures the detection of traffic anomalies
+	Anomalies AnomalyDetection
+	// Policy reports the connections it does not allow, when set
+	Policy *Policy
+}
+
+func New(logger *slog.Logger, factory session.ContainerFactory, reg prometheus.Registerer, flowRecordTTL time.Duration, flowLogger func(vanflow.RecordMessage), opts Options) *Collector {
 	sessionCtr := factory.Create()
 
 	collector := &Collector{
@@ -38,6 +52,8 @@ func New(logger *slog.Logger, factory session.ContainerFactory, reg *prometheus.
 		metrics:        register(reg),
 		metricsAdaptor: opmetrics.New(reg),
 		flowLogging:    flowLogger,
+		policy:         opts.Policy,
+		idp:            newNamespacedIdentityProvider(opts.Network),
 	}
 
 	collector.Records = store.NewSyncMapStore(store.SyncMapStoreConfig{
@@ -49,9 +65,11 @@ func New(logger *slog.Logger, factory session.ContainerFactory, reg *prometheus.
 		Indexers: RecordIndexers(),
 	})
 	collector.graph = NewGraph(collector.Records).(*graph)
-	collector.processManager = newProcessManager(logger, collector.Records, collector.graph, newStableIdentityProvider(), collector.metrics)
-	collector.addressManager = newAddressManager(collector.logger, collector.Records)
-	collector.pairManager = newPairManager(logger, collector.Records, collector.graph, collector.metrics)
+	collector.processManager = newProcessManager(logger, collector.Records, collector.graph, collector.idp, collector.metrics)
+	collector.addressManager = newAddressManager(collector.logger, collector.Records, collector.idp)
+	collector.pairManager = newPairManager(logger, collector.Records, collector.graph, collector.idp, collector.metrics, opts.Policy)
+	collector.sloManager = newSLOManager(logger, collector.Records, collector.idp, reg, opts.Objectives)
+	collector.anomalyDetector = newAnomalyDetector(logger, collector.Records, collector.idp, reg, opts.Anomalies)
 	routerCfg := collector.recordRouting
 	for _, typ := range standardRecordTypes {
 		routerCfg[typ.String()] = collector.Records
@@ -63,6 +81,8 @@ type Collector struct {
 	logger        *slog.Logger
 	flowRecordTTL time.Duration
 	flowLogging   func(vanflow.RecordMessage)
+	policy        *Policy
+	idp           idProvider
 
 	session   session.Container
 	discovery *eventsource.Discovery
@@ -74,10 +94,12 @@ type Collector struct {
 	graph         *graph
 	recordRouting eventsource.RecordStoreMap
 
-	processManager *processManager
-	addressManager *addressManager
-	pairManager    *pairManager
-	metricsAdaptor *opmetrics.Adaptor
+	processManager  *processManager
+	addressManager  *addressManager
+	pairManager     *pairManager
+	sloManager      *sloManager
+	anomalyDetector *anomalyDetector
+	metricsAdaptor  *opmetrics.Adaptor
 
 	events     chan changeEvent
 	purgeQueue chan store.SourceRef
@@ -105,6 +127,8 @@ func (c *Collector) Run(ctx context.Context) error {
 	g.Go(c.processManager.run(ctx))
 	g.Go(c.addressManager.run(ctx))
 	g.Go(c.pairManager.run(ctx))
+	g.Go(c.sloManager.run(ctx))
+	g.Go(c.anomalyDetector.run(ctx))
 	return g.Wait()
 }
 
@@ -178,6 +202,7 @@ func (c *Collector) runWorkQueue(ctx context.Context) func() error {
 		c.processManager.handleChangeEvent,
 	)
 	reactors[ProcPairRecord{}.GetTypeMeta()] = append(reactors[ProcPairRecord{}.GetTypeMeta()], c.pairManager.handleChangeEvent)
+	reactors[SiteAddressPairRecord{}.GetTypeMeta()] = append(reactors[SiteAddressPairRecord{}.GetTypeMeta()], c.pairManager.handleChangeEvent)
 
 	return func() error {
 		defer func() {
@@ -282,6 +307,10 @@ func (c *Collector) handleStoreAdd(e store.Entry) {
 		return
 	case ConnectionRecord:
 		return
+	case ServiceLevelRecord:
+		return
+	case AnomalyRecord:
+		return
 	}
 	select {
 	case c.events <- addEvent{Record: e.Record}:
@@ -296,6 +325,10 @@ func (c *Collector) handleStoreChange(p, e store.Entry) {
 		return
 	case ConnectionRecord:
 		return
+	case ServiceLevelRecord:
+		return
+	case AnomalyRecord:
+		return
 	}
 	select {
 	case c.events <- updateEvent{Prev: p.Record, Curr: e.Record}:
@@ -309,6 +342,10 @@ func (c *Collector) handleStoreDelete(e store.Entry) {
 		return
 	case ConnectionRecord:
 		return
+	case ServiceLevelRecord:
+		return
+	case AnomalyRecord:
+		return
 	}
 	select {
 	case c.events <- deleteEvent{Record: e.Record}:
@@ -364,7 +401,7 @@ func (c *Collector) discoveryHandler(ctx context.Context) func(eventsource.Info)
 			addresses = append(addresses, eventsource.FromSourceAddressHeartbeats()) // listen to .heartbeats
 		case "ROUTER":
 			addresses = append(addresses, eventsource.FromSourceAddressFlows()) // listen to .flows
-			sourceCtr.manager = newConnectionmanager(
+			sourceCtr.manager = newConnectionmanagerWithOptions(
 				ctx,
 				c.logger.With(slog.String("eventsource", fmt.Sprintf("%d/%s", source.Version, source.ID))),
 				sourceRef(source),
@@ -372,6 +409,12 @@ func (c *Collector) discoveryHandler(ctx context.Context) func(eventsource.Info)
 				c.graph,
 				c.metrics,
 				c.flowRecordTTL,
+				connectionManagerOptions{
+					SLOs:      c.sloManager,
+					Anomalies: c.anomalyDetector,
+					Policy:    c.policy,
+					IDs:       c.idp,
+				},
 			)
 
 			// route flow records to source-specific stores
@@ -431,3 +474,8 @@ func sourceRef(source eventsource.Info) store.SourceRef {
 		ID:      source.ID,
 	}
 }
+
+// LinkHealth returns the health of the router links in the network
+func (c *Collector) LinkHealth() []opmetrics.LinkHealth {
+	return c.metricsAdaptor.LinkHealth()
+}
@@ -26,6 +26,9 @@ type connectionManager struct {
 	graph                 *graph
 	idp                   idProvider
 	metrics               metrics
+	slos                  *sloManager
+	anomalies             *anomalyDetector
+	policy                *Policy
 	mcMu                  sync.Mutex
 	requestMetricsCache   map[labelSet]appMetrics
 	transportMetricsCache map[labelSet]transportMetrics
@@ -40,6 +43,7 @@ type connectionManager struct {
 
 	pairMu       sync.Mutex
 	processPairs map[pair]bool
+	addressPairs map[addressPair]bool
 
 	attrMu          sync.Mutex
 	processesCache  map[string]processAttributes
@@ -47,14 +51,32 @@ type connectionManager struct {
 	routerCache     map[string]routerAttrs
 }
 
+// connectionManagerOptions holds the optional features of a
+// connectionManager. Features left nil are disabled.
+type connectionManagerOptions struct {
+	SLOs      *sloManager
+	Anomalies *anomalyDetector
+	Policy    *Policy
+	// IDs provides the identities of derived records, defaults to a
+	// stable identity provider
+	IDs idProvider
+}
+
 func newConnectionmanager(ctx context.Context, log *slog.Logger, source store.SourceRef, records store.Interface, graph *graph, metrics metrics, ttl time.Duration) *connectionManager {
+	return newConnectionmanagerWithOptions(ctx, log, source, records, graph, metrics, ttl, connectionManagerOptions{})
+}
+
+func newConnectionmanagerWithOptions(ctx context.Context, log *slog.Logger, source store.SourceRef, records store.Interface, graph *graph, metrics metrics, ttl time.Duration, opts connectionManagerOptions) *connectionManager {
 	m := &connectionManager{
 		logger:                  log,
 		records:                 records,
 		graph:                   graph,
 		source:                  source,
-		idp:                     newStableIdentityProvider(),
+		idp:                     opts.IDs,
 		metrics:                 metrics,
+		slos:                    opts.SLOs,
+		anomalies:               opts.Anomalies,
+		policy:                  opts.Policy,
 		ttl:                     ttl,
 		transportProcessingTime: metrics.internal.flowProcessingTime.WithLabelValues(vanflow.TransportBiflowRecord{}.GetTypeMeta().String()),
 		appProcessingTime:       metrics.internal.flowProcessingTime.WithLabelValues(vanflow.AppBiflowRecord{}.GetTypeMeta().String()),
@@ -67,11 +89,16 @@ func newConnectionmanager(ctx context.Context, log *slog.Logger, source store.So
 			lru:  list.New(),
 		},
 		processPairs:    make(map[pair]bool),
+		addressPairs:    make(map[addressPair]bool),
 		processesCache:  make(map[string]processAttributes),
 		connectorsCache: make(map[string]connectorAttrs),
 		routerCache:     make(map[string]routerAttrs),
 	}
 
+	if m.idp == nil {
+		m.idp = newStableIdentityProvider()
+	}
+
 	m.flows = store.NewSyncMapStore(store.SyncMapStoreConfig{
 		Handlers: store.EventHandlerFuncs{
 			OnAdd:    m.handleAdd,
@@ -103,19 +130,38 @@ func (c *connectionManager) handleTransportFlow(record vanflow.TransportBiflowRe
 	if !state.Opened {
 		metrics.opened.Inc()
 		metrics.closed.Add(0)
+		c.anomalies.observeConnection(metrics.routingKey, metrics.protocol, metrics.sourceSiteID, metrics.sourceSiteName)
 		state.Opened = true
 	}
-	if !state.Terminated && record.EndTime != nil {
-		terminated := record.EndTime.Compare(dref(record.StartTime).Time) >= 0
-		if terminated {
+	if !state.Terminated {
+		if reason, terminated := TerminationReason(record); terminated {
 			state.Terminated = true
 			metrics.closed.Inc()
+			metrics.terminated.WithLabelValues(reason).Inc()
 		}
 	}
+	if !state.TimeToFirstByteSet && record.Latency != nil {
+		state.TimeToFirstByteSet = true
+		metrics.timeToFirstByte.Observe((time.Microsecond * time.Duration(*record.Latency)).Seconds())
+	}
+	if closures := dref(record.WindowClosures); closures > state.WindowClosures {
+		metrics.windowClosures.Add(float64(closures - state.WindowClosures))
+		state.WindowClosures = closures
+	}
+	// only open connections count towards the bytes in flight
+	unacked := dref(record.OctetsUnacked)
+	if state.Terminated {
+		unacked = 0
+	}
+	if unacked != state.OctetsUnacked {
+		metrics.unacked.Add(float64(unacked) - float64(state.OctetsUnacked))
+		state.OctetsUnacked = unacked
+	}
 	if !state.LatencySet && record.Latency != nil && record.LatencyReverse != nil {
 		delta := time.Microsecond * time.Duration(*record.Latency-*record.LatencyReverse)
 		state.LatencySet = true
 		metrics.latency.Observe(delta.Seconds())
+		c.slos.observeLatency(metrics.routingKey, delta)
 		metrics.latencyLegacy.Observe(float64(*record.Latency))
 		metrics.latencyLegacyReverse.Observe(float64(*record.LatencyReverse))
 	}
@@ -125,6 +171,7 @@ func (c *connectionManager) handleTransportFlow(record vanflow.TransportBiflowRe
 	if receivedInc != 0 {
 		metrics.sent.Add(sentInc)
 		metrics.received.Add(receivedInc)
+		c.anomalies.observeBytes(metrics.routingKey, metrics.protocol, (bs-state.BytesSent)+(br-state.BytesReceived))
 		state.BytesSent = bs
 		state.BytesReceived = br
 	}
@@ -149,10 +196,13 @@ func (c *connectionManager) handleAppFlow(record vanflow.AppBiflowRecord) {
 		terminated := record.EndTime.Compare(dref(record.StartTime).Time) >= 0
 		if terminated {
 			state.Terminated = true
+			responseClass := normalizeHTTPResponseClass(record.Result)
 			metrics.requests.With(prometheus.Labels{
 				"method": normalizeHTTPMethod(record.Method),
-				"code":   normalizeHTTPResponseClass(record.Result),
+				"code":   responseClass,
 			}).Inc()
+			c.slos.observeRequest(metrics.routingKey, responseClass)
+			c.anomalies.observeRequest(metrics.routingKey, metrics.protocol, responseClass)
 		}
 	}
 	c.appFlows.Push(record.ID, state)
@@ -205,7 +255,9 @@ func normalizeHTTPMethod(method *string) string {
 func (c *connectionManager) handleDelete(e store.Entry) {
 	switch record := e.Record.(type) {
 	case vanflow.TransportBiflowRecord:
-		c.transportFlows.Pop(record.ID)
+		if state, ok := c.transportFlows.Pop(record.ID); ok && state.metrics != nil {
+			state.metrics.unacked.Sub(float64(state.OctetsUnacked))
+		}
 		c.records.Delete(record.ID)
 	case vanflow.AppBiflowRecord:
 		c.appFlows.Pop(record.ID)
@@ -295,7 +347,9 @@ func (c *connectionManager) getAppMetricSet(l labelSet) appMetrics {
 	}
 	labels := l.asLabels()
 	m := appMetrics{
-		requests: c.metrics.requestsCounter.MustCurryWith(labels),
+		routingKey: l.RoutingKey,
+		protocol:   l.Protocol,
+		requests:   c.metrics.requestsCounter.MustCurryWith(labels),
 	}
 	c.requestMetricsCache[l] = m
 	return m
@@ -413,7 +467,13 @@ func (c *connectionManager) getTransportMetricSet(l labelSet) transportMetrics {
 
 	legacyLabelsReverse := lRev.asLabels()
 	legacyLabelsReverse["direction"] = "outgoing"
+
+	addressLabels := prometheus.Labels{"routing_key": l.RoutingKey, "protocol": l.Protocol}
 	m := transportMetrics{
+		routingKey:           l.RoutingKey,
+		protocol:             l.Protocol,
+		sourceSiteID:         l.SourceSiteID,
+		sourceSiteName:       l.SourceSiteName,
 		opened:               c.metrics.flowOpenedCounter.With(labels),
 		closed:               c.metrics.flowClosedCounter.With(labels),
 		sent:                 c.metrics.flowBytesSentCounter.With(labels),
@@ -421,6 +481,10 @@ func (c *connectionManager) getTransportMetricSet(l labelSet) transportMetrics {
 		latency:              c.metrics.internal.flowLatency.With(labels),
 		latencyLegacy:        c.metrics.internal.legancyLatency.With(legacyLabels),
 		latencyLegacyReverse: c.metrics.internal.legancyLatency.With(legacyLabelsReverse),
+		terminated:           c.metrics.connectionsTerminatedCounter.MustCurryWith(addressLabels),
+		timeToFirstByte:      c.metrics.timeToFirstByte.With(addressLabels),
+		windowClosures:       c.metrics.windowClosuresCounter.With(addressLabels),
+		unacked:              c.metrics.unackedBytesGauge.With(addressLabels),
 	}
 	c.transportMetricsCache[l] = m
 	return m
@@ -593,6 +657,26 @@ func (c *connectionManager) run(ctx context.Context) {
 					}
 					c.processPairs[pair] = false
 				}
+				for pair, dirty := range c.addressPairs {
+					if !dirty {
+						continue
+					}
+
+					id := c.idp.ID("siteaddresspair", pair.Source.ID, pair.Dest.ID, pair.RoutingKey, pair.Protocol)
+					if _, ok := c.records.Get(id); !ok {
+						record := SiteAddressPairRecord{
+							ID:         id,
+							Protocol:   pair.Protocol,
+							RoutingKey: pair.RoutingKey,
+							Source:     pair.Source,
+							Dest:       pair.Dest,
+							Start:      time.Now(),
+						}
+						c.logger.Info("Adding site address pair", slog.Any("id", id))
+						c.records.Add(record, store.SourceRef{ID: "self"})
+					}
+					c.addressPairs[pair] = false
+				}
 			}()
 		}
 	}
@@ -763,7 +847,26 @@ func (c *connectionManager) runReconcile() reconcileResult {
 			if _, ok := c.processPairs[p]; !ok {
 				c.processPairs[p] = true
 			}
+			ap := addressPair{
+				Protocol:   connection.Protocol,
+				RoutingKey: connection.RoutingKey,
+				Source:     connection.SourceSite,
+				Dest:       connection.DestSite,
+			}
+			if _, ok := c.addressPairs[ap]; !ok {
+				c.addressPairs[ap] = true
+			}
 			c.pairMu.Unlock()
+			if !c.policy.Allows(connection.SourceSite, connection.DestSite, connection.RoutingKey) {
+				c.metrics.policyViolationsCounter.WithLabelValues(
+					connection.SourceSite.ID,
+					connection.DestSite.ID,
+					connection.SourceSite.Name,
+					connection.DestSite.Name,
+					connection.RoutingKey,
+					connection.Protocol,
+				).Inc()
+			}
 		}
 		if push {
 			c.transportFlows.Push(state.ID, state)
@@ -833,6 +936,10 @@ func (c *connectionManager) Stop() {
 }
 
 type transportMetrics struct {
+	routingKey           string
+	protocol             string
+	sourceSiteID         string
+	sourceSiteName       string
 	opened               prometheus.Counter
 	closed               prometheus.Counter
 	sent                 prometheus.Counter
@@ -840,9 +947,15 @@ type transportMetrics struct {
 	latency              prometheus.Observer
 	latencyLegacy        prometheus.Observer
 	latencyLegacyReverse prometheus.Observer
+	terminated           *prometheus.CounterVec
+	timeToFirstByte      prometheus.Observer
+	windowClosures       prometheus.Counter
+	unacked              prometheus.Gauge
 }
 type appMetrics struct {
-	requests *prometheus.CounterVec
+	routingKey string
+	protocol   string
+	requests   *prometheus.CounterVec
 }
 
 type appState struct {
@@ -871,6 +984,10 @@ type transportState struct {
 	BytesReceived uint64
 	LatencySet    bool
 
+	TimeToFirstByteSet bool
+	WindowClosures     uint64
+	OctetsUnacked      uint64
+
 	metrics *transportMetrics
 
 	FirstSeen time.Time
@@ -1135,6 +1252,13 @@ type pair struct {
 	Dest     string
 	Protocol string
 }
+
+type addressPair struct {
+	Source     NamedReference
+	Dest       NamedReference
+	RoutingKey string
+	Protocol   string
+}
 type processAttributes struct {
 	ID        string
 	Name      string
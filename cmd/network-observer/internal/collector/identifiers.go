@@ -13,16 +13,25 @@ type idProvider interface {
 }
 
 type hashIDer struct {
-	mu   sync.Mutex
-	hash hash.Hash
-	buff []byte
+	mu        sync.Mutex
+	namespace string
+	hash      hash.Hash
+	buff      []byte
 }
 
 func newStableIdentityProvider() idProvider {
+	return newNamespacedIdentityProvider("")
+}
+
+// newNamespacedIdentityProvider returns a stable identity provider whose
+// identities are unique to the namespace, so that the same parts produce
+// different identities in different networks.
+func newNamespacedIdentityProvider(namespace string) idProvider {
 	h := fnv.New64()
 	return &hashIDer{
-		hash: h,
-		buff: make([]byte, 0, h.Size()),
+		namespace: namespace,
+		hash:      h,
+		buff:      make([]byte, 0, h.Size()),
 	}
 }
 
@@ -30,6 +39,7 @@ func (c *hashIDer) ID(prefix string, part string, parts ...string) string {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c.hash.Reset()
+	c.hash.Write([]byte(c.namespace))
 	c.hash.Write([]byte(prefix))
 	c.hash.Write([]byte(part))
 	for _, p := range parts {
@@ -0,0 +1,18 @@
+package collector
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNamespacedIdentityProvider(t *testing.T) {
+	stable := newStableIdentityProvider()
+	east := newNamespacedIdentityProvider("east")
+	west := newNamespacedIdentityProvider("west")
+
+	assert.Equal(t, newNamespacedIdentityProvider("").ID("adr", "backend", "tcp"), stable.ID("adr", "backend", "tcp"))
+	assert.Equal(t, east.ID("adr", "backend", "tcp"), newNamespacedIdentityProvider("east").ID("adr", "backend", "tcp"))
+	assert.Assert(t, east.ID("adr", "backend", "tcp") != west.ID("adr", "backend", "tcp"))
+	assert.Assert(t, east.ID("adr", "backend", "tcp") != stable.ID("adr", "backend", "tcp"))
+}
@@ -51,6 +51,10 @@ func indexByTypeAndAddress(e store.Entry) []string {
 		return []string{fmt.Sprintf("%s/%s/%s", record.GetTypeMeta().String(), record.RoutingKey, record.Protocol)}
 	case RequestRecord:
 		return []string{fmt.Sprintf("%s/%s/%s", record.GetTypeMeta().String(), record.RoutingKey, record.Protocol)}
+	case ServiceLevelRecord:
+		return []string{fmt.Sprintf("%s/%s/%s", record.GetTypeMeta().String(), record.RoutingKey, record.Protocol)}
+	case AnomalyRecord:
+		return []string{fmt.Sprintf("%s/%s/%s", record.GetTypeMeta().String(), record.RoutingKey, record.Protocol)}
 	}
 	return nil
 }
@@ -10,6 +10,12 @@ type metrics struct {
 	flowBytesSentCounter     *prometheus.CounterVec
 	flowBytesReceivedCounter *prometheus.CounterVec
 	requestsCounter          *prometheus.CounterVec
+	policyViolationsCounter  *prometheus.CounterVec
+
+	connectionsTerminatedCounter *prometheus.CounterVec
+	timeToFirstByte              *prometheus.HistogramVec
+	windowClosuresCounter        *prometheus.CounterVec
+	unackedBytesGauge            *prometheus.GaugeVec
 
 	internal metricsInternal
 }
@@ -23,7 +29,7 @@ type metricsInternal struct {
 	pendingFlows       *prometheus.GaugeVec
 }
 
-func register(reg *prometheus.Registry) metrics {
+func register(reg prometheus.Registerer) metrics {
 	m := metrics{
 		flowOpenedCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: "skupper",
@@ -50,6 +56,32 @@ func register(reg *prometheus.Registry) metrics {
 			Name:      "requests_total",
 			Help:      "Counter incremented for each request handled through the skupper network",
 		}, appFlowMetricLables),
+		policyViolationsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "skupper",
+			Name:      "policy_violating_connections_total",
+			Help:      "Number of connections opened through the application network not allowed by the network policy",
+		}, policyMetricLabels),
+		connectionsTerminatedCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "skupper",
+			Name:      "connections_terminated_total",
+			Help:      "Number of connections to an address that terminated, by the reason they terminated for",
+		}, append(addressMetricLabels, "reason")),
+		timeToFirstByte: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "skupper",
+			Name:      "connection_time_to_first_byte_seconds",
+			Help:      "Time to first byte of connections to an address observed from the listener (client) side",
+			Buckets:   []float64{0.001, 0.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, addressMetricLabels),
+		windowClosuresCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "skupper",
+			Name:      "connection_window_closures_total",
+			Help:      "Number of times the receive window of connections to an address closed, applying back-pressure",
+		}, addressMetricLabels),
+		unackedBytesGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "skupper",
+			Name:      "connection_unacked_bytes",
+			Help:      "Bytes sent over open connections to an address that have not been acknowledged",
+		}, addressMetricLabels),
 
 		internal: metricsInternal{
 			flowLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
@@ -100,6 +132,11 @@ func register(reg *prometheus.Registry) metrics {
 		m.flowBytesSentCounter,
 		m.flowBytesReceivedCounter,
 		m.requestsCounter,
+		m.policyViolationsCounter,
+		m.connectionsTerminatedCounter,
+		m.timeToFirstByte,
+		m.windowClosuresCounter,
+		m.unackedBytesGauge,
 		m.internal.legancyLatency,
 		m.internal.flowLatency,
 		m.internal.reconcileTime,
@@ -127,6 +164,15 @@ var (
 		"dest_process_name",
 	}
 	appFlowMetricLables = append(flowMetricLabels, "method", "code")
+	addressMetricLabels = []string{"routing_key", "protocol"}
+	policyMetricLabels  = []string{
+		"source_site_id",
+		"dest_site_id",
+		"source_site_name",
+		"dest_site_name",
+		"routing_key",
+		"protocol",
+	}
 )
 
 type labelSet struct {
@@ -0,0 +1,255 @@
+package metrics
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+)
+
+const (
+	// linkAvailabilityWindow is the period over which link availability is
+	// computed
+	linkAvailabilityWindow = time.Hour
+	// linkFlapWindow is the period over which link flaps are counted
+	linkFlapWindow = time.Hour
+)
+
+var linkHealthMetricLabels = []string{
+	"link_id",
+	"name",
+	"site_id",
+	"role",
+}
+
+// LinkHealth is the health of a router link as observed from its records
+type LinkHealth struct {
+	// Network is the name of the network the link is in, when the observer
+	// collects from more than one
+	Network string
+	ID      string
+	Name    string
+	SiteID  string
+	Role    string
+	Peer    string
+	// Status is the status last reported for the link, if any
+	Status string
+	Up     bool
+	// Since is when the link was first observed with its current status
+	Since time.Time
+
+	DownCount uint64
+	// Flaps is the number of times the link went down over the last hour
+	Flaps     int
+	Octets    uint64
+	OctetRate uint64
+	// Availability is the percentage of the last hour the link was
+	// observed up, or of the time since it was first observed when more
+	// recent.
+	Availability float64
+}
+
+type linkTransition struct {
+	At time.Time
+	Up bool
+}
+
+type linkState struct {
+	health LinkHealth
+
+	transitions []linkTransition
+
+	downCount *uint64
+	downs     []time.Time
+}
+
+func (s *linkState) observe(link vanflow.LinkRecord, siteID string, now time.Time) {
+	h := &s.health
+	h.ID = link.ID
+	h.SiteID = siteID
+	h.Name = deref(link.Name)
+	h.Role = deref(link.Role)
+	h.Peer = deref(link.Peer)
+	h.Octets = deref(link.Octets)
+	h.OctetRate = deref(link.OctetRate)
+
+	if link.Status != nil {
+		up := strings.EqualFold(*link.Status, "up")
+		if h.Status == "" || up != h.Up {
+			s.transitions = append(s.transitions, linkTransition{At: now, Up: up})
+			h.Since = now
+		}
+		h.Status, h.Up = *link.Status, up
+	}
+
+	if link.DownCount != nil {
+		count := *link.DownCount
+		if s.downCount != nil && count > *s.downCount {
+			for i := *s.downCount; i < count; i++ {
+				s.downs = append(s.downs, now)
+			}
+		}
+		s.downCount = &count
+		h.DownCount = count
+	}
+	s.prune(now)
+}
+
+func (s *linkState) prune(now time.Time) {
+	// keep the last transition before the window as the state the window
+	// starts in
+	windowStart := now.Add(-linkAvailabilityWindow)
+	for len(s.transitions) > 1 && !s.transitions[1].At.After(windowStart) {
+		s.transitions = s.transitions[1:]
+	}
+	flapStart := now.Add(-linkFlapWindow)
+	for len(s.downs) > 0 && !s.downs[0].After(flapStart) {
+		s.downs = s.downs[1:]
+	}
+}
+
+func (s *linkState) availability(now time.Time) (float64, bool) {
+	if len(s.transitions) == 0 {
+		return 0, false
+	}
+	start := now.Add(-linkAvailabilityWindow)
+	if first := s.transitions[0].At; first.After(start) {
+		start = first
+	}
+	total := now.Sub(start)
+	if total <= 0 {
+		if s.health.Up {
+			return 100, true
+		}
+		return 0, true
+	}
+	var upTime time.Duration
+	up, at := false, start
+	for _, transition := range s.transitions {
+		if !transition.At.After(start) {
+			up = transition.Up
+			continue
+		}
+		if up {
+			upTime += transition.At.Sub(at)
+		}
+		up, at = transition.Up, transition.At
+	}
+	if up {
+		upTime += now.Sub(at)
+	}
+	return 100 * float64(upTime) / float64(total), true
+}
+
+func (s *linkState) snapshot(now time.Time) LinkHealth {
+	s.prune(now)
+	health := s.health
+	health.Flaps = len(s.downs)
+	health.Availability, _ = s.availability(now)
+	return health
+}
+
+// linkHealthCollector is a prometheus.Collector exposing the health of each
+// link. Link availability depends on the time the metrics are collected, so
+// unlike the rest of the metrics maintained by the Adaptor these are
+// computed on collection.
+type linkHealthCollector struct {
+	now func() time.Time
+
+	up           *prometheus.Desc
+	flaps        *prometheus.Desc
+	octets       *prometheus.Desc
+	octetRate    *prometheus.Desc
+	availability *prometheus.Desc
+
+	mu    sync.Mutex
+	links map[string]*linkState
+}
+
+func newLinkHealthCollector() *linkHealthCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("skupper", "", name), help, linkHealthMetricLabels, nil)
+	}
+	return &linkHealthCollector{
+		now:          time.Now,
+		up:           desc("link_up", "Whether each router link is up (1) or down (0)"),
+		flaps:        desc("link_flaps_total", "Count of the times each router link went down"),
+		octets:       desc("link_octets_total", "Octets sent over each router link"),
+		octetRate:    desc("link_octet_rate", "Octets per second sent over each router link"),
+		availability: desc("link_availability_percent", "Percentage of the last hour each router link was up"),
+		links:        make(map[string]*linkState),
+	}
+}
+
+func (c *linkHealthCollector) observe(link vanflow.LinkRecord, siteID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state, ok := c.links[link.ID]
+	if !ok {
+		state = &linkState{}
+		c.links[link.ID] = state
+	}
+	state.observe(link, siteID, c.now())
+}
+
+func (c *linkHealthCollector) remove(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.links, id)
+}
+
+func (c *linkHealthCollector) snapshot() []LinkHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	out := make([]LinkHealth, 0, len(c.links))
+	for _, state := range c.links {
+		out = append(out, state.snapshot(now))
+	}
+	slices.SortFunc(out, func(a, b LinkHealth) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return out
+}
+
+func (c *linkHealthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.up
+	ch <- c.flaps
+	ch <- c.octets
+	ch <- c.octetRate
+	ch <- c.availability
+}
+
+func (c *linkHealthCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for _, state := range c.links {
+		h := state.snapshot(now)
+		labels := []string{h.ID, h.Name, h.SiteID, h.Role}
+		if h.Status != "" {
+			up := 0.0
+			if h.Up {
+				up = 1.0
+			}
+			ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, up, labels...)
+		}
+		if availability, ok := state.availability(now); ok {
+			ch <- prometheus.MustNewConstMetric(c.availability, prometheus.GaugeValue, availability, labels...)
+		}
+		ch <- prometheus.MustNewConstMetric(c.flaps, prometheus.CounterValue, float64(h.DownCount), labels...)
+		ch <- prometheus.MustNewConstMetric(c.octets, prometheus.CounterValue, float64(h.Octets), labels...)
+		ch <- prometheus.MustNewConstMetric(c.octetRate, prometheus.GaugeValue, float64(h.OctetRate), labels...)
+	}
+}
+
+func deref[T any](ptr *T) T {
+	var out T
+	if ptr != nil {
+		out = *ptr
+	}
+	return out
+}
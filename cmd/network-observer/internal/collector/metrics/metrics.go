@@ -36,6 +36,7 @@ func New(reg prometheus.Registerer) *Adaptor {
 		connectors:    make(map[string]*gaugeMetricByID),
 		linkErrors:    make(map[siteLinkErrors]*counterMetricByItem),
 		pendingRouter: make(map[string]map[string]vanflow.Record),
+		linkHealth:    newLinkHealthCollector(),
 	}
 	h.siteInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "skupper",
@@ -75,6 +76,7 @@ func New(reg prometheus.Registerer) *Adaptor {
 		h.siteListenerInfo,
 		h.siteConnectorInfo,
 		h.siteLinkErrors,
+		h.linkHealth,
 	)
 	return h
 }
@@ -88,6 +90,7 @@ type Adaptor struct {
 	siteListenerInfo  *prometheus.GaugeVec
 	siteConnectorInfo *prometheus.GaugeVec
 	siteLinkErrors    *prometheus.CounterVec
+	linkHealth        *linkHealthCollector
 
 	sites      map[siteInfo]prometheus.Gauge
 	routers    map[siteRouters]*gaugeMetricByID
@@ -182,6 +185,20 @@ func (a *Adaptor) linkInfo(link vanflow.LinkRecord) (info siteLinks, wants strin
 	}, "", true
 }
 
+func (a *Adaptor) observeLinkHealth(link vanflow.LinkRecord) {
+	var siteID string
+	if link.Parent != nil {
+		siteID, _ = a.siteIDForRotuer(*link.Parent)
+	}
+	a.linkHealth.observe(link, siteID)
+}
+
+// LinkHealth returns the health of each link known to the Adaptor. Safe to
+// call concurrently with the record events it handles.
+func (a *Adaptor) LinkHealth() []LinkHealth {
+	return a.linkHealth.snapshot()
+}
+
 func (a *Adaptor) listenerInfo(listener vanflow.ListenerRecord) (siteID, wants string, ok bool) {
 	if listener.Parent == nil {
 		return "", "", false
@@ -257,6 +274,7 @@ func (a *Adaptor) Add(record vanflow.Record) {
 			}
 		}
 	case vanflow.LinkRecord:
+		a.observeLinkHealth(record)
 		siteLink, wants, ok := a.linkInfo(record)
 		if !ok {
 			if wants != "" {
@@ -342,6 +360,7 @@ func (a *Adaptor) Update(prev, curr vanflow.Record) {
 	case vanflow.ConnectorRecord:
 		a.Add(curr)
 	case vanflow.LinkRecord:
+		a.observeLinkHealth(record)
 		siteLink, wants, ok := a.linkInfo(record)
 		if !ok {
 			if wants != "" {
@@ -351,7 +370,7 @@ func (a *Adaptor) Update(prev, curr vanflow.Record) {
 		}
 		prevLink, _, ok := a.linkInfo(prev.(vanflow.LinkRecord))
 		if ok && prevLink != siteLink {
-			a.Remove(prev)
+			a.removeLink(prev.(vanflow.LinkRecord))
 		}
 		metric, ok := a.links[siteLink]
 		if !ok {
@@ -399,27 +418,8 @@ func (a *Adaptor) Remove(record vanflow.Record) {
 		}
 		metric.Remove(record.ID)
 	case vanflow.LinkRecord:
-		siteLink, wants, ok := a.linkInfo(record)
-		if !ok {
-			if wants != "" {
-				a.clearPendingRouter(wants, record)
-			}
-			return
-		}
-		metric, ok := a.links[siteLink]
-		if !ok {
-			return
-		}
-		metric.Remove(record.ID)
-		linkErrorKey := siteLinkErrors{
-			SiteID: siteLink.SiteID,
-			Role:   siteLink.Role,
-		}
-		counter, ok := a.linkErrors[linkErrorKey]
-		if !ok {
-			return
-		}
-		counter.Remove(record.ID)
+		a.linkHealth.remove(record.ID)
+		a.removeLink(record)
 	case vanflow.ListenerRecord:
 		site, wants, ok := a.listenerInfo(record)
 		if !ok {
@@ -449,6 +449,30 @@ func (a *Adaptor) Remove(record vanflow.Record) {
 	}
 }
 
+func (a *Adaptor) removeLink(record vanflow.LinkRecord) {
+	siteLink, wants, ok := a.linkInfo(record)
+	if !ok {
+		if wants != "" {
+			a.clearPendingRouter(wants, record)
+		}
+		return
+	}
+	metric, ok := a.links[siteLink]
+	if !ok {
+		return
+	}
+	metric.Remove(record.ID)
+	linkErrorKey := siteLinkErrors{
+		SiteID: siteLink.SiteID,
+		Role:   siteLink.Role,
+	}
+	counter, ok := a.linkErrors[linkErrorKey]
+	if !ok {
+		return
+	}
+	counter.Remove(record.ID)
+}
+
 func (a *Adaptor) newSiteConnectorMetrics(siteID string) *gaugeMetricByID {
 	return &gaugeMetricByID{
 		IDs:   make(map[string]struct{}),
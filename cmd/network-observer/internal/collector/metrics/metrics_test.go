@@ -1,7 +1,9 @@
 package metrics
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
@@ -230,6 +232,76 @@ func TestSiteLinkErrorMetrics(t *testing.T) {
 	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteLinkErrors.WithLabelValues("s01", "inter-router")), 4.0)
 }
 
+func TestLinkHealthMetrics(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	metrics := New(reg)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	metrics.linkHealth.now = func() time.Time { return now }
+	link := func(status string, downCount uint64, octets uint64) vanflow.LinkRecord {
+		return vanflow.LinkRecord{
+			BaseRecord: vanflow.NewBase("l01"),
+			Name:       ptrTo("west-east"),
+			Role:       ptrTo("inter-router"),
+			Status:     ptrTo(status),
+			Parent:     ptrTo("r01"),
+			DownCount:  ptrTo(downCount),
+			Octets:     ptrTo(octets),
+			OctetRate:  ptrTo(octets / 10),
+		}
+	}
+	metrics.Add(vanflow.RouterRecord{
+		BaseRecord: vanflow.NewBase("r01"), Parent: ptrTo("s01"), Mode: ptrTo("interior"),
+	})
+
+	prev := link("up", 2, 1000)
+	metrics.Add(prev)
+	advance := func(d time.Duration, next vanflow.LinkRecord) {
+		now = now.Add(d)
+		metrics.Update(prev, next)
+		prev = next
+	}
+	now = now.Add(30 * time.Minute)
+	advance(0, link("down", 3, 1000))
+	advance(15*time.Minute, link("up", 3, 1000))
+	advance(15*time.Minute, link("up", 3, 4000))
+
+	expected := `
+# HELP skupper_link_availability_percent Percentage of the last hour each router link was up
+# TYPE skupper_link_availability_percent gauge
+skupper_link_availability_percent{link_id="l01",name="west-east",role="inter-router",site_id="s01"} 75
+# HELP skupper_link_flaps_total Count of the times each router link went down
+# TYPE skupper_link_flaps_total counter
+skupper_link_flaps_total{link_id="l01",name="west-east",role="inter-router",site_id="s01"} 3
+# HELP skupper_link_octet_rate Octets per second sent over each router link
+# TYPE skupper_link_octet_rate gauge
+skupper_link_octet_rate{link_id="l01",name="west-east",role="inter-router",site_id="s01"} 400
+# HELP skupper_link_octets_total Octets sent over each router link
+# TYPE skupper_link_octets_total counter
+skupper_link_octets_total{link_id="l01",name="west-east",role="inter-router",site_id="s01"} 4000
+# HELP skupper_link_up Whether each router link is up (1) or down (0)
+# TYPE skupper_link_up gauge
+skupper_link_up{link_id="l01",name="west-east",role="inter-router",site_id="s01"} 1
+`
+	assert.Check(t, prom_testutil.CollectAndCompare(reg, strings.NewReader(expected),
+		"skupper_link_up", "skupper_link_flaps_total", "skupper_link_octets_total",
+		"skupper_link_octet_rate", "skupper_link_availability_percent"))
+
+	health := metrics.LinkHealth()
+	assert.Equal(t, len(health), 1)
+	assert.Equal(t, health[0].Flaps, 1)
+	assert.Equal(t, health[0].Since, now.Add(-15*time.Minute))
+
+	// flaps and downtime age out of the window
+	now = now.Add(time.Hour)
+	health = metrics.LinkHealth()
+	assert.Equal(t, health[0].Flaps, 0)
+	assert.Equal(t, health[0].Availability, 100.0)
+
+	metrics.Remove(prev)
+	assert.Equal(t, prom_testutil.CollectAndCount(reg, "skupper_link_up"), 0)
+	assert.Equal(t, len(metrics.LinkHealth()), 0)
+}
+
 func ptrTo[T any](s T) *T {
 	return &s
 }
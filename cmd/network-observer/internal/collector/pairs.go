@@ -17,19 +17,20 @@ type pairManager struct {
 	idp             idProvider
 	source          store.SourceRef
 	reconcileMetric prometheus.Observer
+	policy          *Policy
 
 	mu          sync.Mutex
 	pairs       map[string]struct{}
 	updatePairs chan ProcPairRecord
 }
 
-func newPairManager(logger *slog.Logger, stor store.Interface, graph *graph, m metrics) *pairManager {
+func newPairManager(logger *slog.Logger, stor store.Interface, graph *graph, idp idProvider, m metrics, policy *Policy) *pairManager {
 
 	return &pairManager{
 		logger:      logger,
 		stor:        stor,
 		graph:       graph,
-		idp:         newStableIdentityProvider(),
+		idp:         idp,
 		updatePairs: make(chan ProcPairRecord, 8),
 		pairs:       make(map[string]struct{}),
 		source: store.SourceRef{
@@ -37,6 +38,7 @@ func newPairManager(logger *slog.Logger, stor store.Interface, graph *graph, m m
 			ID:      "self",
 		},
 		reconcileMetric: m.internal.reconcileTime.WithLabelValues("self", "flowpairs"),
+		policy:          policy,
 	}
 }
 
@@ -158,11 +160,40 @@ func (m *pairManager) handleChangeEvent(event changeEvent, stor readonly) {
 				// skip if queue is full - will eventually be reconciled
 			}
 		}
+	case SiteAddressPairRecord:
+		m.checkPolicy(r)
 	default:
 	}
 
 }
 
+// checkPolicy adds a PolicyViolationRecord for site address pairs the policy
+// does not allow
+func (m *pairManager) checkPolicy(pair SiteAddressPairRecord) {
+	if m.policy == nil || m.policy.Allows(pair.Source, pair.Dest, pair.RoutingKey) {
+		return
+	}
+	id := m.idp.ID("policyviolation", pair.ID)
+	added := m.stor.Add(PolicyViolationRecord{
+		ID:         id,
+		Protocol:   pair.Protocol,
+		RoutingKey: pair.RoutingKey,
+		Source:     pair.Source,
+		Dest:       pair.Dest,
+		Start:      pair.Start,
+	},
+		m.source,
+	)
+	if added {
+		m.logger.Warn(
+			"Connections not allowed by policy",
+			slog.String("id", id),
+			slog.String("source", pair.Source.ID),
+			slog.String("dest", pair.Dest.ID),
+			slog.String("routing_key", pair.RoutingKey))
+	}
+}
+
 func (m *pairManager) hasPair(id string) bool {
 	m.mu.Lock()
 	defer m.mu.Unlock()
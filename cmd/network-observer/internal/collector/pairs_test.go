@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/skupperproject/skupper/pkg/vanflow"
 	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
 	"gotest.tools/v3/poll"
 )
 
@@ -70,7 +71,7 @@ func TestPairManagerSitePairs(t *testing.T) {
 
 	graph.Reset()
 
-	manager := newPairManager(tlog, stor, graph, metrics)
+	manager := newPairManager(tlog, stor, graph, newStableIdentityProvider(), metrics, nil)
 	go manager.run(context.TODO())()
 	for _, procPair := range procPairs {
 		manager.handleChangeEvent(addEvent{Record: procPair.Pair}, stor)
@@ -94,4 +95,46 @@ func TestPairManagerSitePairs(t *testing.T) {
 	}
 }
 
+func TestPairManagerPolicy(t *testing.T) {
+	tlog := slog.New(slog.NewTextHandler(io.Discard, nil))
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{
+		Indexers: RecordIndexers(),
+	})
+	graph := NewGraph(stor).(*graph)
+	metrics := register(prometheus.NewRegistry())
+	policy := &Policy{Allow: []PolicyRule{
+		{From: "west", To: "east", RoutingKeys: []string{"backend"}},
+	}}
+	manager := newPairManager(tlog, stor, graph, newStableIdentityProvider(), metrics, policy)
+
+	west := NamedReference{ID: "s1", Name: "west"}
+	east := NamedReference{ID: "s2", Name: "east"}
+	pairs := []SiteAddressPairRecord{
+		{ID: "sap1", Source: west, Dest: east, RoutingKey: "backend", Protocol: "tcp"},
+		{ID: "sap2", Source: west, Dest: east, RoutingKey: "database", Protocol: "tcp"},
+		{ID: "sap3", Source: east, Dest: west, RoutingKey: "backend", Protocol: "tcp"},
+	}
+	for _, pair := range pairs {
+		stor.Add(pair, store.SourceRef{ID: "self"})
+		manager.handleChangeEvent(addEvent{Record: pair}, stor)
+		// re-firing events should be a noop
+		manager.handleChangeEvent(addEvent{Record: pair}, stor)
+	}
+
+	violations := make(map[string]PolicyViolationRecord)
+	for _, entry := range stor.Index(store.TypeIndex, store.Entry{Record: PolicyViolationRecord{}}) {
+		violation := entry.Record.(PolicyViolationRecord)
+		violations[violation.RoutingKey+"/"+violation.Source.Name] = violation
+	}
+	assert.Equal(t, len(violations), 2)
+	assert.DeepEqual(t, violations["database/west"], PolicyViolationRecord{
+		ID:         violations["database/west"].ID,
+		Protocol:   "tcp",
+		RoutingKey: "database",
+		Source:     west,
+		Dest:       east,
+	})
+	assert.Equal(t, violations["backend/east"].Dest, west)
+}
+
 func ptrTo[T any](obj T) *T { return &obj }
@@ -0,0 +1,64 @@
+package collector
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PolicyAny matches any site or routing key in a PolicyRule
+const PolicyAny = "*"
+
+// PolicyRule allows connections from clients in one site to a routing key
+// served from another
+type PolicyRule struct {
+	// From is the name or ID of the site clients connect from
+	From string
+	// To is the name or ID of the site the routing key is served from
+	To string
+	// RoutingKeys the clients are allowed to connect to. Any routing key is
+	// allowed when empty.
+	RoutingKeys []string
+}
+
+func (r PolicyRule) Validate() error {
+	if r.From == "" {
+		return fmt.Errorf("from is required")
+	}
+	if r.To == "" {
+		return fmt.Errorf("to is required")
+	}
+	if slices.Contains(r.RoutingKeys, "") {
+		return fmt.Errorf("routing keys cannot be empty")
+	}
+	return nil
+}
+
+func (r PolicyRule) allows(source, dest NamedReference, routingKey string) bool {
+	return matchesSite(r.From, source) &&
+		matchesSite(r.To, dest) &&
+		(len(r.RoutingKeys) == 0 || slices.Contains(r.RoutingKeys, routingKey) || slices.Contains(r.RoutingKeys, PolicyAny))
+}
+
+func matchesSite(pattern string, site NamedReference) bool {
+	return pattern == PolicyAny || pattern == site.ID || (site.Name != "" && pattern == site.Name)
+}
+
+// Policy is the allow-list of the communication intended between sites. A
+// nil Policy allows everything.
+type Policy struct {
+	Allow []PolicyRule
+}
+
+// Allows returns true when a rule allows connections from the source site to
+// the routing key served from the destination site
+func (p *Policy) Allows(source, dest NamedReference, routingKey string) bool {
+	if p == nil {
+		return true
+	}
+	for _, rule := range p.Allow {
+		if rule.allows(source, dest, routingKey) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package collector
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPolicyAllows(t *testing.T) {
+	west := NamedReference{ID: "s1", Name: "west"}
+	east := NamedReference{ID: "s2", Name: "east"}
+	north := NamedReference{ID: "s3"}
+	policy := &Policy{Allow: []PolicyRule{
+		{From: "west", To: "east", RoutingKeys: []string{"backend", "database"}},
+		{From: "s2", To: "*"},
+		{From: "*", To: "s3", RoutingKeys: []string{"*"}},
+	}}
+	testCases := []struct {
+		Name       string
+		Policy     *Policy
+		Source     NamedReference
+		Dest       NamedReference
+		RoutingKey string
+		Expected   bool
+	}{
+		{Name: "no policy", Source: west, Dest: east, RoutingKey: "any", Expected: true},
+		{Name: "by name", Policy: policy, Source: west, Dest: east, RoutingKey: "database", Expected: true},
+		{Name: "routing key not allowed", Policy: policy, Source: west, Dest: east, RoutingKey: "cache"},
+		{Name: "reverse allowed from any site by id", Policy: policy, Source: east, Dest: west, RoutingKey: "backend", Expected: true},
+		{Name: "source not allowed", Policy: policy, Source: north, Dest: east, RoutingKey: "backend"},
+		{Name: "by id to another site", Policy: policy, Source: east, Dest: north, RoutingKey: "cache", Expected: true},
+		{Name: "any routing key", Policy: policy, Source: west, Dest: north, RoutingKey: "cache", Expected: true},
+		{Name: "empty policy", Policy: &Policy{}, Source: west, Dest: east, RoutingKey: "backend"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Policy.Allows(tc.Source, tc.Dest, tc.RoutingKey), tc.Expected)
+		})
+	}
+}
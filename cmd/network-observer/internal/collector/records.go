@@ -61,6 +61,49 @@ func (r SitePairRecord) GetTypeMeta() vanflow.TypeMeta {
 	}
 }
 
+// SiteAddressPairRecord is a pair of sites clients in the source site
+// connected to a routing key served from the dest site through
+type SiteAddressPairRecord struct {
+	ID         string
+	Protocol   string
+	RoutingKey string
+	Source     NamedReference
+	Dest       NamedReference
+	Start      time.Time
+}
+
+func (r SiteAddressPairRecord) Identity() string {
+	return r.ID
+}
+
+func (r SiteAddressPairRecord) GetTypeMeta() vanflow.TypeMeta {
+	return vanflow.TypeMeta{
+		Type:       "SiteAddressPairRecord",
+		APIVersion: "v1alpha1",
+	}
+}
+
+// PolicyViolationRecord is a SiteAddressPairRecord the Policy does not allow
+type PolicyViolationRecord struct {
+	ID         string
+	Protocol   string
+	RoutingKey string
+	Source     NamedReference
+	Dest       NamedReference
+	Start      time.Time
+}
+
+func (r PolicyViolationRecord) Identity() string {
+	return r.ID
+}
+
+func (r PolicyViolationRecord) GetTypeMeta() vanflow.TypeMeta {
+	return vanflow.TypeMeta{
+		Type:       "PolicyViolationRecord",
+		APIVersion: "v1alpha1",
+	}
+}
+
 type ProcGroupPairRecord struct {
 	ID         string
 	Protocol   string
@@ -121,3 +164,69 @@ func (r FlowSourceRecord) GetTypeMeta() vanflow.TypeMeta {
 		APIVersion: "v1alpha1",
 	}
 }
+
+// ServiceLevelRecord is the status of a service level objective for an
+// address
+type ServiceLevelRecord struct {
+	ID               string
+	Name             string
+	RoutingKey       string
+	Protocol         string
+	Indicator        string
+	Objective        float64
+	Window           time.Duration
+	LatencyThreshold time.Duration
+	Start            time.Time
+
+	Good                 uint64
+	Total                uint64
+	Ratio                float64
+	ErrorBudgetRemaining float64
+	BurnRate1h           float64
+	BurnRate6h           float64
+	Status               string
+}
+
+func (r ServiceLevelRecord) Identity() string {
+	return r.ID
+}
+
+func (r ServiceLevelRecord) GetTypeMeta() vanflow.TypeMeta {
+	return vanflow.TypeMeta{
+		Type:       "ServiceLevelRecord",
+		APIVersion: "v1alpha1",
+	}
+}
+
+// AnomalyRecord is a deviation of the traffic to an address from the
+// baseline learned for it
+type AnomalyRecord struct {
+	ID         string
+	RoutingKey string
+	Protocol   string
+	Kind       string
+	Start      time.Time
+	End        time.Time
+
+	Observed float64
+	Expected float64
+	StdDev   float64
+	// Score is the number of standard deviations the traffic was from the
+	// baseline, at its furthest while the anomaly lasted
+	Score float64
+	// SourceSite is the site traffic not seen before came from, or that most
+	// connections came from for connection rate anomalies
+	SourceSite  NamedReference
+	Description string
+}
+
+func (r AnomalyRecord) Identity() string {
+	return r.ID
+}
+
+func (r AnomalyRecord) GetTypeMeta() vanflow.TypeMeta {
+	return vanflow.TypeMeta{
+		Type:       "AnomalyRecord",
+		APIVersion: "v1alpha1",
+	}
+}
@@ -0,0 +1,350 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+const (
+	IndicatorAvailability = "availability"
+	IndicatorLatency      = "latency"
+
+	ErrorBudgetOK        = "ok"
+	ErrorBudgetBurning   = "burning"
+	ErrorBudgetExhausted = "exhausted"
+
+	sloEvaluationInterval = 15 * time.Second
+	// sloMaxBucketWidth is the coarsest granularity events are counted at,
+	// so that burn rates over an hour stay meaningful for long windows.
+	sloMaxBucketWidth = time.Hour
+	sloBuckets        = 720
+
+	// error budgets are burning when spent fast enough to be exhausted in
+	// about two days (1h) or five days (6h) of a thirty day window
+	sloFastBurnRate1h = 14.4
+	sloFastBurnRate6h = 6
+)
+
+// ServiceLevelObjective declares the percentage of good events targeted
+// for the traffic to an address over a window.
+type ServiceLevelObjective struct {
+	// Name of the objective, unique for each address
+	Name string
+	// Address is the routing key of the service
+	Address string
+	// Objective is the percentage of good events targeted
+	Objective float64
+	// Window is the duration events are counted over
+	Window time.Duration
+	// LatencyThreshold makes this a latency objective, where connections
+	// with a time to first byte within the threshold are good. Otherwise
+	// requests that do not end with a 5xx response class are.
+	LatencyThreshold time.Duration
+}
+
+func (o ServiceLevelObjective) Indicator() string {
+	if o.LatencyThreshold > 0 {
+		return IndicatorLatency
+	}
+	return IndicatorAvailability
+}
+
+func (o ServiceLevelObjective) Validate() error {
+	if o.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if o.Address == "" {
+		return fmt.Errorf("address is required")
+	}
+	if o.Objective <= 0 || o.Objective >= 100 {
+		return fmt.Errorf("objective must be a percentage between 0 and 100 exclusive")
+	}
+	if o.Window <= 0 {
+		return fmt.Errorf("window must be positive")
+	}
+	if o.LatencyThreshold < 0 {
+		return fmt.Errorf("latency threshold cannot be negative")
+	}
+	return nil
+}
+
+// eventWindow counts good and total events over a sliding window using a
+// ring of fixed width buckets.
+type eventWindow struct {
+	width   time.Duration
+	buckets []eventBucket
+}
+
+type eventBucket struct {
+	Start time.Time
+	Good  uint64
+	Total uint64
+}
+
+func newEventWindow(window time.Duration) *eventWindow {
+	width := min(window/sloBuckets, sloMaxBucketWidth)
+	width = max(width, time.Second)
+	n := int((window + width - 1) / width)
+	return &eventWindow{
+		width:   width,
+		buckets: make([]eventBucket, n),
+	}
+}
+
+func (w *eventWindow) add(at time.Time, good bool) {
+	start := at.Truncate(w.width)
+	slot := int((start.UnixNano() / int64(w.width)) % int64(len(w.buckets)))
+	bucket := &w.buckets[slot]
+	if !bucket.Start.Equal(start) {
+		*bucket = eventBucket{Start: start}
+	}
+	bucket.Total++
+	if good {
+		bucket.Good++
+	}
+}
+
+// sum returns the events counted in the buckets overlapping the period
+// ending at now.
+func (w *eventWindow) sum(now time.Time, period time.Duration) (good uint64, total uint64) {
+	from := now.Add(-period)
+	for _, bucket := range w.buckets {
+		if bucket.Start.IsZero() || bucket.Start.After(now) || !bucket.Start.Add(w.width).After(from) {
+			continue
+		}
+		good += bucket.Good
+		total += bucket.Total
+	}
+	return good, total
+}
+
+type sloState struct {
+	ServiceLevelObjective
+	events *eventWindow
+
+	sli         prometheus.Gauge
+	budget      prometheus.Gauge
+	burnRate1h  prometheus.Gauge
+	burnRate6h  prometheus.Gauge
+	objective   prometheus.Gauge
+	lastRecords map[string]struct{}
+}
+
+// status of the objective at a point in time
+func (s *sloState) status(now time.Time) ServiceLevelRecord {
+	allowed := (100 - s.Objective) / 100
+	burnRate := func(period time.Duration) float64 {
+		good, total := s.events.sum(now, min(period, s.Window))
+		if total == 0 {
+			return 0
+		}
+		return float64(total-good) / float64(total) / allowed
+	}
+	good, total := s.events.sum(now, s.Window)
+	record := ServiceLevelRecord{
+		Name:                 s.Name,
+		RoutingKey:           s.Address,
+		Indicator:            s.Indicator(),
+		Objective:            s.Objective,
+		Window:               s.Window,
+		LatencyThreshold:     s.LatencyThreshold,
+		Good:                 good,
+		Total:                total,
+		Ratio:                1,
+		ErrorBudgetRemaining: 1,
+		BurnRate1h:           burnRate(time.Hour),
+		BurnRate6h:           burnRate(6 * time.Hour),
+		Status:               ErrorBudgetOK,
+	}
+	if total > 0 {
+		bad := float64(total - good)
+		record.Ratio = float64(good) / float64(total)
+		record.ErrorBudgetRemaining = math.Max(0, 1-bad/(allowed*float64(total)))
+	}
+	switch {
+	case record.ErrorBudgetRemaining <= 0:
+		record.Status = ErrorBudgetExhausted
+	case record.BurnRate1h >= sloFastBurnRate1h || record.BurnRate6h >= sloFastBurnRate6h:
+		record.Status = ErrorBudgetBurning
+	}
+	return record
+}
+
+// sloManager tracks the service level objectives declared for addresses
+// against the requests and connections handled by the connection managers
+// of each event source, and maintains a ServiceLevelRecord for each
+// objective and matching AddressRecord.
+type sloManager struct {
+	logger *slog.Logger
+	stor   store.Interface
+	idp    idProvider
+	source store.SourceRef
+	now    func() time.Time
+
+	mu        sync.Mutex
+	byAddress map[string][]*sloState
+}
+
+func newSLOManager(logger *slog.Logger, stor store.Interface, idp idProvider, reg prometheus.Registerer, objectives []ServiceLevelObjective) *sloManager {
+	labels := []string{"routing_key", "slo"}
+	gauge := func(name, help string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "skupper",
+			Name:      name,
+			Help:      help,
+		}, labels)
+	}
+	var (
+		objectiveRatio = gauge("slo_objective_ratio", "Ratio of good events targeted by each service level objective")
+		sliRatio       = gauge("slo_sli_ratio", "Ratio of good events to total events over the window of each service level objective")
+		budget         = gauge("slo_error_budget_remaining_ratio", "Ratio of the error budget of each service level objective not yet spent")
+		burnRate       = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "skupper",
+			Name:      "slo_burn_rate",
+			Help:      "Rate the error budget of each service level objective is spent at relative to the rate that spends it over exactly the window",
+		}, append(labels, "window"))
+	)
+	if len(objectives) > 0 {
+		reg.MustRegister(objectiveRatio, sliRatio, budget, burnRate)
+	}
+
+	m := &sloManager{
+		logger:    logger,
+		stor:      stor,
+		idp:       idp,
+		now:       time.Now,
+		byAddress: make(map[string][]*sloState),
+		source: store.SourceRef{
+			Version: "0.1",
+			ID:      "self",
+		},
+	}
+	for _, objective := range objectives {
+		labels := prometheus.Labels{"routing_key": objective.Address, "slo": objective.Name}
+		state := &sloState{
+			ServiceLevelObjective: objective,
+			events:                newEventWindow(objective.Window),
+			objective:             objectiveRatio.With(labels),
+			sli:                   sliRatio.With(labels),
+			budget:                budget.With(labels),
+			burnRate1h:            burnRate.MustCurryWith(labels).WithLabelValues("1h"),
+			burnRate6h:            burnRate.MustCurryWith(labels).WithLabelValues("6h"),
+			lastRecords:           make(map[string]struct{}),
+		}
+		state.objective.Set(objective.Objective / 100)
+		m.byAddress[objective.Address] = append(m.byAddress[objective.Address], state)
+	}
+	return m
+}
+
+func (m *sloManager) run(ctx context.Context) func() error {
+	return func() error {
+		if m.empty() {
+			return nil
+		}
+		ticker := time.NewTicker(sloEvaluationInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				m.evaluate()
+			}
+		}
+	}
+}
+
+func (m *sloManager) empty() bool {
+	return m == nil || len(m.byAddress) == 0
+}
+
+// observeRequest counts a completed request to an address for its
+// availability objectives
+func (m *sloManager) observeRequest(address string, responseClass string) {
+	m.observe(address, func(state *sloState) (bool, bool) {
+		return state.Indicator() == IndicatorAvailability, responseClass != "5xx"
+	})
+}
+
+// observeLatency counts the time to first byte of a connection to an address
+// for its latency objectives
+func (m *sloManager) observeLatency(address string, latency time.Duration) {
+	m.observe(address, func(state *sloState) (bool, bool) {
+		return state.Indicator() == IndicatorLatency, latency <= state.LatencyThreshold
+	})
+}
+
+func (m *sloManager) observe(address string, classify func(*sloState) (counts bool, good bool)) {
+	if m.empty() {
+		return
+	}
+	now := m.now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, state := range m.byAddress[address] {
+		if counts, good := classify(state); counts {
+			state.events.add(now, good)
+		}
+	}
+}
+
+// evaluate updates the metrics and records for each objective
+func (m *sloManager) evaluate() {
+	now := m.now()
+	addresses := make(map[string][]AddressRecord)
+	for _, entry := range m.stor.Index(store.TypeIndex, store.Entry{Record: AddressRecord{}}) {
+		if address, ok := entry.Record.(AddressRecord); ok {
+			addresses[address.Name] = append(addresses[address.Name], address)
+		}
+	}
+
+	type evaluation struct {
+		state  *sloState
+		status ServiceLevelRecord
+	}
+	var evaluations []evaluation
+	m.mu.Lock()
+	for _, states := range m.byAddress {
+		for _, state := range states {
+			evaluations = append(evaluations, evaluation{state: state, status: state.status(now)})
+		}
+	}
+	m.mu.Unlock()
+
+	// lastRecords is only accessed here, from a single goroutine
+	for _, e := range evaluations {
+		state, status := e.state, e.status
+		state.sli.Set(status.Ratio)
+		state.budget.Set(status.ErrorBudgetRemaining)
+		state.burnRate1h.Set(status.BurnRate1h)
+		state.burnRate6h.Set(status.BurnRate6h)
+
+		current := make(map[string]struct{})
+		for _, address := range addresses[state.Address] {
+			record := status
+			record.ID = m.idp.ID("slo", state.Name, address.ID)
+			record.Protocol = address.Protocol
+			record.Start = address.Start
+			if _, ok := state.lastRecords[record.ID]; ok {
+				m.stor.Update(record)
+			} else {
+				m.stor.Add(record, m.source)
+			}
+			current[record.ID] = struct{}{}
+		}
+		for id := range state.lastRecords {
+			if _, ok := current[id]; !ok {
+				m.stor.Delete(id)
+			}
+		}
+		state.lastRecords = current
+	}
+}
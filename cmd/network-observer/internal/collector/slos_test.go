@@ -0,0 +1,111 @@
+package collector
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestEventWindow(t *testing.T) {
+	window := newEventWindow(2 * time.Hour)
+	assert.Equal(t, window.width, 10*time.Second)
+	assert.Equal(t, len(window.buckets), 720)
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	window.add(t0, true)
+	window.add(t0.Add(time.Hour), false)
+	window.add(t0.Add(90*time.Minute), true)
+	now := t0.Add(90 * time.Minute)
+
+	good, total := window.sum(now, 2*time.Hour)
+	assert.Equal(t, good, uint64(2))
+	assert.Equal(t, total, uint64(3))
+	good, total = window.sum(now, time.Hour)
+	assert.Equal(t, good, uint64(1))
+	assert.Equal(t, total, uint64(2))
+
+	// events recorded over slots used more than a window ago replace them
+	window.add(t0.Add(2*time.Hour), true)
+	good, total = window.sum(t0.Add(2*time.Hour), 2*time.Hour)
+	assert.Equal(t, good, uint64(2))
+	assert.Equal(t, total, uint64(3))
+
+	long := newEventWindow(28 * 24 * time.Hour)
+	assert.Equal(t, long.width, 56*time.Minute)
+	assert.Equal(t, len(long.buckets), 720)
+}
+
+func TestSLOManager(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: RecordIndexers()})
+	manager := newSLOManager(slog.Default(), stor, newStableIdentityProvider(), reg, []ServiceLevelObjective{
+		{Name: "availability", Address: "pizza", Objective: 99, Window: 24 * time.Hour},
+		{Name: "latency", Address: "pizza", Objective: 90, Window: 24 * time.Hour, LatencyThreshold: 200 * time.Millisecond},
+	})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	manager.now = func() time.Time { return now }
+	stor.Add(AddressRecord{ID: "addr-1", Name: "pizza", Protocol: "tcp", Start: now}, store.SourceRef{ID: "self"})
+
+	for i := 0; i < 200; i++ {
+		class := "2xx"
+		if i%100 == 0 {
+			class = "5xx"
+		}
+		manager.observeRequest("pizza", class)
+		manager.observeRequest("icecream", "5xx")
+	}
+	manager.observeLatency("pizza", 100*time.Millisecond)
+	manager.observeLatency("pizza", 300*time.Millisecond)
+
+	manager.evaluate()
+	records := stor.Index(IndexFlowByAddress, store.Entry{Record: ServiceLevelRecord{RoutingKey: "pizza", Protocol: "tcp"}})
+	assert.Equal(t, len(records), 2)
+	byName := make(map[string]ServiceLevelRecord)
+	for _, entry := range records {
+		record := entry.Record.(ServiceLevelRecord)
+		byName[record.Name] = record
+	}
+
+	availability := byName["availability"]
+	assert.Equal(t, availability.Indicator, IndicatorAvailability)
+	assert.Equal(t, availability.Good, uint64(198))
+	assert.Equal(t, availability.Total, uint64(200))
+	assert.Equal(t, availability.Ratio, 0.99)
+	assert.Equal(t, availability.Status, ErrorBudgetExhausted)
+	assert.Equal(t, availability.ErrorBudgetRemaining, 0.0)
+
+	latency := byName["latency"]
+	assert.Equal(t, latency.Indicator, IndicatorLatency)
+	assert.Equal(t, latency.Total, uint64(2))
+	assert.Equal(t, latency.Ratio, 0.5)
+	assert.Equal(t, latency.Status, ErrorBudgetExhausted)
+
+	assert.Equal(t, prom_testutil.ToFloat64(manager.byAddress["pizza"][0].sli), 0.99)
+	assert.Equal(t, prom_testutil.CollectAndCount(reg, "skupper_slo_burn_rate"), 4)
+
+	// once the bad events age out of the last six hours the budget is no
+	// longer burning fast, but the window still holds them
+	now = now.Add(7 * time.Hour)
+	for i := 0; i < 9800; i++ {
+		manager.observeRequest("pizza", "2xx")
+	}
+	manager.evaluate()
+	entry, ok := stor.Get(availability.ID)
+	assert.Assert(t, ok)
+	availability = entry.Record.(ServiceLevelRecord)
+	assert.Equal(t, availability.Total, uint64(10000))
+	assert.Equal(t, availability.BurnRate1h, 0.0)
+	assert.Equal(t, availability.BurnRate6h, 0.0)
+	assert.Equal(t, availability.ErrorBudgetRemaining, 0.98)
+	assert.Equal(t, availability.Status, ErrorBudgetOK)
+
+	// records are removed with their address
+	stor.Delete("addr-1")
+	manager.evaluate()
+	assert.Equal(t, len(stor.Index(store.TypeIndex, store.Entry{Record: ServiceLevelRecord{}})), 0)
+}
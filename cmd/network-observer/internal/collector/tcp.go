@@ -0,0 +1,57 @@
+package collector
+
+import (
+	"strings"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+)
+
+// Reasons connections terminated for
+const (
+	// TerminationClosed connections ended without error
+	TerminationClosed = "closed"
+	// TerminationRefused connections were refused by the server
+	TerminationRefused = "refused"
+	// TerminationReset connections were reset by a peer
+	TerminationReset = "reset"
+	// TerminationTimeout connections timed out
+	TerminationTimeout = "timeout"
+	// TerminationUnreachable connections could not reach the server host
+	TerminationUnreachable = "unreachable"
+	// TerminationError connections ended with any other error
+	TerminationError = "error"
+)
+
+// TerminationReason classifies why a connection terminated from the errors
+// reported by the connector and listener sides. It returns false for
+// connections that have not terminated.
+func TerminationReason(record vanflow.TransportBiflowRecord) (string, bool) {
+	if record.EndTime == nil || record.EndTime.Compare(dref(record.StartTime).Time) < 0 {
+		return "", false
+	}
+	for _, err := range []*string{record.ErrorConnector, record.ErrorListener} {
+		if reason := classifyError(dref(err)); reason != "" {
+			return reason, true
+		}
+	}
+	return TerminationClosed, true
+}
+
+func classifyError(err string) string {
+	if err == "" {
+		return ""
+	}
+	err = strings.ToLower(err)
+	switch {
+	case strings.Contains(err, "refused"):
+		return TerminationRefused
+	case strings.Contains(err, "reset"):
+		return TerminationReset
+	case strings.Contains(err, "timed out") || strings.Contains(err, "timeout"):
+		return TerminationTimeout
+	case strings.Contains(err, "unreachable") || strings.Contains(err, "no route"):
+		return TerminationUnreachable
+	default:
+		return TerminationError
+	}
+}
@@ -0,0 +1,131 @@
+package collector
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestTerminationReason(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name           string
+		Record         vanflow.TransportBiflowRecord
+		ExpectReason   string
+		ExpectTerminal bool
+	}{
+		{
+			Name:   "active",
+			Record: vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("1", start)},
+		}, {
+			Name:           "closed",
+			Record:         vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("1", start, start.Add(time.Second))},
+			ExpectReason:   TerminationClosed,
+			ExpectTerminal: true,
+		}, {
+			Name: "refused",
+			Record: vanflow.TransportBiflowRecord{
+				BaseRecord:     vanflow.NewBase("1", start, start),
+				ErrorConnector: ptrTo("Connection refused"),
+			},
+			ExpectReason:   TerminationRefused,
+			ExpectTerminal: true,
+		}, {
+			Name: "reset by client",
+			Record: vanflow.TransportBiflowRecord{
+				BaseRecord:    vanflow.NewBase("1", start, start),
+				ErrorListener: ptrTo("Connection reset by peer"),
+			},
+			ExpectReason:   TerminationReset,
+			ExpectTerminal: true,
+		}, {
+			Name: "connector error first",
+			Record: vanflow.TransportBiflowRecord{
+				BaseRecord:     vanflow.NewBase("1", start, start),
+				ErrorConnector: ptrTo("Connection timed out"),
+				ErrorListener:  ptrTo("Connection reset by peer"),
+			},
+			ExpectReason:   TerminationTimeout,
+			ExpectTerminal: true,
+		}, {
+			Name: "unreachable",
+			Record: vanflow.TransportBiflowRecord{
+				BaseRecord:     vanflow.NewBase("1", start, start),
+				ErrorConnector: ptrTo("No route to host"),
+			},
+			ExpectReason:   TerminationUnreachable,
+			ExpectTerminal: true,
+		}, {
+			Name: "other error",
+			Record: vanflow.TransportBiflowRecord{
+				BaseRecord:     vanflow.NewBase("1", start, start),
+				ErrorConnector: ptrTo("Broken pipe"),
+			},
+			ExpectReason:   TerminationError,
+			ExpectTerminal: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			reason, terminal := TerminationReason(tc.Record)
+			assert.Equal(t, reason, tc.ExpectReason)
+			assert.Equal(t, terminal, tc.ExpectTerminal)
+		})
+	}
+}
+
+func TestConnectionManagerTCPMetrics(t *testing.T) {
+	tCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vanStor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: RecordIndexers()})
+	m := register(prometheus.NewRegistry())
+	manager := newConnectionmanager(tCtx, slog.Default(), store.SourceRef{}, vanStor, NewGraph(vanStor).(*graph), m, time.Minute)
+	defer manager.Stop()
+
+	metrics := manager.getTransportMetricSet(labelSet{RoutingKey: "backend", Protocol: "tcp"})
+	for _, id := range []string{"tflow-01", "tflow-02"} {
+		manager.transportFlows.Push(id, transportState{ID: id, metrics: &metrics})
+	}
+	start := time.Now()
+	manager.handleTransportFlow(vanflow.TransportBiflowRecord{
+		BaseRecord:     vanflow.NewBase("tflow-01", start),
+		Latency:        ptrTo(uint64(250_000)),
+		WindowClosures: ptrTo(uint64(2)),
+		OctetsUnacked:  ptrTo(uint64(4096)),
+	})
+	manager.handleTransportFlow(vanflow.TransportBiflowRecord{
+		BaseRecord:     vanflow.NewBase("tflow-02", start),
+		WindowClosures: ptrTo(uint64(1)),
+		OctetsUnacked:  ptrTo(uint64(1024)),
+	})
+	manager.handleTransportFlow(vanflow.TransportBiflowRecord{
+		BaseRecord:     vanflow.NewBase("tflow-01", start),
+		Latency:        ptrTo(uint64(250_000)),
+		WindowClosures: ptrTo(uint64(5)),
+		OctetsUnacked:  ptrTo(uint64(2048)),
+	})
+
+	addressLabels := []string{"backend", "tcp"}
+	assert.Equal(t, prom_testutil.ToFloat64(m.windowClosuresCounter.WithLabelValues(addressLabels...)), 6.0)
+	assert.Equal(t, prom_testutil.ToFloat64(m.unackedBytesGauge.WithLabelValues(addressLabels...)), 3072.0)
+	assert.Equal(t, prom_testutil.CollectAndCount(m.timeToFirstByte), 1)
+
+	// terminated connections no longer have bytes in flight
+	manager.handleTransportFlow(vanflow.TransportBiflowRecord{
+		BaseRecord:     vanflow.NewBase("tflow-01", start, start.Add(time.Second)),
+		ErrorConnector: ptrTo("Connection reset by peer"),
+		OctetsUnacked:  ptrTo(uint64(2048)),
+	})
+	assert.Equal(t, prom_testutil.ToFloat64(m.connectionsTerminatedCounter.WithLabelValues("backend", "tcp", TerminationReset)), 1.0)
+	assert.Equal(t, prom_testutil.ToFloat64(m.unackedBytesGauge.WithLabelValues(addressLabels...)), 1024.0)
+
+	manager.handleDelete(store.Entry{Record: vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("tflow-02")}})
+	assert.Equal(t, prom_testutil.ToFloat64(m.unackedBytesGauge.WithLabelValues(addressLabels...)), 0.0)
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnomalies(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	srv, c := requireTestClient(t, New(tlog, stor, graph))
+	defer srv.Close()
+
+	begin := time.Now().Truncate(time.Minute)
+	records := wrapRecords(
+		collector.AddressRecord{ID: "addr-1", Name: "database", Protocol: "tcp", Start: begin},
+		collector.AddressRecord{ID: "addr-2", Name: "backend", Protocol: "http1", Start: begin},
+		collector.AnomalyRecord{
+			ID:          "anomaly-1",
+			RoutingKey:  "database",
+			Protocol:    "tcp",
+			Kind:        collector.AnomalyConnectionRate,
+			Start:       begin,
+			Observed:    250,
+			Expected:    3,
+			StdDev:      1,
+			Score:       247,
+			SourceSite:  collector.NamedReference{ID: "site-east", Name: "east"},
+			Description: "250 connections to database in the last 1m0s, mostly from site east, 247.0 standard deviations above the expected 3.0",
+		},
+		collector.AnomalyRecord{
+			ID:         "anomaly-2",
+			RoutingKey: "database",
+			Protocol:   "tcp",
+			Kind:       collector.AnomalyNewSourceSite,
+			Start:      begin,
+			Observed:   250,
+			SourceSite: collector.NamedReference{ID: "site-east", Name: "east"},
+		},
+		collector.AnomalyRecord{
+			ID:         "anomaly-3",
+			RoutingKey: "backend",
+			Protocol:   "http1",
+			Kind:       collector.AnomalyErrorRatio,
+			Start:      begin.Add(-time.Hour),
+			End:        begin.Add(-5 * time.Minute),
+			Observed:   0.5,
+			Expected:   0.01,
+			StdDev:     0.01,
+			Score:      49,
+		},
+	)
+	stor.Replace(records)
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := c.AnomaliesWithResponse(context.TODO(), withParameters(map[string][]string{
+			"kind": {"connectionRate"},
+		}))
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, resp.JSON200.Count, int64(1))
+		assert.DeepEqual(t, resp.JSON200.Results, []api.AnomalyRecord{{
+			Identity:       "anomaly-1",
+			StartTime:      uint64(begin.UnixMicro()),
+			RoutingKey:     "database",
+			Protocol:       "tcp",
+			Kind:           api.ConnectionRate,
+			Observed:       250,
+			Expected:       3,
+			StdDev:         1,
+			Score:          247,
+			SourceSiteId:   ptrTo("site-east"),
+			SourceSiteName: ptrTo("east"),
+			Description:    "250 connections to database in the last 1m0s, mostly from site east, 247.0 standard deviations above the expected 3.0",
+		}})
+
+		resp, err = c.AnomaliesWithResponse(context.TODO(), withParameters(map[string][]string{
+			"state": {"active"},
+		}))
+		assert.Check(t, err)
+		assert.Equal(t, resp.JSON200.Count, int64(2))
+	})
+
+	testcases := []struct {
+		ID          string
+		ExpectOK    bool
+		ExpectCount int
+	}{
+		{ID: "dne"},
+		{ID: "addr-1", ExpectOK: true, ExpectCount: 2},
+		{ID: "addr-2", ExpectOK: true, ExpectCount: 1},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.ID, func(t *testing.T) {
+			resp, err := c.AnomaliesByServiceWithResponse(context.TODO(), tc.ID)
+			assert.Check(t, err)
+			if !tc.ExpectOK {
+				assert.Check(t, resp.JSON404 != nil)
+				return
+			}
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.Equal(t, resp.JSON200.Count, int64(tc.ExpectCount))
+			assert.Equal(t, len(resp.JSON200.Results), tc.ExpectCount)
+		})
+	}
+}
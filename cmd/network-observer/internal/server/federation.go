@@ -0,0 +1,378 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/auth"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// NetworkQueryParam selects the networks records are served from
+const NetworkQueryParam = "network"
+
+// maxFederatedHandlers bounds the number of handlers cached for the
+// combinations of networks and namespaces requests are served from
+const maxFederatedHandlers = 256
+
+// Network is a skupper network the API serves records from
+type Network struct {
+	Name    string
+	Records store.Interface
+	Graph   collector.Graph
+}
+
+// Federation serves the API over multiple networks. Requests are served from
+// the networks selected by their network query parameters, or from all of
+// them when none is set. Requests authenticated for a user restricted to a set
+// of namespaces are served only the records of the sites in them.
+//
+// When there is more than one network, the results of API responses are
+// labelled with the network their record is found in, and requests for a
+// record whose identity is found in more than one of the selected networks
+// are rejected.
+type Federation struct {
+	logger   *slog.Logger
+	networks map[string]Network
+	names    []string
+	handler  func(store.Interface, collector.Graph) http.Handler
+
+	mu       sync.Mutex
+	handlers map[string]*federatedHandler
+}
+
+// NewFederation returns a Federation over the networks, where handler returns
+// the handler serving requests from a set of records and their graph.
+func NewFederation(logger *slog.Logger, networks []Network, handler func(store.Interface, collector.Graph) http.Handler) *Federation {
+	f := &Federation{
+		logger:   logger,
+		networks: make(map[string]Network, len(networks)),
+		handler:  handler,
+		handlers: make(map[string]*federatedHandler),
+	}
+	for _, network := range networks {
+		f.networks[network.Name] = network
+		f.names = append(f.names, network.Name)
+	}
+	slices.Sort(f.names)
+	return f
+}
+
+func (f *Federation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	selected := query[NetworkQueryParam]
+	for _, name := range selected {
+		if _, ok := f.networks[name]; !ok {
+			resp := api.ErrorBadRequest{
+				Message: fmt.Sprintf("unknown network %q", name),
+			}
+			if err := encodeResponse(w, http.StatusBadRequest, resp); err != nil {
+				f.logger.Error("response write error", slog.Any("error", err))
+			}
+			return
+		}
+	}
+	if len(selected) > 0 {
+		query.Del(NetworkQueryParam)
+		r = r.Clone(r.Context())
+		r.URL.RawQuery = query.Encode()
+	} else {
+		selected = f.names
+	}
+	var namespaces []string
+	access, scoped := auth.FromContext(r.Context())
+	if scoped && access.AllNamespaces {
+		scoped = false
+	}
+	if scoped {
+		namespaces = access.Namespaces
+	}
+	handler := f.handlerFor(selected, scoped, namespaces)
+	if len(f.networks) == 1 {
+		handler.ServeHTTP(w, r)
+		return
+	}
+	if id, ok := recordID(r.URL.Path); ok {
+		if networks := handler.networksOf(id); len(networks) > 1 {
+			resp := api.ErrorBadRequest{
+				Message: fmt.Sprintf("record %q is found in networks %s, select one with the %s query parameter",
+					id, strings.Join(networks, ", "), NetworkQueryParam),
+			}
+			if err := encodeResponse(w, http.StatusBadRequest, resp); err != nil {
+				f.logger.Error("response write error", slog.Any("error", err))
+			}
+			return
+		}
+	}
+	out := &bufferedResponse{header: make(http.Header)}
+	handler.ServeHTTP(out, r)
+	body := out.body.Bytes()
+	if out.status == http.StatusOK && strings.HasPrefix(out.header.Get("Content-Type"), "application/json") {
+		labelled, err := handler.labelResults(body)
+		if err != nil {
+			f.logger.Error("failed to label results with their network", slog.Any("error", err))
+		} else {
+			body = labelled
+			out.header.Del("Content-Length")
+		}
+	}
+	maps.Copy(w.Header(), out.header)
+	if out.status != 0 {
+		w.WriteHeader(out.status)
+	}
+	if _, err := w.Write(body); err != nil {
+		f.logger.Error("response write error", slog.Any("error", err))
+	}
+}
+
+// recordID returns the record identity in the path of a request for a
+// single record, or for the records related to it: /api/{version}/{type}/{id}
+func recordID(path string) (string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 4 || parts[0] != "api" || parts[3] == "" {
+		return "", false
+	}
+	return parts[3], true
+}
+
+// handlerFor returns the handler serving records from the named networks,
+// scoped to the sites in the namespaces when scoped is set
+func (f *Federation) handlerFor(names []string, scoped bool, namespaces []string) *federatedHandler {
+	names = slices.Compact(slices.Sorted(slices.Values(names)))
+	key := strings.Join(names, ",")
+	if scoped {
+		key += "/" + strings.Join(namespaces, ",")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if handler, ok := f.handlers[key]; ok {
+		return handler
+	}
+	var (
+		records federatedStore
+		graph   federatedGraph
+	)
+	for _, name := range names {
+		network := f.networks[name]
+		var networkRecords store.Interface = network.Records
+		if scoped {
+			networkRecords = newScopedStore(network.Records, network.Graph, namespaces)
+		}
+		records = append(records, networkRecords)
+		graph = append(graph, network.Graph)
+	}
+	handler := &federatedHandler{names: names, records: records}
+	if len(names) == 1 {
+		handler.Handler = f.handler(records[0], graph[0])
+	} else {
+		handler.Handler = f.handler(records, graph)
+	}
+	if len(f.handlers) >= maxFederatedHandlers {
+		// evict an arbitrary handler, it is created again when needed
+		for k := range f.handlers {
+			delete(f.handlers, k)
+			break
+		}
+	}
+	f.handlers[key] = handler
+	return handler
+}
+
+// federatedHandler serves the records of a set of networks
+type federatedHandler struct {
+	http.Handler
+	names   []string
+	records federatedStore
+}
+
+// networksOf returns the names of the networks a record is found in
+func (h *federatedHandler) networksOf(id string) []string {
+	var out []string
+	for i, stor := range h.records {
+		if _, ok := stor.Get(id); ok {
+			out = append(out, h.names[i])
+		}
+	}
+	return out
+}
+
+// labelResults sets the network of the results in a response body, when the
+// identity of the result is found in a single network
+func (h *federatedHandler) labelResults(body []byte) ([]byte, error) {
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	results, ok := response["results"]
+	if !ok {
+		return body, nil
+	}
+	label := func(result map[string]json.RawMessage) error {
+		var id string
+		if err := json.Unmarshal(result["identity"], &id); err != nil || id == "" {
+			return nil
+		}
+		networks := h.networksOf(id)
+		if len(networks) != 1 {
+			return nil
+		}
+		network, err := json.Marshal(networks[0])
+		if err != nil {
+			return err
+		}
+		result["network"] = network
+		return nil
+	}
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(results), []byte("[")) {
+		var list []map[string]json.RawMessage
+		if err = json.Unmarshal(results, &list); err != nil {
+			return nil, err
+		}
+		for _, result := range list {
+			if err := label(result); err != nil {
+				return nil, err
+			}
+		}
+		results, err = json.Marshal(list)
+	} else {
+		var result map[string]json.RawMessage
+		if err = json.Unmarshal(results, &result); err != nil {
+			return nil, err
+		}
+		if err := label(result); err != nil {
+			return nil, err
+		}
+		results, err = json.Marshal(result)
+	}
+	if err != nil {
+		return nil, err
+	}
+	response["results"] = results
+	out, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// bufferedResponse holds a response so that it can be rewritten
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *bufferedResponse) Header() http.Header { return r.header }
+
+func (r *bufferedResponse) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *bufferedResponse) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(data)
+}
+
+// federatedStore is a read only view over the stores of multiple networks
+type federatedStore []store.Interface
+
+func (s federatedStore) Add(record vanflow.Record, source store.SourceRef) bool { return false }
+func (s federatedStore) Update(vanflow.Record) bool                             { return false }
+func (s federatedStore) Delete(id string) (store.Entry, bool)                   { return store.Entry{}, false }
+func (s federatedStore) Patch(record vanflow.Record, source store.SourceRef)    {}
+func (s federatedStore) Replace([]store.Entry)                                  {}
+
+func (s federatedStore) Get(id string) (store.Entry, bool) {
+	for _, stor := range s {
+		if entry, ok := stor.Get(id); ok {
+			return entry, true
+		}
+	}
+	return store.Entry{}, false
+}
+
+func (s federatedStore) List() []store.Entry {
+	var out []store.Entry
+	for _, stor := range s {
+		out = append(out, stor.List()...)
+	}
+	return out
+}
+
+func (s federatedStore) Index(index string, exemplar store.Entry) []store.Entry {
+	var out []store.Entry
+	for _, stor := range s {
+		out = append(out, stor.Index(index, exemplar)...)
+	}
+	return out
+}
+
+func (s federatedStore) IndexValues(index string) []string {
+	var out []string
+	for _, stor := range s {
+		out = append(out, stor.IndexValues(index)...)
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// federatedGraph is a view over the graphs of multiple networks, where nodes
+// are resolved from the first graph they are known in
+type federatedGraph []collector.Graph
+
+func firstKnown[N collector.Node](graphs federatedGraph, get func(collector.Graph) N) N {
+	var node N
+	for _, graph := range graphs {
+		if node = get(graph); node.IsKnown() {
+			return node
+		}
+	}
+	return node
+}
+
+func (g federatedGraph) Address(id string) collector.Address {
+	return firstKnown(g, func(graph collector.Graph) collector.Address { return graph.Address(id) })
+}
+
+func (g federatedGraph) Connector(id string) collector.Connector {
+	return firstKnown(g, func(graph collector.Graph) collector.Connector { return graph.Connector(id) })
+}
+
+func (g federatedGraph) SiteHost(id string) collector.SiteHost {
+	return firstKnown(g, func(graph collector.Graph) collector.SiteHost { return graph.SiteHost(id) })
+}
+
+func (g federatedGraph) Link(id string) collector.Link {
+	return firstKnown(g, func(graph collector.Graph) collector.Link { return graph.Link(id) })
+}
+
+func (g federatedGraph) Listener(id string) collector.Listener {
+	return firstKnown(g, func(graph collector.Graph) collector.Listener { return graph.Listener(id) })
+}
+
+func (g federatedGraph) Process(id string) collector.Process {
+	return firstKnown(g, func(graph collector.Graph) collector.Process { return graph.Process(id) })
+}
+
+func (g federatedGraph) RouterAccess(id string) collector.RouterAccess {
+	return firstKnown(g, func(graph collector.Graph) collector.RouterAccess { return graph.RouterAccess(id) })
+}
+
+func (g federatedGraph) Site(id string) collector.Site {
+	return firstKnown(g, func(graph collector.Graph) collector.Site { return graph.Site(id) })
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestFederation(t *testing.T) {
+	tlog := slog.Default()
+	var networks []Network
+	for name, sites := range map[string][]string{
+		"east": {"site-1", "site-2"},
+		"west": {"site-3"},
+	} {
+		stor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+		var records []vanflow.Record
+		for _, site := range sites {
+			records = append(records, vanflow.SiteRecord{BaseRecord: vanflow.NewBase(site)})
+		}
+		stor.Replace(wrapRecords(records...))
+		networks = append(networks, Network{Name: name, Records: stor, Graph: collector.NewGraph(stor)})
+	}
+	federation := NewFederation(tlog, networks, func(records store.Interface, graph collector.Graph) http.Handler {
+		return api.Handler(New(tlog, records, graph))
+	})
+	srv := httptest.NewTLSServer(federation)
+	defer srv.Close()
+	c, err := api.NewClientWithResponses(srv.URL, api.WithHTTPClient(srv.Client()))
+	assert.Assert(t, err)
+
+	testcases := []struct {
+		Networks      []string
+		ExpectStatus  int
+		ExpectResults []string
+	}{
+		{
+			ExpectStatus:  200,
+			ExpectResults: []string{"site-1", "site-2", "site-3"},
+		}, {
+			Networks:      []string{"east"},
+			ExpectStatus:  200,
+			ExpectResults: []string{"site-1", "site-2"},
+		}, {
+			Networks:      []string{"west", "east"},
+			ExpectStatus:  200,
+			ExpectResults: []string{"site-1", "site-2", "site-3"},
+		}, {
+			Networks:     []string{"north"},
+			ExpectStatus: 400,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run("", func(t *testing.T) {
+			resp, err := c.SitesWithResponse(context.TODO(), withParameters(map[string][]string{
+				NetworkQueryParam: tc.Networks,
+			}))
+			assert.Check(t, err)
+			assert.Equal(t, resp.StatusCode(), tc.ExpectStatus)
+			if tc.ExpectStatus != 200 {
+				assert.Check(t, resp.JSON400 != nil)
+				return
+			}
+			var sites []string
+			for _, site := range resp.JSON200.Results {
+				sites = append(sites, site.Identity)
+			}
+			slices.Sort(sites)
+			assert.DeepEqual(t, sites, tc.ExpectResults)
+		})
+	}
+
+	t.Run("by id", func(t *testing.T) {
+		resp, err := c.SiteByIdWithResponse(context.TODO(), "site-3")
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, resp.JSON200.Results.Identity, "site-3")
+
+		resp, err = c.SiteByIdWithResponse(context.TODO(), "site-3", withParameters(map[string][]string{
+			NetworkQueryParam: {"east"},
+		}))
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 404)
+	})
+}
+
+func TestFederationNetworkLabels(t *testing.T) {
+	tlog := slog.Default()
+	var networks []Network
+	for name, sites := range map[string][]string{
+		"east": {"site-1", "shared"},
+		"west": {"site-2", "shared"},
+	} {
+		stor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+		var records []vanflow.Record
+		for _, site := range sites {
+			records = append(records, vanflow.SiteRecord{BaseRecord: vanflow.NewBase(site)})
+		}
+		stor.Replace(wrapRecords(records...))
+		networks = append(networks, Network{Name: name, Records: stor, Graph: collector.NewGraph(stor)})
+	}
+	federation := NewFederation(tlog, networks, func(records store.Interface, graph collector.Graph) http.Handler {
+		return api.Handler(New(tlog, records, graph))
+	})
+	srv := httptest.NewTLSServer(federation)
+	defer srv.Close()
+	c, err := api.NewClientWithResponses(srv.URL, api.WithHTTPClient(srv.Client()))
+	assert.Assert(t, err)
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := c.SitesWithResponse(context.TODO())
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		labels := make(map[string][]string)
+		for _, site := range resp.JSON200.Results {
+			labels[site.Identity] = append(labels[site.Identity], dref(site.Network))
+		}
+		assert.DeepEqual(t, labels, map[string][]string{
+			"site-1": {"east"},
+			"site-2": {"west"},
+			"shared": {"", ""},
+		})
+	})
+
+	t.Run("by id", func(t *testing.T) {
+		resp, err := c.SiteByIdWithResponse(context.TODO(), "site-2")
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, dref(resp.JSON200.Results.Network), "west")
+
+		resp, err = c.SiteByIdWithResponse(context.TODO(), "shared")
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 400)
+		assert.Equal(t, resp.JSON400.Message, `record "shared" is found in networks east, west, select one with the network query parameter`)
+
+		resp, err = c.SiteByIdWithResponse(context.TODO(), "shared", withParameters(map[string][]string{
+			NetworkQueryParam: {"east"},
+		}))
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, dref(resp.JSON200.Results.Network), "east")
+	})
+}
+
+func TestFederationHandlerCacheBound(t *testing.T) {
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	federation := NewFederation(slog.Default(), []Network{{Name: "east", Records: stor, Graph: collector.NewGraph(stor)}},
+		func(records store.Interface, graph collector.Graph) http.Handler {
+			return http.NotFoundHandler()
+		})
+	for i := range maxFederatedHandlers + 8 {
+		federation.handlerFor([]string{"east"}, true, []string{fmt.Sprintf("namespace-%d", i)})
+	}
+	assert.Equal(t, len(federation.handlers), maxFederatedHandlers)
+}
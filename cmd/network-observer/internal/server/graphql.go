@@ -0,0 +1,577 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/graph-gophers/graphql-go"
+	graphqllog "github.com/graph-gophers/graphql-go/log"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/server/views"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+//go:embed schema.graphql
+var graphqlSchema string
+
+// maxQueryDepth bounds the nesting of queries, which can otherwise traverse
+// the cycles of the graph indefinitely
+const maxQueryDepth = 12
+
+// NewGraphQLHandler returns a handler serving GraphQL queries over the records
+// and graph of a network, so that nested views of it can be built in a single
+// request.
+func NewGraphQLHandler(logger *slog.Logger, records store.Interface, graph collector.Graph) http.Handler {
+	schema := graphql.MustParseSchema(graphqlSchema,
+		&queryResolver{records: records, graph: graph},
+		graphql.MaxDepth(maxQueryDepth),
+		graphql.Logger(graphqllog.LoggerFunc(func(ctx context.Context, value interface{}) {
+			logger.Error("graphql query panic", slog.Any("error", value))
+		})),
+	)
+	return &graphqlHandler{logger: logger, schema: schema}
+}
+
+type graphqlHandler struct {
+	logger *slog.Logger
+	schema *graphql.Schema
+}
+
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (h *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req graphqlRequest
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				h.badRequest(w, r, fmt.Sprintf("invalid variables: %s", err))
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.badRequest(w, r, fmt.Sprintf("invalid request: %s", err))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	resp := h.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+	if err := encodeResponse(w, http.StatusOK, resp); err != nil {
+		requestLogger(h.logger, r).Error("response write error", slog.Any("error", err))
+	}
+}
+
+func (h *graphqlHandler) badRequest(w http.ResponseWriter, r *http.Request, message string) {
+	if err := encodeResponse(w, http.StatusBadRequest, api.ErrorBadRequest{Message: message}); err != nil {
+		requestLogger(h.logger, r).Error("response write error", slog.Any("error", err))
+	}
+}
+
+// uint64Scalar is the Uint64 scalar of the schema
+type uint64Scalar uint64
+
+func (uint64Scalar) ImplementsGraphQLType(name string) bool {
+	return name == "Uint64"
+}
+
+func (u *uint64Scalar) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case int32:
+		if v < 0 {
+			return fmt.Errorf("invalid Uint64 %d", v)
+		}
+		*u = uint64Scalar(v)
+	case float64:
+		if v < 0 {
+			return fmt.Errorf("invalid Uint64 %f", v)
+		}
+		*u = uint64Scalar(v)
+	default:
+		return fmt.Errorf("invalid Uint64 %v", input)
+	}
+	return nil
+}
+
+func ptrToUint64(v *uint64) *uint64Scalar {
+	if v == nil {
+		return nil
+	}
+	out := uint64Scalar(*v)
+	return &out
+}
+
+// matches returns true when the optional filter is unset or equal to value
+func matches(filter *string, value string) bool {
+	return filter == nil || *filter == value
+}
+
+func filterMap[R any, T any](records []R, match func(R) bool, resolve func(R) T) []T {
+	out := make([]T, 0, len(records))
+	for _, record := range records {
+		if match(record) {
+			out = append(out, resolve(record))
+		}
+	}
+	return out
+}
+
+// nodeEntries returns the entries of the nodes found in records
+func nodeEntries[N collector.Node](records store.Interface, nodes []N) []store.Entry {
+	out := make([]store.Entry, 0, len(nodes))
+	for _, node := range nodes {
+		if entry, ok := records.Get(node.ID()); ok {
+			out = append(out, entry)
+		}
+	}
+	return ordered(out)
+}
+
+type queryResolver struct {
+	records store.Interface
+	graph   collector.Graph
+}
+
+type nameArgs struct {
+	Name *string
+}
+
+type idArgs struct {
+	ID graphql.ID
+}
+
+func (q *queryResolver) Sites(args nameArgs) []*siteResolver {
+	return q.sites(listByType[vanflow.SiteRecord](q.records), args.Name)
+}
+
+func (q *queryResolver) Site(args idArgs) *siteResolver {
+	return q.site(string(args.ID))
+}
+
+func (q *queryResolver) Routers(args nameArgs) []*routerResolver {
+	return q.routers(listByType[vanflow.RouterRecord](q.records), args.Name)
+}
+
+func (q *queryResolver) Router(args idArgs) *routerResolver {
+	return q.router(string(args.ID))
+}
+
+func (q *queryResolver) Services(args struct{ Name, Protocol *string }) []*serviceResolver {
+	services := views.NewServiceSliceProvider(q.records, q.graph)(listByType[collector.AddressRecord](q.records))
+	return filterMap(services, func(service api.ServiceRecord) bool {
+		return matches(args.Name, service.Name) && matches(args.Protocol, service.Protocol)
+	}, q.resolveService)
+}
+
+func (q *queryResolver) Service(args idArgs) *serviceResolver {
+	return q.service(string(args.ID))
+}
+
+func (q *queryResolver) Processes(args nameArgs) []*processResolver {
+	return q.processes(listByType[vanflow.ProcessRecord](q.records), args.Name)
+}
+
+func (q *queryResolver) Process(args idArgs) *processResolver {
+	return q.process(string(args.ID))
+}
+
+func (q *queryResolver) sites(entries []store.Entry, name *string) []*siteResolver {
+	sites := views.NewSiteSliceProvider(q.graph)(entries)
+	return filterMap(sites, func(site api.SiteRecord) bool {
+		return matches(name, site.Name)
+	}, func(site api.SiteRecord) *siteResolver {
+		return &siteResolver{q: q, record: site}
+	})
+}
+
+func (q *queryResolver) site(id string) *siteResolver {
+	site, ok := fetchAndMap(q.records, views.NewSiteProvider(q.graph), id)()
+	if !ok {
+		return nil
+	}
+	return &siteResolver{q: q, record: site}
+}
+
+func (q *queryResolver) routers(entries []store.Entry, name *string) []*routerResolver {
+	return filterMap(views.Routers(entries), func(router api.RouterRecord) bool {
+		return matches(name, router.Name)
+	}, func(router api.RouterRecord) *routerResolver {
+		return &routerResolver{q: q, record: router}
+	})
+}
+
+func (q *queryResolver) router(id string) *routerResolver {
+	router, ok := fetchAndMap(q.records, views.Router, id)()
+	if !ok {
+		return nil
+	}
+	return &routerResolver{q: q, record: router}
+}
+
+func (q *queryResolver) listeners(entries []store.Entry, routingKey *string) []*listenerResolver {
+	listeners := views.NewListenerSliceProvider(q.graph)(entries)
+	return filterMap(listeners, func(listener api.ListenerRecord) bool {
+		return matches(routingKey, listener.RoutingKey)
+	}, func(listener api.ListenerRecord) *listenerResolver {
+		return &listenerResolver{q: q, record: listener}
+	})
+}
+
+func (q *queryResolver) listener(id string) *listenerResolver {
+	listener, ok := fetchAndMap(q.records, views.NewListenerProvider(q.graph), id)()
+	if !ok {
+		return nil
+	}
+	return &listenerResolver{q: q, record: listener}
+}
+
+func (q *queryResolver) connectors(entries []store.Entry, routingKey *string) []*connectorResolver {
+	connectors := views.NewConnectorSliceProvider(q.graph)(entries)
+	return filterMap(connectors, func(connector api.ConnectorRecord) bool {
+		return matches(routingKey, connector.RoutingKey)
+	}, func(connector api.ConnectorRecord) *connectorResolver {
+		return &connectorResolver{q: q, record: connector}
+	})
+}
+
+func (q *queryResolver) connector(id string) *connectorResolver {
+	connector, ok := fetchAndMap(q.records, views.NewConnectorProvider(q.graph), id)()
+	if !ok {
+		return nil
+	}
+	return &connectorResolver{q: q, record: connector}
+}
+
+func (q *queryResolver) resolveService(service api.ServiceRecord) *serviceResolver {
+	return &serviceResolver{q: q, record: service}
+}
+
+func (q *queryResolver) service(id string) *serviceResolver {
+	service, ok := fetchAndMap(q.records, views.NewServiceProvider(q.records, q.graph), id)()
+	if !ok {
+		return nil
+	}
+	return q.resolveService(service)
+}
+
+func (q *queryResolver) processes(entries []store.Entry, name *string) []*processResolver {
+	processes := views.NewProcessSliceProvider(q.records, q.graph)(entries)
+	return filterMap(processes, func(process api.ProcessRecord) bool {
+		return matches(name, process.Name)
+	}, func(process api.ProcessRecord) *processResolver {
+		return &processResolver{q: q, record: process}
+	})
+}
+
+func (q *queryResolver) process(id string) *processResolver {
+	process, ok := fetchAndConditionalMap(q.records, views.NewProcessProvider(q.records, q.graph), id)()
+	if !ok {
+		return nil
+	}
+	return &processResolver{q: q, record: process}
+}
+
+type siteResolver struct {
+	q      *queryResolver
+	record api.SiteRecord
+}
+
+func (r *siteResolver) Identity() graphql.ID    { return graphql.ID(r.record.Identity) }
+func (r *siteResolver) Name() string            { return r.record.Name }
+func (r *siteResolver) Namespace() *string      { return r.record.Namespace }
+func (r *siteResolver) Platform() string        { return string(r.record.Platform) }
+func (r *siteResolver) Provider() string        { return r.record.Provider }
+func (r *siteResolver) Version() string         { return r.record.Version }
+func (r *siteResolver) StartTime() uint64Scalar { return uint64Scalar(r.record.StartTime) }
+func (r *siteResolver) EndTime() uint64Scalar   { return uint64Scalar(r.record.EndTime) }
+
+func (r *siteResolver) Routers(args nameArgs) []*routerResolver {
+	exemplar := store.Entry{Record: vanflow.RouterRecord{Parent: &r.record.Identity}}
+	return r.q.routers(index(r.q.records, collector.IndexByTypeParent, exemplar), args.Name)
+}
+
+func (r *siteResolver) Processes(args nameArgs) []*processResolver {
+	exemplar := store.Entry{Record: vanflow.ProcessRecord{Parent: &r.record.Identity}}
+	return r.q.processes(index(r.q.records, collector.IndexByTypeParent, exemplar), args.Name)
+}
+
+type routerResolver struct {
+	q      *queryResolver
+	record api.RouterRecord
+}
+
+func (r *routerResolver) Identity() graphql.ID    { return graphql.ID(r.record.Identity) }
+func (r *routerResolver) Name() string            { return r.record.Name }
+func (r *routerResolver) Namespace() *string      { return r.record.Namespace }
+func (r *routerResolver) Mode() string            { return r.record.Mode }
+func (r *routerResolver) HostName() string        { return r.record.HostName }
+func (r *routerResolver) StartTime() uint64Scalar { return uint64Scalar(r.record.StartTime) }
+func (r *routerResolver) EndTime() uint64Scalar   { return uint64Scalar(r.record.EndTime) }
+func (r *routerResolver) Site() *siteResolver     { return r.q.site(r.record.SiteId) }
+
+func (r *routerResolver) Listeners(args struct{ RoutingKey *string }) []*listenerResolver {
+	exemplar := store.Entry{Record: vanflow.ListenerRecord{Parent: &r.record.Identity}}
+	return r.q.listeners(index(r.q.records, collector.IndexByTypeParent, exemplar), args.RoutingKey)
+}
+
+func (r *routerResolver) Connectors(args struct{ RoutingKey *string }) []*connectorResolver {
+	exemplar := store.Entry{Record: vanflow.ConnectorRecord{Parent: &r.record.Identity}}
+	return r.q.connectors(index(r.q.records, collector.IndexByTypeParent, exemplar), args.RoutingKey)
+}
+
+type listenerResolver struct {
+	q      *queryResolver
+	record api.ListenerRecord
+}
+
+func (r *listenerResolver) Identity() graphql.ID    { return graphql.ID(r.record.Identity) }
+func (r *listenerResolver) Name() string            { return r.record.Name }
+func (r *listenerResolver) RoutingKey() string      { return r.record.RoutingKey }
+func (r *listenerResolver) Protocol() string        { return r.record.Protocol }
+func (r *listenerResolver) DestHost() string        { return r.record.DestHost }
+func (r *listenerResolver) DestPort() string        { return r.record.DestPort }
+func (r *listenerResolver) StartTime() uint64Scalar { return uint64Scalar(r.record.StartTime) }
+func (r *listenerResolver) EndTime() uint64Scalar   { return uint64Scalar(r.record.EndTime) }
+func (r *listenerResolver) Router() *routerResolver { return r.q.router(r.record.RouterId) }
+
+func (r *listenerResolver) Service() *serviceResolver {
+	if r.record.ServiceId == nil {
+		return nil
+	}
+	return r.q.service(*r.record.ServiceId)
+}
+
+type connectorResolver struct {
+	q      *queryResolver
+	record api.ConnectorRecord
+}
+
+func (r *connectorResolver) Identity() graphql.ID    { return graphql.ID(r.record.Identity) }
+func (r *connectorResolver) Name() string            { return r.record.Name }
+func (r *connectorResolver) RoutingKey() string      { return r.record.RoutingKey }
+func (r *connectorResolver) Protocol() string        { return r.record.Protocol }
+func (r *connectorResolver) DestHost() string        { return r.record.DestHost }
+func (r *connectorResolver) DestPort() string        { return r.record.DestPort }
+func (r *connectorResolver) StartTime() uint64Scalar { return uint64Scalar(r.record.StartTime) }
+func (r *connectorResolver) EndTime() uint64Scalar   { return uint64Scalar(r.record.EndTime) }
+func (r *connectorResolver) Router() *routerResolver { return r.q.router(r.record.RouterId) }
+
+func (r *connectorResolver) Service() *serviceResolver {
+	if r.record.ServiceId == nil {
+		return nil
+	}
+	return r.q.service(*r.record.ServiceId)
+}
+
+func (r *connectorResolver) Process() *processResolver {
+	target := r.q.graph.Connector(r.record.Identity).Target()
+	if !target.IsKnown() {
+		return nil
+	}
+	return r.q.process(target.ID())
+}
+
+type serviceResolver struct {
+	q      *queryResolver
+	record api.ServiceRecord
+}
+
+func (r *serviceResolver) Identity() graphql.ID    { return graphql.ID(r.record.Identity) }
+func (r *serviceResolver) Name() string            { return r.record.Name }
+func (r *serviceResolver) Protocol() string        { return r.record.Protocol }
+func (r *serviceResolver) IsBound() bool           { return r.record.IsBound }
+func (r *serviceResolver) HasListener() bool       { return r.record.HasListener }
+func (r *serviceResolver) StartTime() uint64Scalar { return uint64Scalar(r.record.StartTime) }
+func (r *serviceResolver) EndTime() uint64Scalar   { return uint64Scalar(r.record.EndTime) }
+
+func (r *serviceResolver) ObservedApplicationProtocols() []string {
+	if r.record.ObservedApplicationProtocols == nil {
+		return []string{}
+	}
+	return r.record.ObservedApplicationProtocols
+}
+
+func (r *serviceResolver) Listeners() []*listenerResolver {
+	routingKey := r.q.graph.Address(r.record.Identity).RoutingKey()
+	return r.q.listeners(nodeEntries(r.q.records, routingKey.Listeners()), nil)
+}
+
+func (r *serviceResolver) Connectors() []*connectorResolver {
+	routingKey := r.q.graph.Address(r.record.Identity).RoutingKey()
+	return r.q.connectors(nodeEntries(r.q.records, routingKey.Connectors()), nil)
+}
+
+func (r *serviceResolver) Processes() []*processResolver {
+	var targets []collector.Process
+	for _, connector := range r.q.graph.Address(r.record.Identity).RoutingKey().Connectors() {
+		targets = append(targets, connector.Target())
+	}
+	return r.q.processes(slices.CompactFunc(nodeEntries(r.q.records, targets), func(a, b store.Entry) bool {
+		return a.Record.Identity() == b.Record.Identity()
+	}), nil)
+}
+
+type connectionArgs struct {
+	SourceSite *graphql.ID
+	DestSite   *graphql.ID
+	Active     *bool
+	Limit      *int32
+}
+
+func (r *serviceResolver) Connections(args connectionArgs) []*connectionResolver {
+	exemplar := store.Entry{Record: collector.ConnectionRecord{RoutingKey: r.record.Name, Protocol: r.record.Protocol}}
+	connections := views.NewConnectionsSliceProvider(r.q.records)(r.q.records.Index(collector.IndexFlowByAddress, exemplar))
+	slices.SortFunc(connections, func(a, b api.ConnectionRecord) int {
+		if c := cmp.Compare(b.StartTime, a.StartTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Identity, b.Identity)
+	})
+	out := filterMap(connections, func(connection api.ConnectionRecord) bool {
+		return (args.SourceSite == nil || string(*args.SourceSite) == connection.SourceSiteId) &&
+			(args.DestSite == nil || string(*args.DestSite) == connection.DestSiteId) &&
+			(args.Active == nil || *args.Active == (connection.EndTime == 0))
+	}, func(connection api.ConnectionRecord) *connectionResolver {
+		return &connectionResolver{q: r.q, record: connection}
+	})
+	if args.Limit != nil && *args.Limit >= 0 && int(*args.Limit) < len(out) {
+		out = out[:*args.Limit]
+	}
+	return out
+}
+
+func (r *serviceResolver) TcpStats() *tcpStatsResolver {
+	exemplar := store.Entry{Record: collector.ConnectionRecord{RoutingKey: r.record.Name, Protocol: r.record.Protocol}}
+	connections := views.NewConnectionsSliceProvider(r.q.records)(r.q.records.Index(collector.IndexFlowByAddress, exemplar))
+	return &tcpStatsResolver{record: views.TCPStats(connections)}
+}
+
+type tcpStatsResolver struct {
+	record api.TcpStatsRecord
+}
+
+func (r *tcpStatsResolver) ConnectionCount() uint64Scalar {
+	return uint64Scalar(r.record.ConnectionCount)
+}
+func (r *tcpStatsResolver) ActiveConnectionCount() uint64Scalar {
+	return uint64Scalar(r.record.ActiveConnectionCount)
+}
+func (r *tcpStatsResolver) TimeToFirstByteP50() uint64Scalar {
+	return uint64Scalar(r.record.TimeToFirstByteP50)
+}
+func (r *tcpStatsResolver) TimeToFirstByteP95() uint64Scalar {
+	return uint64Scalar(r.record.TimeToFirstByteP95)
+}
+func (r *tcpStatsResolver) TimeToFirstByteMax() uint64Scalar {
+	return uint64Scalar(r.record.TimeToFirstByteMax)
+}
+func (r *tcpStatsResolver) WindowClosures() uint64Scalar {
+	return uint64Scalar(r.record.WindowClosures)
+}
+func (r *tcpStatsResolver) OctetsUnacked() uint64Scalar { return uint64Scalar(r.record.OctetsUnacked) }
+
+func (r *tcpStatsResolver) TerminationReasons() []*terminationCountResolver {
+	out := make([]*terminationCountResolver, 0, len(r.record.TerminationReasons))
+	for _, reason := range slices.Sorted(maps.Keys(r.record.TerminationReasons)) {
+		out = append(out, &terminationCountResolver{reason: reason, count: r.record.TerminationReasons[reason]})
+	}
+	return out
+}
+
+type terminationCountResolver struct {
+	reason string
+	count  uint64
+}
+
+func (r *terminationCountResolver) Reason() string      { return r.reason }
+func (r *terminationCountResolver) Count() uint64Scalar { return uint64Scalar(r.count) }
+
+type processResolver struct {
+	q      *queryResolver
+	record api.ProcessRecord
+}
+
+func (r *processResolver) Identity() graphql.ID    { return graphql.ID(r.record.Identity) }
+func (r *processResolver) Name() string            { return r.record.Name }
+func (r *processResolver) SourceHost() string      { return r.record.SourceHost }
+func (r *processResolver) HostName() *string       { return r.record.HostName }
+func (r *processResolver) ImageName() *string      { return r.record.ImageName }
+func (r *processResolver) ComponentName() string   { return r.record.ComponentName }
+func (r *processResolver) Role() string            { return string(r.record.Role) }
+func (r *processResolver) Binding() string         { return string(r.record.Binding) }
+func (r *processResolver) StartTime() uint64Scalar { return uint64Scalar(r.record.StartTime) }
+func (r *processResolver) EndTime() uint64Scalar   { return uint64Scalar(r.record.EndTime) }
+func (r *processResolver) Site() *siteResolver     { return r.q.site(r.record.SiteId) }
+
+func (r *processResolver) Connectors() []*connectorResolver {
+	return r.q.connectors(nodeEntries(r.q.records, r.q.graph.Process(r.record.Identity).Connectors()), nil)
+}
+
+type connectionResolver struct {
+	q      *queryResolver
+	record api.ConnectionRecord
+}
+
+func (r *connectionResolver) Identity() graphql.ID     { return graphql.ID(r.record.Identity) }
+func (r *connectionResolver) RoutingKey() string       { return r.record.RoutingKey }
+func (r *connectionResolver) Protocol() string         { return r.record.Protocol }
+func (r *connectionResolver) SourceHost() string       { return r.record.SourceHost }
+func (r *connectionResolver) SourcePort() string       { return r.record.SourcePort }
+func (r *connectionResolver) DestHost() string         { return r.record.DestHost }
+func (r *connectionResolver) DestPort() string         { return r.record.DestPort }
+func (r *connectionResolver) OctetCount() uint64Scalar { return uint64Scalar(r.record.OctetCount) }
+func (r *connectionResolver) OctetReverseCount() uint64Scalar {
+	return uint64Scalar(r.record.OctetReverseCount)
+}
+func (r *connectionResolver) Latency() uint64Scalar { return uint64Scalar(r.record.Latency) }
+func (r *connectionResolver) LatencyReverse() uint64Scalar {
+	return uint64Scalar(r.record.LatencyReverse)
+}
+func (r *connectionResolver) TerminationReason() *string {
+	if r.record.TerminationReason == nil {
+		return nil
+	}
+	reason := string(*r.record.TerminationReason)
+	return &reason
+}
+func (r *connectionResolver) Duration() *uint64Scalar { return ptrToUint64(r.record.Duration) }
+func (r *connectionResolver) WindowClosures() *uint64Scalar {
+	return ptrToUint64(r.record.WindowClosures)
+}
+func (r *connectionResolver) WindowSize() *uint64Scalar { return ptrToUint64(r.record.WindowSize) }
+func (r *connectionResolver) OctetsUnacked() *uint64Scalar {
+	return ptrToUint64(r.record.OctetsUnacked)
+}
+func (r *connectionResolver) StartTime() uint64Scalar   { return uint64Scalar(r.record.StartTime) }
+func (r *connectionResolver) EndTime() uint64Scalar     { return uint64Scalar(r.record.EndTime) }
+func (r *connectionResolver) SourceSite() *siteResolver { return r.q.site(r.record.SourceSiteId) }
+func (r *connectionResolver) DestSite() *siteResolver   { return r.q.site(r.record.DestSiteId) }
+func (r *connectionResolver) SourceProcess() *processResolver {
+	return r.q.process(r.record.SourceProcessId)
+}
+func (r *connectionResolver) DestProcess() *processResolver {
+	return r.q.process(r.record.DestProcessId)
+}
+func (r *connectionResolver) Listener() *listenerResolver { return r.q.listener(r.record.ListenerId) }
+func (r *connectionResolver) Connector() *connectorResolver {
+	return r.q.connector(r.record.ConnectorId)
+}
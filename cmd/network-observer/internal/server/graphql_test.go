@@ -0,0 +1,132 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestGraphQL(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	flowStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	graph := collector.NewGraph(stor)
+	srv := httptest.NewServer(NewGraphQLHandler(tlog, stor, graph))
+	defer srv.Close()
+
+	begin := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stor.Replace(append(exProcessWithAddresses(), wrapRecords(
+		vanflow.ListenerRecord{
+			BaseRecord: vanflow.NewBase("l1"),
+			Parent:     ptrTo("router-1"),
+			Address:    ptrTo("icecream"),
+			Protocol:   ptrTo("tcp"),
+		},
+		collector.ConnectionRecord{
+			ID:         "flow:1",
+			RoutingKey: "icecream",
+			Protocol:   "tcp",
+			SourceSite: collector.NamedReference{ID: "site-1", Name: "site one"},
+			Dest:       collector.NamedReference{ID: "1", Name: "processone"},
+			FlowStore:  flowStor,
+		},
+		collector.ConnectionRecord{
+			ID:         "flow:2",
+			RoutingKey: "icecream",
+			Protocol:   "tcp",
+			FlowStore:  flowStor,
+		},
+	)...))
+	flowStor.Replace(wrapRecords(
+		vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("flow:1", begin.Add(time.Minute)), Octets: ptrTo(uint64(33))},
+		vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("flow:2", begin, begin.Add(time.Second))},
+	))
+	graph.(reset).Reset()
+
+	testcases := []struct {
+		Name   string
+		Query  string
+		Expect string
+	}{
+		{
+			Name:   "sites to processes",
+			Query:  `{ sites { name routers { identity connectors { identity service { name } process { name site { identity } } } } } }`,
+			Expect: `{"sites":[{"name":"site one","routers":[{"identity":"router-1","connectors":[{"identity":"c2","service":{"name":"icecream"},"process":{"name":"processone","site":{"identity":"site-1"}}},{"identity":"c3","service":{"name":"icecream"},"process":{"name":"processone","site":{"identity":"site-1"}}}]}]}]}`,
+		}, {
+			Name:   "service view",
+			Query:  `{ services(name: "icecream") { identity listeners { identity router { identity } } processes { identity } connections { identity octetCount startTime endTime } } }`,
+			Expect: `{"services":[{"identity":"icecream-addr-id","listeners":[{"identity":"l1","router":{"identity":"router-1"}}],"processes":[{"identity":"1"}],"connections":[{"identity":"flow:1","octetCount":33,"startTime":1704067260000000,"endTime":0},{"identity":"flow:2","octetCount":0,"startTime":1704067200000000,"endTime":1704067201000000}]}]}`,
+		}, {
+			Name:   "filtered connections",
+			Query:  `{ service(id: "icecream-addr-id") { connections(active: true, sourceSite: "site-1", limit: 1) { identity sourceSite { name } destProcess { identity } } } }`,
+			Expect: `{"service":{"connections":[{"identity":"flow:1","sourceSite":{"name":"site one"},"destProcess":{"identity":"1"}}]}}`,
+		}, {
+			Name:   "tcp stats",
+			Query:  `{ service(id: "icecream-addr-id") { tcpStats { connectionCount activeConnectionCount terminationReasons { reason count } } connections { terminationReason } } }`,
+			Expect: `{"service":{"tcpStats":{"connectionCount":2,"activeConnectionCount":1,"terminationReasons":[{"reason":"closed","count":1}]},"connections":[{"terminationReason":null},{"terminationReason":"closed"}]}}`,
+		}, {
+			Name:   "not found",
+			Query:  `{ process(id: "dne") { name } }`,
+			Expect: `{"process":null}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			body, _ := json.Marshal(graphqlRequest{Query: tc.Query})
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(string(body)))
+			assert.Assert(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, resp.StatusCode, 200)
+			var result struct {
+				Data   json.RawMessage `json:"data"`
+				Errors []any           `json:"errors"`
+			}
+			assert.Assert(t, json.NewDecoder(resp.Body).Decode(&result))
+			assert.Equal(t, len(result.Errors), 0)
+			assert.Equal(t, string(result.Data), tc.Expect)
+		})
+	}
+
+	t.Run("get", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "?query=" + url.QueryEscape(`{ site(id: "site-1") { processes { name } } }`))
+		assert.Assert(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, 200)
+		var result struct {
+			Data json.RawMessage `json:"data"`
+		}
+		assert.Assert(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, string(result.Data), `{"site":{"processes":[{"name":"processone"}]}}`)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "?query=" + url.QueryEscape(`{ sites { color } }`))
+		assert.Assert(t, err)
+		defer resp.Body.Close()
+		var result struct {
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+		assert.Assert(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, len(result.Errors), 1)
+		assert.Equal(t, result.Errors[0].Message, `Cannot query field "color" on type "Site".`)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader("query"))
+		assert.Assert(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, 400)
+	})
+}
@@ -55,11 +55,62 @@ func (s *server) ServiceByID(w http.ResponseWriter, r *http.Request, id string)
 	}
 }
 
+// (GET /api/v2alpha1/services/{id}/tcpstats)
+func (s *server) TcpStatsByService(w http.ResponseWriter, r *http.Request, id string) {
+	getStats := fetchAndMap(s.records, func(a collector.AddressRecord) api.TcpStatsRecord {
+		exemplar := store.Entry{Record: collector.ConnectionRecord{RoutingKey: a.Name, Protocol: a.Protocol}}
+		return views.TCPStats(views.NewConnectionsSliceProvider(s.records)(s.records.Index(collector.IndexFlowByAddress, exemplar)))
+	}, id)
+	if err := handleSingle(w, r, &api.TcpStatsResponse{}, getStats); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/services/{id}/slo)
+func (s *server) ServiceLevelObjectivesByService(w http.ResponseWriter, r *http.Request, id string) {
+	getExemplar := fetchAndMap(s.records, func(a collector.AddressRecord) store.Entry {
+		return store.Entry{Record: collector.ServiceLevelRecord{RoutingKey: a.Name, Protocol: a.Protocol}}
+	}, id)
+	if err := handleSubCollection(w, r, &api.ServiceLevelObjectiveListResponse{}, getExemplar, func(exemplar store.Entry) []api.ServiceLevelObjectiveRecord {
+		return views.NewServiceLevelObjectiveSliceProvider()(index(s.records, collector.IndexFlowByAddress, exemplar))
+	}); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/services/{id}/anomalies)
+func (s *server) AnomaliesByService(w http.ResponseWriter, r *http.Request, id string) {
+	getExemplar := fetchAndMap(s.records, func(a collector.AddressRecord) store.Entry {
+		return store.Entry{Record: collector.AnomalyRecord{RoutingKey: a.Name, Protocol: a.Protocol}}
+	}, id)
+	if err := handleSubCollection(w, r, &api.AnomalyListResponse{}, getExemplar, func(exemplar store.Entry) []api.AnomalyRecord {
+		return views.NewAnomalySliceProvider()(index(s.records, collector.IndexFlowByAddress, exemplar))
+	}); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/anomalies)
+func (s *server) Anomalies(w http.ResponseWriter, r *http.Request) {
+	results := views.NewAnomalySliceProvider()(listByType[collector.AnomalyRecord](s.records))
+	if err := handleCollection(w, r, &api.AnomalyListResponse{}, results); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/policy/violations)
+func (s *server) PolicyViolations(w http.ResponseWriter, r *http.Request) {
+	results := views.PolicyViolations(listByType[collector.PolicyViolationRecord](s.records))
+	if err := handleCollection(w, r, &api.PolicyViolationListResponse{}, results); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
 // (GET /api/v2alpha1/services/{id}/processes)
 func (s *server) ProcessesByService(w http.ResponseWriter, r *http.Request, id string) {
 	//todo(ck) find a way to more directly index this
 	anode := s.graph.Address(id)
-	if !anode.IsKnown() {
+	if _, ok := s.records.Get(id); !ok || !anode.IsKnown() {
 		resp := api.ErrorNotFound{
 			Code: "ErrNotFound",
 		}
@@ -71,7 +122,7 @@ func (s *server) ProcessesByService(w http.ResponseWriter, r *http.Request, id s
 	cnodes := anode.RoutingKey().Connectors()
 	entries := make([]store.Entry, 0, len(cnodes))
 	for _, cnode := range cnodes {
-		if entry, ok := cnode.Target().Get(); ok {
+		if entry, ok := s.records.Get(cnode.Target().ID()); ok {
 			entries = append(entries, entry)
 		}
 	}
@@ -43,6 +43,14 @@ func listByType[T vanflow.Record](stor store.Interface) []store.Entry {
 	return ordered(stor.Index(store.TypeIndex, store.Entry{Record: r}))
 }
 
+func dref[T any](ptr *T) T {
+	var out T
+	if ptr != nil {
+		out = *ptr
+	}
+	return out
+}
+
 func index(stor store.Interface, index string, exemplar store.Entry) []store.Entry {
 	return ordered(stor.Index(index, exemplar))
 }
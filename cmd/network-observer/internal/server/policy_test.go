@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestPolicyViolations(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	srv, c := requireTestClient(t, New(tlog, stor, graph))
+	defer srv.Close()
+
+	begin := time.Now()
+	west := collector.NamedReference{ID: "s1", Name: "west"}
+	east := collector.NamedReference{ID: "s2", Name: "east"}
+	stor.Replace(wrapRecords(
+		collector.PolicyViolationRecord{ID: "v1", Source: west, Dest: east, RoutingKey: "database", Protocol: "tcp", Start: begin},
+		collector.PolicyViolationRecord{ID: "v2", Source: east, Dest: west, RoutingKey: "backend", Protocol: "http1", Start: begin},
+	))
+
+	testcases := []collectionTestCase[api.PolicyViolationRecord]{
+		{ExpectOK: true, ExpectCount: 2},
+		{
+			Parameters:  map[string][]string{"routingKey": {"database"}},
+			ExpectOK:    true,
+			ExpectCount: 1,
+			ExpectResults: func(t *testing.T, results []api.PolicyViolationRecord) {
+				assert.DeepEqual(t, results[0], api.PolicyViolationRecord{
+					Identity:       "v1",
+					StartTime:      uint64(begin.UnixMicro()),
+					SourceSiteId:   "s1",
+					SourceSiteName: "west",
+					DestSiteId:     "s2",
+					DestSiteName:   "east",
+					RoutingKey:     "database",
+					Protocol:       "tcp",
+				})
+			},
+		},
+		{Parameters: map[string][]string{"sourceSite": {"west"}}},
+	}
+	for _, tc := range testcases {
+		t.Run("", func(t *testing.T) {
+			resp, err := c.PolicyViolationsWithResponse(context.TODO(), withParameters(tc.Parameters))
+			assert.Check(t, err)
+			if !tc.ExpectOK {
+				assert.Check(t, resp.JSON400 != nil)
+				return
+			}
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.Equal(t, resp.JSON200.Count, int64(tc.ExpectCount))
+			assert.Equal(t, len(resp.JSON200.Results), tc.ExpectCount)
+			if tc.ExpectResults != nil {
+				tc.ExpectResults(t, resp.JSON200.Results)
+			}
+		})
+	}
+}
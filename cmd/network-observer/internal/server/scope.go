@@ -0,0 +1,148 @@
+package server
+
+import (
+	"slices"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// scopedStore is a read only view over the records of a network that belong
+// to the sites in a set of namespaces. Records of traffic between sites are
+// visible when either site is, and services when any of their listeners or
+// connectors is.
+type scopedStore struct {
+	records    store.Interface
+	graph      collector.Graph
+	namespaces []string
+}
+
+func newScopedStore(records store.Interface, graph collector.Graph, namespaces []string) scopedStore {
+	return scopedStore{
+		records:    records,
+		graph:      graph,
+		namespaces: namespaces,
+	}
+}
+
+func (s scopedStore) Add(record vanflow.Record, source store.SourceRef) bool { return false }
+func (s scopedStore) Update(vanflow.Record) bool                             { return false }
+func (s scopedStore) Delete(id string) (store.Entry, bool)                   { return store.Entry{}, false }
+func (s scopedStore) Patch(record vanflow.Record, source store.SourceRef)    {}
+func (s scopedStore) Replace([]store.Entry)                                  {}
+
+func (s scopedStore) Get(id string) (store.Entry, bool) {
+	entry, ok := s.records.Get(id)
+	if !ok || !s.visible(entry) {
+		return store.Entry{}, false
+	}
+	return entry, true
+}
+
+func (s scopedStore) List() []store.Entry {
+	return s.filter(s.records.List())
+}
+
+func (s scopedStore) Index(index string, exemplar store.Entry) []store.Entry {
+	return s.filter(s.records.Index(index, exemplar))
+}
+
+// IndexValues is not scoped. Index values only name the routing keys and
+// protocols of traffic.
+func (s scopedStore) IndexValues(index string) []string {
+	return s.records.IndexValues(index)
+}
+
+func (s scopedStore) filter(entries []store.Entry) []store.Entry {
+	out := make([]store.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if s.visible(entry) {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
+func (s scopedStore) visible(entry store.Entry) bool {
+	switch record := entry.Record.(type) {
+	case vanflow.SiteRecord:
+		return s.siteVisible(record.ID)
+	case vanflow.RouterRecord:
+		return s.siteVisible(dref(record.Parent))
+	case vanflow.ProcessRecord:
+		return s.siteVisible(dref(record.Parent))
+	case vanflow.LinkRecord:
+		return s.siteVisible(s.graph.Link(record.ID).Parent().Parent().ID())
+	case vanflow.RouterAccessRecord:
+		return s.siteVisible(s.graph.RouterAccess(record.ID).Parent().Parent().ID())
+	case vanflow.ListenerRecord:
+		return s.siteVisible(s.graph.Listener(record.ID).Parent().Parent().ID())
+	case vanflow.ConnectorRecord:
+		return s.siteVisible(s.graph.Connector(record.ID).Parent().Parent().ID())
+	case collector.AddressRecord:
+		return s.routingKeyVisible(record.Name)
+	case collector.ServiceLevelRecord:
+		return s.routingKeyVisible(record.RoutingKey)
+	case collector.AnomalyRecord:
+		return s.routingKeyVisible(record.RoutingKey)
+	case collector.ProcessGroupRecord:
+		return s.groupVisible(record.Name)
+	case collector.ProcGroupPairRecord:
+		return s.groupVisible(record.SourceName) || s.groupVisible(record.DestName)
+	case collector.ProcPairRecord:
+		return s.siteVisible(s.graph.Process(record.Source).Parent().ID()) ||
+			s.siteVisible(s.graph.Process(record.Dest).Parent().ID())
+	case collector.SitePairRecord:
+		return s.siteVisible(record.Source) || s.siteVisible(record.Dest)
+	case collector.SiteAddressPairRecord:
+		return s.siteVisible(record.Source.ID) || s.siteVisible(record.Dest.ID)
+	case collector.PolicyViolationRecord:
+		return s.siteVisible(record.Source.ID) || s.siteVisible(record.Dest.ID)
+	case collector.FlowSourceRecord:
+		return s.siteVisible(record.Site)
+	case collector.ConnectionRecord:
+		return s.siteVisible(record.SourceSite.ID) || s.siteVisible(record.DestSite.ID)
+	case collector.RequestRecord:
+		return s.siteVisible(record.SourceSite.ID) || s.siteVisible(record.DestSite.ID)
+	default:
+		return false
+	}
+}
+
+func (s scopedStore) siteVisible(id string) bool {
+	if id == "" {
+		return false
+	}
+	entry, ok := s.records.Get(id)
+	if !ok {
+		return false
+	}
+	site, ok := entry.Record.(vanflow.SiteRecord)
+	if !ok || site.Namespace == nil {
+		return false
+	}
+	return slices.Contains(s.namespaces, *site.Namespace)
+}
+
+// routingKeyVisible returns true when any listener or connector for the
+// routing key is visible
+func (s scopedStore) routingKeyVisible(routingKey string) bool {
+	exemplar := store.Entry{Record: vanflow.ListenerRecord{Address: &routingKey}}
+	for _, entry := range s.records.Index(collector.IndexByAddress, exemplar) {
+		if s.visible(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupVisible returns true when any process in the group is visible
+func (s scopedStore) groupVisible(name string) bool {
+	for _, entry := range listByType[vanflow.ProcessRecord](s.records) {
+		if process := entry.Record.(vanflow.ProcessRecord); dref(process.Group) == name && s.visible(entry) {
+			return true
+		}
+	}
+	return false
+}
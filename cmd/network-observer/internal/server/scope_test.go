@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/auth"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestFederationScope(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	flowStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	begin := time.Now()
+	flowStor.Replace(wrapRecords(
+		vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("flow-1", begin)},
+		vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("flow-2", begin)},
+	))
+	stor.Replace(wrapRecords(
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-east"), Name: ptrTo("east"), Namespace: ptrTo("east")},
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-west"), Name: ptrTo("west"), Namespace: ptrTo("west")},
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-podman"), Name: ptrTo("podman")},
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-east"), Parent: ptrTo("site-east")},
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-west"), Parent: ptrTo("site-west")},
+		vanflow.ProcessRecord{BaseRecord: vanflow.NewBase("process-east"), Parent: ptrTo("site-east"), Name: ptrTo("database")},
+		vanflow.ProcessRecord{BaseRecord: vanflow.NewBase("process-west"), Parent: ptrTo("site-west"), Name: ptrTo("cache")},
+		vanflow.ListenerRecord{BaseRecord: vanflow.NewBase("listener-west"), Parent: ptrTo("router-west"), Address: ptrTo("database"), Protocol: ptrTo("tcp")},
+		vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("connector-east"), Parent: ptrTo("router-east"), Address: ptrTo("database"), Protocol: ptrTo("tcp")},
+		vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("connector-west"), Parent: ptrTo("router-west"), Address: ptrTo("cache"), Protocol: ptrTo("tcp")},
+		collector.AddressRecord{ID: "database-id", Name: "database", Protocol: "tcp", Start: begin},
+		collector.AddressRecord{ID: "cache-id", Name: "cache", Protocol: "tcp", Start: begin},
+		collector.ConnectionRecord{
+			ID:         "flow-1",
+			RoutingKey: "database",
+			Protocol:   "tcp",
+			SourceSite: collector.NamedReference{ID: "site-west", Name: "west"},
+			DestSite:   collector.NamedReference{ID: "site-east", Name: "east"},
+			FlowStore:  flowStor,
+		},
+		collector.ConnectionRecord{
+			ID:         "flow-2",
+			RoutingKey: "cache",
+			Protocol:   "tcp",
+			SourceSite: collector.NamedReference{ID: "site-west", Name: "west"},
+			DestSite:   collector.NamedReference{ID: "site-west", Name: "west"},
+			FlowStore:  flowStor,
+		},
+	))
+	graph.(reset).Reset()
+
+	federation := NewFederation(tlog, []Network{{Records: stor, Graph: graph}}, func(records store.Interface, graph collector.Graph) http.Handler {
+		return api.Handler(New(tlog, records, graph))
+	})
+	var access *auth.Access
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if access != nil {
+			r = r.WithContext(auth.NewContext(r.Context(), *access))
+		}
+		federation.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	c, err := api.NewClientWithResponses(srv.URL)
+	assert.Assert(t, err)
+
+	testcases := []struct {
+		Name              string
+		Access            *auth.Access
+		ExpectSites       []string
+		ExpectProcesses   []string
+		ExpectServices    []string
+		ExpectConnections []string
+		ExpectConnectors  []string
+	}{
+		{
+			Name:              "unauthenticated",
+			ExpectSites:       []string{"site-east", "site-podman", "site-west"},
+			ExpectProcesses:   []string{"process-east", "process-west"},
+			ExpectServices:    []string{"cache-id", "database-id"},
+			ExpectConnections: []string{"flow-1", "flow-2"},
+			ExpectConnectors:  []string{"connector-east", "connector-west"},
+		}, {
+			Name:              "all namespaces",
+			Access:            &auth.Access{AllNamespaces: true},
+			ExpectSites:       []string{"site-east", "site-podman", "site-west"},
+			ExpectProcesses:   []string{"process-east", "process-west"},
+			ExpectServices:    []string{"cache-id", "database-id"},
+			ExpectConnections: []string{"flow-1", "flow-2"},
+			ExpectConnectors:  []string{"connector-east", "connector-west"},
+		}, {
+			Name:              "east",
+			Access:            &auth.Access{Namespaces: []string{"east"}},
+			ExpectSites:       []string{"site-east"},
+			ExpectProcesses:   []string{"process-east"},
+			ExpectServices:    []string{"database-id"},
+			ExpectConnections: []string{"flow-1"},
+			ExpectConnectors:  []string{"connector-east"},
+		}, {
+			Name:              "west",
+			Access:            &auth.Access{Namespaces: []string{"west"}},
+			ExpectSites:       []string{"site-west"},
+			ExpectProcesses:   []string{"process-west"},
+			ExpectServices:    []string{"cache-id", "database-id"},
+			ExpectConnections: []string{"flow-1", "flow-2"},
+			ExpectConnectors:  []string{"connector-west"},
+		}, {
+			Name:   "no sites",
+			Access: &auth.Access{Namespaces: []string{"north"}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			access = tc.Access
+			ctx := context.TODO()
+
+			sites, err := c.SitesWithResponse(ctx)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, identities(sites.JSON200.Results, func(r api.SiteRecord) string { return r.Identity }), tc.ExpectSites)
+
+			processes, err := c.ProcessesWithResponse(ctx)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, identities(processes.JSON200.Results, func(r api.ProcessRecord) string { return r.Identity }), tc.ExpectProcesses)
+
+			services, err := c.ServicesWithResponse(ctx)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, identities(services.JSON200.Results, func(r api.ServiceRecord) string { return r.Identity }), tc.ExpectServices)
+
+			connections, err := c.ConnectionsWithResponse(ctx)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, identities(connections.JSON200.Results, func(r api.ConnectionRecord) string { return r.Identity }), tc.ExpectConnections)
+
+			connectors, err := c.ConnectorsWithResponse(ctx)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, identities(connectors.JSON200.Results, func(r api.ConnectorRecord) string { return r.Identity }), tc.ExpectConnectors)
+
+			for _, id := range []string{"site-east", "site-west", "site-podman"} {
+				site, err := c.SiteByIdWithResponse(ctx, id)
+				assert.Assert(t, err)
+				if slices.Contains(tc.ExpectSites, id) {
+					assert.Equal(t, site.StatusCode(), 200)
+				} else {
+					assert.Equal(t, site.StatusCode(), 404)
+				}
+			}
+		})
+	}
+}
+
+func identities[T any](records []T, identity func(T) string) []string {
+	var out []string
+	for _, record := range records {
+		out = append(out, identity(record))
+	}
+	slices.Sort(out)
+	return out
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestServiceLevelObjectivesByService(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	srv, c := requireTestClient(t, New(tlog, stor, graph))
+	defer srv.Close()
+
+	begin := time.Now()
+	records := wrapRecords(
+		collector.AddressRecord{ID: "addr-1", Name: "pizza", Protocol: "tcp", Start: begin},
+		collector.AddressRecord{ID: "addr-2", Name: "icecream", Protocol: "tcp", Start: begin},
+		collector.ServiceLevelRecord{
+			ID:                   "slo-1",
+			Name:                 "availability",
+			RoutingKey:           "pizza",
+			Protocol:             "tcp",
+			Indicator:            collector.IndicatorAvailability,
+			Objective:            99.9,
+			Window:               28 * 24 * time.Hour,
+			Start:                begin,
+			Good:                 999,
+			Total:                1000,
+			Ratio:                0.999,
+			BurnRate1h:           2,
+			BurnRate6h:           1,
+			ErrorBudgetRemaining: 0,
+			Status:               collector.ErrorBudgetExhausted,
+		},
+		collector.ServiceLevelRecord{
+			ID:                   "slo-2",
+			Name:                 "latency",
+			RoutingKey:           "pizza",
+			Protocol:             "tcp",
+			Indicator:            collector.IndicatorLatency,
+			Objective:            95,
+			Window:               time.Hour,
+			LatencyThreshold:     200 * time.Millisecond,
+			Start:                begin,
+			Ratio:                1,
+			ErrorBudgetRemaining: 1,
+			Status:               collector.ErrorBudgetOK,
+		},
+	)
+
+	testcases := []struct {
+		ID            string
+		ExpectOK      bool
+		ExpectCount   int
+		ExpectResults func(t *testing.T, results []api.ServiceLevelObjectiveRecord)
+	}{
+		{ID: "dne"},
+		{ID: "addr-2", ExpectOK: true},
+		{
+			ID:          "addr-1",
+			ExpectOK:    true,
+			ExpectCount: 2,
+			ExpectResults: func(t *testing.T, results []api.ServiceLevelObjectiveRecord) {
+				assert.DeepEqual(t, results[0], api.ServiceLevelObjectiveRecord{
+					Identity:             "slo-1",
+					StartTime:            uint64(begin.UnixMicro()),
+					Name:                 "availability",
+					RoutingKey:           "pizza",
+					Protocol:             "tcp",
+					Indicator:            api.Availability,
+					Objective:            99.9,
+					Window:               "672h0m0s",
+					GoodEvents:           999,
+					TotalEvents:          1000,
+					Ratio:                0.999,
+					BurnRate1h:           2,
+					BurnRate6h:           1,
+					ErrorBudgetRemaining: 0,
+					Status:               api.Exhausted,
+				})
+				assert.Equal(t, results[1].Indicator, api.Latency)
+				assert.DeepEqual(t, results[1].LatencyThreshold, ptrTo("200ms"))
+				assert.Equal(t, results[1].Status, api.Ok)
+			},
+		},
+	}
+
+	stor.Replace(records)
+	for _, tc := range testcases {
+		t.Run(tc.ID, func(t *testing.T) {
+			resp, err := c.ServiceLevelObjectivesByServiceWithResponse(context.TODO(), tc.ID)
+			assert.Check(t, err)
+			if !tc.ExpectOK {
+				assert.Check(t, resp.JSON404 != nil)
+				return
+			}
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.Equal(t, resp.JSON200.Count, int64(tc.ExpectCount))
+			assert.Equal(t, len(resp.JSON200.Results), tc.ExpectCount)
+			if tc.ExpectResults != nil {
+				tc.ExpectResults(t, resp.JSON200.Results)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestTcpStatsByService(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	flowStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	graph := collector.NewGraph(stor)
+	srv, c := requireTestClient(t, New(tlog, stor, graph))
+	defer srv.Close()
+
+	begin := time.Now().Truncate(time.Second)
+	connection := func(id string) collector.ConnectionRecord {
+		return collector.ConnectionRecord{ID: id, RoutingKey: "database", Protocol: "tcp", FlowStore: flowStor}
+	}
+	stor.Replace(wrapRecords(
+		collector.AddressRecord{ID: "addr-1", Name: "database", Protocol: "tcp", Start: begin},
+		collector.AddressRecord{ID: "addr-2", Name: "backend", Protocol: "tcp", Start: begin},
+		connection("flow-1"),
+		connection("flow-2"),
+		connection("flow-3"),
+		connection("flow-4"),
+	))
+	flowStor.Replace(wrapRecords(
+		vanflow.TransportBiflowRecord{
+			BaseRecord:     vanflow.NewBase("flow-1", begin),
+			Latency:        ptrTo(uint64(1000)),
+			WindowClosures: ptrTo(uint64(3)),
+			WindowSize:     ptrTo(uint64(65535)),
+			OctetsUnacked:  ptrTo(uint64(512)),
+		},
+		vanflow.TransportBiflowRecord{
+			BaseRecord:     vanflow.NewBase("flow-2", begin, begin.Add(time.Second)),
+			Latency:        ptrTo(uint64(3000)),
+			WindowClosures: ptrTo(uint64(1)),
+			OctetsUnacked:  ptrTo(uint64(128)),
+		},
+		vanflow.TransportBiflowRecord{
+			BaseRecord:     vanflow.NewBase("flow-3", begin, begin),
+			ErrorConnector: ptrTo("Connection refused"),
+		},
+		vanflow.TransportBiflowRecord{
+			BaseRecord: vanflow.NewBase("flow-4", begin),
+			Latency:    ptrTo(uint64(2000)),
+		},
+	))
+
+	testcases := []struct {
+		ID           string
+		ExpectOK     bool
+		ExpectResult api.TcpStatsRecord
+	}{
+		{ID: "dne"},
+		{
+			ID:       "addr-2",
+			ExpectOK: true,
+			ExpectResult: api.TcpStatsRecord{
+				TerminationReasons: map[string]uint64{},
+			},
+		}, {
+			ID:       "addr-1",
+			ExpectOK: true,
+			ExpectResult: api.TcpStatsRecord{
+				ConnectionCount:       4,
+				ActiveConnectionCount: 2,
+				TimeToFirstByteP50:    2000,
+				TimeToFirstByteP95:    3000,
+				TimeToFirstByteMax:    3000,
+				WindowClosures:        4,
+				OctetsUnacked:         512,
+				TerminationReasons: map[string]uint64{
+					collector.TerminationClosed:  1,
+					collector.TerminationRefused: 1,
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.ID, func(t *testing.T) {
+			resp, err := c.TcpStatsByServiceWithResponse(context.TODO(), tc.ID)
+			assert.Check(t, err)
+			if !tc.ExpectOK {
+				assert.Equal(t, resp.StatusCode(), 404)
+				return
+			}
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.DeepEqual(t, resp.JSON200.Results, tc.ExpectResult)
+		})
+	}
+
+	t.Run("connection fields", func(t *testing.T) {
+		resp, err := c.ConnectionsByServiceWithResponse(context.TODO(), "addr-1")
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		connections := make(map[string]api.ConnectionRecord)
+		for _, connection := range resp.JSON200.Results {
+			connections[connection.Identity] = connection
+		}
+		assert.DeepEqual(t, connections["flow-3"].TerminationReason, ptrTo(api.Refused))
+		assert.Check(t, connections["flow-1"].TerminationReason == nil)
+		assert.DeepEqual(t, connections["flow-1"].WindowClosures, ptrTo(uint64(3)))
+		assert.DeepEqual(t, connections["flow-1"].WindowSize, ptrTo(uint64(65535)))
+		assert.DeepEqual(t, connections["flow-1"].OctetsUnacked, ptrTo(uint64(512)))
+	})
+}
@@ -1,6 +1,8 @@
 package views
 
 import (
+	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -49,6 +51,13 @@ func NewConnectionsProvider(stor store.Interface) func(collector.ConnectionRecor
 		setOpt(&out.SourcePort, record.SourcePort)
 		setOpt(&out.ProxyHost, record.ProxyHost)
 		setOpt(&out.ProxyPort, record.ProxyPort)
+		out.WindowClosures = record.WindowClosures
+		out.WindowSize = record.WindowSize
+		out.OctetsUnacked = record.OctetsUnacked
+		if reason, ok := collector.TerminationReason(record); ok {
+			terminationReason := api.TerminationReasonType(reason)
+			out.TerminationReason = &terminationReason
+		}
 
 		if record.EndTime != nil && record.StartTime != nil && record.EndTime.After(record.StartTime.Time) {
 			if record.EndTime != nil && record.StartTime != nil {
@@ -82,6 +91,46 @@ func NewConnectionsProvider(stor store.Interface) func(collector.ConnectionRecor
 	}
 }
 
+// TCPStats aggregates the TCP statistics of connections to a service
+func TCPStats(connections []api.ConnectionRecord) api.TcpStatsRecord {
+	out := api.TcpStatsRecord{
+		ConnectionCount:    uint64(len(connections)),
+		TerminationReasons: make(map[string]uint64),
+	}
+	var timesToFirstByte []uint64
+	for _, connection := range connections {
+		// latency is zero until the first byte is observed
+		if connection.Latency > 0 {
+			timesToFirstByte = append(timesToFirstByte, connection.Latency)
+		}
+		if connection.WindowClosures != nil {
+			out.WindowClosures += *connection.WindowClosures
+		}
+		if connection.TerminationReason == nil {
+			out.ActiveConnectionCount++
+			if connection.OctetsUnacked != nil {
+				out.OctetsUnacked += *connection.OctetsUnacked
+			}
+			continue
+		}
+		out.TerminationReasons[string(*connection.TerminationReason)]++
+	}
+	slices.Sort(timesToFirstByte)
+	out.TimeToFirstByteP50 = percentile(timesToFirstByte, 0.5)
+	out.TimeToFirstByteP95 = percentile(timesToFirstByte, 0.95)
+	out.TimeToFirstByteMax = percentile(timesToFirstByte, 1)
+	return out
+}
+
+// percentile returns the nearest rank percentile p of the sorted values
+func percentile(sorted []uint64, p float64) uint64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
 func defaultConnection(id string) api.ConnectionRecord {
 	return api.ConnectionRecord{
 		Identity:     id,
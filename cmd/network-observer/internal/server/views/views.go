@@ -565,6 +565,107 @@ func NewServiceProvider(stor store.Interface, graph collector.Graph) func(collec
 	}
 }
 
+func NewServiceLevelObjectiveSliceProvider() func(entries []store.Entry) []api.ServiceLevelObjectiveRecord {
+	return func(entries []store.Entry) []api.ServiceLevelObjectiveRecord {
+		results := make([]api.ServiceLevelObjectiveRecord, 0, len(entries))
+		for _, e := range entries {
+			record, ok := e.Record.(collector.ServiceLevelRecord)
+			if !ok {
+				continue
+			}
+			results = append(results, ServiceLevelObjective(record))
+		}
+		return results
+	}
+}
+
+func ServiceLevelObjective(record collector.ServiceLevelRecord) api.ServiceLevelObjectiveRecord {
+	out := api.ServiceLevelObjectiveRecord{
+		Identity:             record.ID,
+		StartTime:            uint64(record.Start.UnixMicro()),
+		Name:                 record.Name,
+		RoutingKey:           record.RoutingKey,
+		Protocol:             record.Protocol,
+		Indicator:            api.ServiceLevelIndicatorType(record.Indicator),
+		Objective:            record.Objective,
+		Window:               record.Window.String(),
+		GoodEvents:           record.Good,
+		TotalEvents:          record.Total,
+		Ratio:                record.Ratio,
+		ErrorBudgetRemaining: record.ErrorBudgetRemaining,
+		BurnRate1h:           record.BurnRate1h,
+		BurnRate6h:           record.BurnRate6h,
+		Status:               api.ErrorBudgetStatusType(record.Status),
+	}
+	if record.LatencyThreshold > 0 {
+		threshold := record.LatencyThreshold.String()
+		out.LatencyThreshold = &threshold
+	}
+	return out
+}
+
+func NewAnomalySliceProvider() func(entries []store.Entry) []api.AnomalyRecord {
+	return func(entries []store.Entry) []api.AnomalyRecord {
+		results := make([]api.AnomalyRecord, 0, len(entries))
+		for _, e := range entries {
+			record, ok := e.Record.(collector.AnomalyRecord)
+			if !ok {
+				continue
+			}
+			results = append(results, Anomaly(record))
+		}
+		return results
+	}
+}
+
+func Anomaly(record collector.AnomalyRecord) api.AnomalyRecord {
+	out := api.AnomalyRecord{
+		Identity:    record.ID,
+		StartTime:   uint64(record.Start.UnixMicro()),
+		RoutingKey:  record.RoutingKey,
+		Protocol:    record.Protocol,
+		Kind:        api.AnomalyKindType(record.Kind),
+		Observed:    record.Observed,
+		Expected:    record.Expected,
+		StdDev:      record.StdDev,
+		Score:       record.Score,
+		Description: record.Description,
+	}
+	if !record.End.IsZero() {
+		out.EndTime = uint64(record.End.UnixMicro())
+	}
+	if record.SourceSite.ID != "" {
+		out.SourceSiteId = &record.SourceSite.ID
+		out.SourceSiteName = &record.SourceSite.Name
+	}
+	return out
+}
+
+func PolicyViolations(entries []store.Entry) []api.PolicyViolationRecord {
+	results := make([]api.PolicyViolationRecord, 0, len(entries))
+	for _, e := range entries {
+		record, ok := e.Record.(collector.PolicyViolationRecord)
+		if !ok {
+			continue
+		}
+		results = append(results, PolicyViolation(record))
+	}
+	return results
+}
+
+func PolicyViolation(record collector.PolicyViolationRecord) api.PolicyViolationRecord {
+	return api.PolicyViolationRecord{
+		Identity:       record.ID,
+		StartTime:      uint64(record.Start.UnixMicro()),
+		SourceSiteId:   record.Source.ID,
+		SourceSiteName: record.Source.Name,
+		DestSiteId:     record.Dest.ID,
+		DestSiteName:   record.Dest.Name,
+		RoutingKey:     record.RoutingKey,
+		Protocol:       record.Protocol,
+	}
+}
+
 func Routers(entries []store.Entry) []api.RouterRecord {
 	results := make([]api.RouterRecord, 0, len(entries))
 	for _, e := range entries {
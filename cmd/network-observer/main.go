@@ -17,14 +17,18 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/auth"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/cmd"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/flowlog"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/server"
 	"github.com/skupperproject/skupper/internal/version"
 	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
 	"github.com/skupperproject/skupper/pkg/vanflow/session"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
 )
 
 func run(cfg Config) error {
@@ -42,11 +46,6 @@ func run(cfg Config) error {
 		return fmt.Errorf("could not load spec filesystem: %s", err)
 	}
 
-	sessionConfig, err := configureSession(cfg.RouterTLS)
-	if err != nil {
-		return fmt.Errorf("failed to load router tls configuration: %s", err)
-	}
-
 	flowLogger := func(vanflow.RecordMessage) {}
 	vanflowSLog := logger.With(slog.String("component", "vanflow"))
 	switch cfg.VanflowLoggingProfile {
@@ -61,31 +60,131 @@ func run(cfg Config) error {
 		return fmt.Errorf("unknown logging profile: %s", cfg.VanflowLoggingProfile)
 	}
 
-	collector := collector.New(
-		logger.With(slog.String("component", "collector")),
-		session.NewContainerFactory(cfg.RouterURL, sessionConfig),
-		reg,
-		cfg.FlowRecordTTL,
-		flowLogger,
+	networkSpecs, err := loadNetworks(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to load networks: %s", err)
+	}
+	if cfg.VanflowReplay != "" && len(networkSpecs) > 1 {
+		return fmt.Errorf("vanflow-replay cannot be used with more than one network")
+	}
+
+	objectives, err := loadServiceLevelObjectives(cfg.SLOConfig)
+	if err != nil {
+		return fmt.Errorf("failed to load service level objectives: %s", err)
+	}
+	policy, err := loadPolicy(cfg.PolicyConfig)
+	if err != nil {
+		return fmt.Errorf("failed to load network policy: %s", err)
+	}
+
+	var (
+		replayRouter *session.MockRouter
+		collectors   networksLinkHealth
+		networks     []server.Network
 	)
+	for _, network := range networkSpecs {
+		sessionConfig, err := configureSession(network.RouterTLS)
+		if err != nil {
+			return fmt.Errorf("failed to load router tls configuration: %s", err)
+		}
+		factory := session.NewContainerFactory(network.RouterURL, sessionConfig)
+		if cfg.VanflowReplay != "" {
+			// the collector is fed from the capture rather than a router
+			replayRouter = session.NewMockRouter()
+			factory = session.NewMockRouterContainerFactory(replayRouter)
+		}
+
+		networkLogger := logger
+		var networkReg prometheus.Registerer = reg
+		if network.Name != "" {
+			networkLogger = logger.With(slog.String("network", network.Name))
+			networkReg = prometheus.WrapRegistererWith(prometheus.Labels{"network": network.Name}, reg)
+		}
+		c := collector.New(
+			networkLogger.With(slog.String("component", "collector")),
+			factory,
+			networkReg,
+			cfg.FlowRecordTTL,
+			flowLogger,
+			collector.Options{
+				Network:    network.Name,
+				Objectives: objectives,
+				Anomalies: collector.AnomalyDetection{
+					Threshold:      cfg.AnomalyThreshold,
+					LearningPeriod: cfg.AnomalyLearningPeriod,
+				},
+				Policy: policy,
+			},
+		)
+		collectors = append(collectors, networkCollector{Name: network.Name, Collector: c})
+		networks = append(networks, server.Network{
+			Name:    network.Name,
+			Records: c.Records,
+			Graph:   c.GetGraph(),
+		})
+	}
+
+	apiLogger := logger.With(slog.String("component", "api"))
+	collectorAPI := server.NewFederation(
+		apiLogger,
+		networks,
+		func(records store.Interface, graph collector.Graph) http.Handler {
+			return api.HandlerWithOptions(server.New(apiLogger, records, graph), api.GorillaServerOptions{
+				BaseRouter: mux.NewRouter(),
+			})
+		},
+	)
+	queryAPI := server.NewFederation(apiLogger, networks, func(records store.Interface, graph collector.Graph) http.Handler {
+		return server.NewGraphQLHandler(apiLogger, records, graph)
+	})
+	// matches the requests for spec'd api routes
+	specRoutes := mux.NewRouter()
+	api.HandlerWithOptions(nil, api.GorillaServerOptions{BaseRouter: specRoutes})
+	isSpecRoute := func(r *http.Request, _ *mux.RouteMatch) bool {
+		return specRoutes.Match(r, &mux.RouteMatch{})
+	}
+
+	authn, err := loadAuth(ctx, logger.With(slog.String("component", "auth")), cfg.AuthConfig)
+	if err != nil {
+		return fmt.Errorf("failed to load auth config: %s", err)
+	}
+	// secure requires requests to the handler to be authenticated when auth
+	// is configured
+	secure := func(handler http.Handler) http.Handler { return handler }
+	if authn != nil {
+		secure = authn.Handler
+	}
 
-	collectorAPI := server.New(
-		logger.With(slog.String("component", "api")),
-		collector.Records,
-		collector.GetGraph(),
+	alertRules := alerts.Rules{
+		LinkDownFor:      cfg.AlertLinkDownFor,
+		LinkFlapsPerHour: cfg.AlertLinkFlapsPerHour,
+	}
+	if err := alertRules.Validate(); err != nil {
+		return fmt.Errorf("invalid alert rules: %s", err)
+	}
+	alertEvaluator := alerts.New(
+		logger.With(slog.String("component", "alerts")),
+		collectors,
+		alerts.Config{
+			Rules:      alertRules,
+			WebhookURL: cfg.AlertWebhookURL,
+		},
 	)
 
 	var mux = mux.NewRouter().StrictSlash(true)
 	promSubrouter := mux.PathPrefix("/api/v2alpha1/internal/prom")
-	mux.Handle("/metrics", handleMetrics(reg))
+	// metrics are not scoped to namespaces
+	mux.Handle("/metrics", secure(auth.RequireAllNamespaces(handleMetrics(reg))))
 	mux.PathPrefix("/swagger").Handler(handleSwagger("/swagger", specFS))
 	apiMux := mux.PathPrefix("/").Subrouter()
 	if cfg.CORSAllowAll {
 		apiMux.Use(handlers.CORS())
 	}
-	api.HandlerWithOptions(collectorAPI, api.GorillaServerOptions{
-		BaseRouter: apiMux,
-	})
+	apiMux.MatcherFunc(isSpecRoute).Handler(secure(collectorAPI))
+	apiMux.Path("/api/v2alpha1/graphql").Handler(secure(queryAPI))
+	// alerts are not scoped to namespaces
+	apiMux.Path("/api/v2alpha1/internal/alerts").Handler(secure(auth.RequireAllNamespaces(handleGetAlerts(alertEvaluator))))
+	apiMux.Path("/api/v2alpha1/internal/alerts/rules").Handler(secure(auth.RequireAllNamespaces(handleAlertRules(alertEvaluator))))
 
 	if cfg.EnableConsole {
 		promAPI, err := parsePrometheusAPI(cfg.PrometheusAPI)
@@ -93,9 +192,10 @@ func run(cfg Config) error {
 			return fmt.Errorf("error parsing prometheus-api as URL: %s", err)
 		}
 		// add unspec'd api routes
-		apiMux.Path("/api/v2alpha1/user").Handler(handleGetUser())
+		apiMux.Path("/api/v2alpha1/user").Handler(secure(handleGetUser()))
 		apiMux.Path("/api/v2alpha1/logout").Handler(handleUserLogout())
-		promSubrouter.Handler(handleProxyPrometheusAPI("/api/v2alpha1/internal/prom", promAPI))
+		// prometheus queries are not scoped to namespaces
+		promSubrouter.Handler(secure(auth.RequireAllNamespaces(handleProxyPrometheusAPI("/api/v2alpha1/internal/prom", promAPI))))
 
 		apiMux.PathPrefix("/").Handler(handleSecuredConsoleAssets(cfg.ConsoleLocation))
 	}
@@ -125,6 +225,7 @@ func run(cfg Config) error {
 		logger.Info("Starting Network Console API Server",
 			slog.String("address", cfg.APIListenAddress),
 			slog.Bool("tls", tlsEnabled),
+			slog.Bool("auth", authn != nil),
 			slog.Bool("console", cfg.EnableConsole))
 		var err error
 		if tlsEnabled {
@@ -178,14 +279,38 @@ func run(cfg Config) error {
 		})
 	}
 
+	if replayRouter != nil {
+		g.Go(func() error {
+			logger.Info("Replaying vanflow capture",
+				slog.String("file", cfg.VanflowReplay),
+				slog.Float64("speed", cfg.VanflowReplaySpeed))
+			stats, err := capture.ReplayFile(runCtx, session.NewMockContainer(replayRouter), cfg.VanflowReplay, capture.ReplayOptions{
+				Speed: cfg.VanflowReplaySpeed,
+			})
+			if err != nil && !errors.Is(err, runCtx.Err()) {
+				return fmt.Errorf("vanflow replay error: %w", err)
+			}
+			logger.Info("Vanflow capture replay complete",
+				slog.Int("sent", stats.Sent),
+				slog.Int("skipped", stats.Skipped))
+			return nil
+		})
+	}
+
 	g.Go(func() error {
-		logger.Debug("Starting Network Observer Collector")
-		if err := collector.Run(runCtx); err != nil {
-			return fmt.Errorf("collector error: %w", err)
-		}
-		return nil
+		return alertEvaluator.Run(runCtx)
 	})
 
+	for _, network := range collectors {
+		g.Go(func() error {
+			logger.Debug("Starting Network Observer Collector", slog.String("network", network.Name))
+			if err := network.Collector.Run(runCtx); err != nil {
+				return fmt.Errorf("collector error: %w", err)
+			}
+			return nil
+		})
+	}
+
 	if err := g.Wait(); err != nil && !errors.Is(err, ctx.Err()) {
 		return err
 	}
@@ -213,11 +338,29 @@ func main() {
 	flags.StringVar(&cfg.ConsoleLocation, "console-location", "/app/console", "Location where the console assets are installed")
 	flags.StringVar(&cfg.PrometheusAPI, "prometheus-api", "http://127.0.0.1:9090", "Prometheus API HTTP endpoint for console")
 
+	flags.StringVar(&cfg.NetworkName, "network-name", "", "Name of the network collected from the router endpoint. When set, metrics are labeled with the network name")
+	flags.StringVar(&cfg.NetworksConfig, "networks-config", "", "Path to a file declaring the networks to collect from, in place of the router endpoint")
+
 	flags.DurationVar(&cfg.FlowRecordTTL, "flow-record-ttl", 15*time.Minute, "How long to retain flow records in memory")
 	flags.BoolVar(&cfg.CORSAllowAll, "cors-allow-all", false, "Development option to allow all origins")
 	flags.BoolVar(&cfg.EnableProfile, "profile", false, "Exposes the runtime profiling facilities from net/http/pprof on http://localhost:9970")
 
 	flags.StringVar(&cfg.VanflowLoggingProfile, "vanflow-logging-profile", "silent", "Controls low level vanflow record logging. Options are silent, minimal, moderate and all")
+	flags.StringVar(&cfg.VanflowReplay, "vanflow-replay", "", "Development option to collect records from a capture file produced by skupper vanflow capture instead of the router")
+	flags.Float64Var(&cfg.VanflowReplaySpeed, "vanflow-replay-speed", 1, "Speed factor at which vanflow-replay sends captured messages. 0 sends them without delay")
+
+	flags.StringVar(&cfg.SLOConfig, "slo-config", "", "Path to a file declaring service level objectives")
+
+	flags.Float64Var(&cfg.AnomalyThreshold, "anomaly-threshold", 4, "Number of standard deviations from the baseline traffic to an address that is flagged as an anomaly. 0 disables anomaly detection")
+	flags.DurationVar(&cfg.AnomalyLearningPeriod, "anomaly-learning-period", 24*time.Hour, "How long the sites traffic to an address comes from are learned before traffic from new sites is flagged as an anomaly")
+
+	flags.StringVar(&cfg.PolicyConfig, "policy-config", "", "Path to a file declaring the communication allowed between sites. Connections not allowed are reported as policy violations")
+
+	flags.StringVar(&cfg.AuthConfig, "auth-config", "", "Path to a file declaring how API requests are authenticated and the namespaces users can see. When unset the API is not authenticated")
+
+	flags.StringVar(&cfg.AlertWebhookURL, "alert-webhook-url", "", "URL alerts are posted to as they start and stop firing")
+	flags.DurationVar(&cfg.AlertLinkDownFor, "alert-link-down-for", time.Minute, "How long a link is down before alerting. 0 disables the alert")
+	flags.IntVar(&cfg.AlertLinkFlapsPerHour, "alert-link-flaps-per-hour", 5, "How many times a link can go down in an hour before alerting. 0 disables the alert")
 
 	flags.Parse(os.Args[1:])
 	if *isVersion {
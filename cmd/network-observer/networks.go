@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector/metrics"
+	"sigs.k8s.io/yaml"
+)
+
+// NetworkSpec is a skupper network the observer collects records from
+type NetworkSpec struct {
+	// Name labels the records and metrics of the network. Empty only when
+	// the observer collects from a single network.
+	Name      string
+	RouterURL string
+	RouterTLS TLSSpec
+}
+
+// networksConfig is the format of the file the networks to collect from are
+// declared in
+type networksConfig struct {
+	Networks []struct {
+		Name           string `json:"name"`
+		RouterEndpoint string `json:"routerEndpoint"`
+		TLS            struct {
+			CA       string `json:"ca,omitempty"`
+			Cert     string `json:"cert,omitempty"`
+			Key      string `json:"key,omitempty"`
+			Insecure bool   `json:"insecure,omitempty"`
+		} `json:"tls"`
+	} `json:"networks"`
+}
+
+// loadNetworks returns the networks declared in the NetworksConfig file, or
+// the network of the router endpoint otherwise
+func loadNetworks(cfg Config) ([]NetworkSpec, error) {
+	if cfg.NetworksConfig == "" {
+		return []NetworkSpec{{
+			Name:      cfg.NetworkName,
+			RouterURL: cfg.RouterURL,
+			RouterTLS: cfg.RouterTLS,
+		}}, nil
+	}
+	data, err := os.ReadFile(cfg.NetworksConfig)
+	if err != nil {
+		return nil, err
+	}
+	var config networksConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	if len(config.Networks) == 0 {
+		return nil, fmt.Errorf("no networks declared")
+	}
+	networks := make([]NetworkSpec, 0, len(config.Networks))
+	names := make(map[string]struct{}, len(config.Networks))
+	for i, in := range config.Networks {
+		if in.Name == "" {
+			return nil, fmt.Errorf("network %d: name is required", i)
+		}
+		if _, ok := names[in.Name]; ok {
+			return nil, fmt.Errorf("network %d: name %q already used", i, in.Name)
+		}
+		names[in.Name] = struct{}{}
+		if in.RouterEndpoint == "" {
+			return nil, fmt.Errorf("network %d: routerEndpoint is required", i)
+		}
+		networks = append(networks, NetworkSpec{
+			Name:      in.Name,
+			RouterURL: in.RouterEndpoint,
+			RouterTLS: TLSSpec{
+				CA:         in.TLS.CA,
+				Cert:       in.TLS.Cert,
+				Key:        in.TLS.Key,
+				SkipVerify: in.TLS.Insecure,
+			},
+		})
+	}
+	return networks, nil
+}
+
+// networkCollector is the collector of records from a network
+type networkCollector struct {
+	Name      string
+	Collector *collector.Collector
+}
+
+// networksLinkHealth reports the health of the links in every network
+type networksLinkHealth []networkCollector
+
+func (n networksLinkHealth) LinkHealth() []metrics.LinkHealth {
+	var out []metrics.LinkHealth
+	for _, network := range n {
+		for _, link := range network.Collector.LinkHealth() {
+			link.Network = network.Name
+			out = append(out, link)
+		}
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadNetworks(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   string
+		Expected []NetworkSpec
+		Err      string
+	}{
+		{
+			Name: "valid",
+			Config: `
+networks:
+- name: east
+  routerEndpoint: amqps://router.east
+  tls:
+    ca: /etc/east/ca.crt
+    cert: /etc/east/tls.crt
+    key: /etc/east/tls.key
+- name: west
+  routerEndpoint: amqp://router.west
+`,
+			Expected: []NetworkSpec{
+				{
+					Name:      "east",
+					RouterURL: "amqps://router.east",
+					RouterTLS: TLSSpec{CA: "/etc/east/ca.crt", Cert: "/etc/east/tls.crt", Key: "/etc/east/tls.key"},
+				},
+				{Name: "west", RouterURL: "amqp://router.west"},
+			},
+		}, {
+			Name:   "empty",
+			Config: `networks: []`,
+			Err:    "no networks declared",
+		}, {
+			Name:   "missing name",
+			Config: `networks: [{routerEndpoint: amqp://router.east}]`,
+			Err:    "network 0: name is required",
+		}, {
+			Name: "repeated name",
+			Config: `
+networks:
+- {name: east, routerEndpoint: amqp://router.east}
+- {name: east, routerEndpoint: amqp://router.west}
+`,
+			Err: `network 1: name "east" already used`,
+		}, {
+			Name:   "missing endpoint",
+			Config: `networks: [{name: east}]`,
+			Err:    "network 0: routerEndpoint is required",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "networks.yaml")
+			assert.Assert(t, os.WriteFile(path, []byte(tc.Config), 0o644))
+			networks, err := loadNetworks(Config{NetworksConfig: path})
+			if tc.Err != "" {
+				assert.Error(t, err, tc.Err)
+				return
+			}
+			assert.Check(t, err)
+			assert.DeepEqual(t, networks, tc.Expected)
+		})
+	}
+
+	t.Run("router endpoint", func(t *testing.T) {
+		networks, err := loadNetworks(Config{
+			NetworkName: "east",
+			RouterURL:   "amqps://skupper-router-local",
+			RouterTLS:   TLSSpec{CA: "/etc/ca.crt"},
+		})
+		assert.Check(t, err)
+		assert.DeepEqual(t, networks, []NetworkSpec{{
+			Name:      "east",
+			RouterURL: "amqps://skupper-router-local",
+			RouterTLS: TLSSpec{CA: "/etc/ca.crt"},
+		}})
+	})
+}
@@ -16,7 +16,7 @@ import (
 )
 
 func main() {
-	parseFlags()
+	grantConfig, apiConfig := parseFlags()
 	log.Printf("Version: %s", version.Version)
 	namespacesPath := api.GetDefaultOutputNamespacesPath()
 	log.Printf("Skupper System Controller watching %s", namespacesPath)
@@ -27,7 +27,7 @@ func main() {
 		log.Fatalf("Error creating skupper namespaces directory %q: %v", namespacesPath, err)
 	}
 
-	c, err := controller.NewController()
+	c, err := controller.NewController(grantConfig, apiConfig)
 	if err != nil {
 		log.Fatalf("Error creating controller: %v", err)
 	}
@@ -67,12 +67,21 @@ func handleShutdown(stop chan struct{}, wait *sync.WaitGroup) {
 	}
 }
 
-func parseFlags() {
+func parseFlags() (*controller.GrantConfig, *controller.ApiConfig) {
 	flags := flag.NewFlagSet("", flag.ExitOnError)
 	isVersion := flags.Bool("version", false, "Report the version of the Skupper System Controller")
+	grantConfig, err := controller.BoundGrantConfig(flags)
+	if err != nil {
+		log.Fatal(err)
+	}
+	apiConfig, err := controller.BoundApiConfig(flags)
+	if err != nil {
+		log.Fatal(err)
+	}
 	flags.Parse(os.Args[1:])
 	if *isVersion {
 		fmt.Println(version.Version)
 		os.Exit(0)
 	}
+	return grantConfig, apiConfig
 }
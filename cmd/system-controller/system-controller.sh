@@ -82,10 +82,19 @@ main() {
         ENV_VARS="${ENV_VARS} -e 'CONTAINER_ENDPOINT=${CONTAINER_ENDPOINT}'"
     fi
     ENV_VARS="${ENV_VARS} -e 'SKUPPER_OUTPUT_PATH=${SKUPPER_OUTPUT_PATH}'"
+    for var in SKUPPER_ENABLE_GRANTS SKUPPER_GRANT_SERVER_BASE_URL SKUPPER_GRANT_SERVER_PORT SKUPPER_ENABLE_API; do
+        value=$(eval echo "\${${var}:-}")
+        if [ -n "${value}" ]; then
+            ENV_VARS="${ENV_VARS} -e '${var}=${value}'"
+        fi
+    done
 
     # Running the system-controller
+    # The container is kept around, so that the container engine can
+    # restart the system-controller should it fail
     ${CONTAINER_ENGINE} pull "${IMAGE}"
-    eval "${CONTAINER_ENGINE}" run --rm --name "${USER}-skupper-controller" \
+    ${CONTAINER_ENGINE} rm -f "${USER}-skupper-controller" > /dev/null 2>&1 || true
+    eval "${CONTAINER_ENGINE}" run --restart on-failure --name "${USER}-skupper-controller" \
         --network host --security-opt label=disable -u \""${RUNAS}"\" --userns=\""${USERNS}"\" \
         "${MOUNTS}" \
         "${ENV_VARS}" \
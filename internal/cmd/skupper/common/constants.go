@@ -17,6 +17,7 @@ const (
 	RouterAccesses  string = "RouterAccess"
 	Links           string = "Link"
 	AccessTokens    string = "AccessToken"
+	AccessGrants    string = "AccessGrant"
 	Secrets         string = "Secret"
 	ConfigMaps      string = "ConfigMap"
 	Certificates    string = "Certificate"
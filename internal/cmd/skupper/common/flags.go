@@ -52,6 +52,7 @@ for other Kubernetes flavors, loadbalancer is the default.`
 	FlagDescIncludeNotRead      = "If true, include server pods that are not in the ready state."
 	FlagNameSelector            = "selector"
 	FlagDescSelector            = "A Kubernetes label selector for specifying target server pods."
+	FlagDescSelectorContainers  = "A label selector for specifying target server containers, instead of a host (podman and docker only)."
 	FlagNameWorkload            = "workload"
 	FlagDescWorkload            = "A Kubernetes resource name that identifies a workload expressed like resource-type/resource-name. Expected resource types: service, daemonset, deployment, and statefulset."
 
@@ -82,6 +83,17 @@ for other Kubernetes flavors, loadbalancer is the default.`
 	FlagNameType  = "type"
 	FlagDescType  = "The bundle type to be produced. Choices: tarball, shell-script"
 
+	FlagNameServiceFormat       = "service-format"
+	FlagDescServiceFormat       = "The format of the services through which sites are run. Choices: systemd, quadlet, kube"
+	FlagDescBundleServiceFormat = "The default format of the service through which the site is run once the bundle is installed. Choices: systemd, quadlet, kube"
+
+	FlagNameBundleVersion = "bundle-version"
+	FlagDescBundleVersion = "The version recorded in the bundle manifest, used to identify upgrades of installed sites. Defaults to the skupper version"
+	FlagNameSigningKey    = "signing-key"
+	FlagDescSigningKey    = "A PEM encoded RSA or ECDSA private key used to sign the bundle and its manifest. The bundle signature is written to <bundle>.sig, to be verified before the bundle is run"
+	FlagNameTemplate      = "template"
+	FlagDescTemplate      = "Generate a template bundle, whose site name, router access bind host and ports and link cost can be provided when it is installed"
+
 	FlagDescUninstallForce = "option to override even with sites present"
 
 	FlagNameHA = "enable-ha"
@@ -89,6 +101,23 @@ for other Kubernetes flavors, loadbalancer is the default.`
 
 	FlagNameFileName = "filename"
 	FlagDescFileName = "The name of the file with custom resources"
+
+	FlagNameRouterEndpoint  = "router-endpoint"
+	FlagDescRouterEndpoint  = "URL of the amqps endpoint of the router to exchange vanflow messages with. Defaults to the local endpoint of the site router"
+	FlagNameRouterTlsCa     = "router-tls-ca"
+	FlagDescRouterTlsCa     = "Path to the CA certificate file for the router endpoint. Defaults to the one of the site"
+	FlagNameRouterTlsCert   = "router-tls-cert"
+	FlagDescRouterTlsCert   = "Path to the client certificate for the router endpoint. Defaults to the one of the site"
+	FlagNameRouterTlsKey    = "router-tls-key"
+	FlagDescRouterTlsKey    = "Path to the client key for the router endpoint. Defaults to the one of the site"
+	FlagNameDuration        = "duration"
+	FlagDescCaptureDuration = "Stop capturing after the given period of time. Captures until interrupted when not set"
+	FlagNameSource          = "source"
+	FlagDescCaptureSource   = "Only capture the event sources with the given identity or type (ROUTER or CONTROLLER). Can be repeated"
+	FlagNameFlush           = "flush"
+	FlagDescCaptureFlush    = "Ask event sources for all of their records once discovered, so that the capture starts with their full state"
+	FlagNameSpeed           = "speed"
+	FlagDescReplaySpeed     = "Factor by which the delays between captured messages are divided. 0 sends messages without delay"
 )
 
 type CommandSiteCreateFlags struct {
@@ -249,13 +278,40 @@ type CommandVersionFlags struct {
 type CommandDebugFlags struct {
 }
 
+type CommandSystemInstallFlags struct {
+	ServiceFormat string
+}
+
 type CommandSystemUninstallFlags struct {
 	Force bool
 }
 
 type CommandSystemGenerateBundleFlags struct {
-	Input string
-	Type  string
+	Input         string
+	Type          string
+	ServiceFormat string
+	BundleVersion string
+	SigningKey    string
+	Template      bool
+}
+
+type CommandVanflowRouterFlags struct {
+	RouterEndpoint string
+	RouterTlsCa    string
+	RouterTlsCert  string
+	RouterTlsKey   string
+}
+
+type CommandVanflowCaptureFlags struct {
+	CommandVanflowRouterFlags
+	Duration time.Duration
+	Sources  []string
+	Flush    bool
+}
+
+type CommandVanflowReplayFlags struct {
+	CommandVanflowRouterFlags
+	Speed float64
 }
 
 type CommandSystemApplyFlags struct {
@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"fmt"
+	"io"
+
 	"github.com/skupperproject/skupper/internal/utils/validator"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func SiteConfigured(siteList *v2alpha1.SiteList) bool {
@@ -33,3 +37,16 @@ func SiteLinkAccessEnabled(siteList *v2alpha1.SiteList, linkAccessTypes []string
 	}
 	return false, ""
 }
+
+// PrintConditions writes the conditions of a resource as a table, to be
+// aligned by the tabwriter w.
+func PrintConditions(w io.Writer, conditions []metav1.Condition) {
+	if len(conditions) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "Conditions:")
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tMESSAGE")
+	for _, condition := range conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+	}
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+)
+
+// ValidateVanflowInput validates the capture file name given as argument
+// along with the client certificate used to reach the router.
+func ValidateVanflowInput(args []string, routerTlsCert string, routerTlsKey string) (string, []error) {
+	var fileName string
+	var validationErrors []error
+	fileStringValidator := validator.NewFilePathStringValidator()
+	if len(args) == 0 || args[0] == "" {
+		validationErrors = append(validationErrors, fmt.Errorf("capture file name must be specified"))
+	} else if len(args) > 1 {
+		validationErrors = append(validationErrors, fmt.Errorf("only one argument is allowed for this command"))
+	} else if ok, err := fileStringValidator.Evaluate(args[0]); !ok {
+		validationErrors = append(validationErrors, fmt.Errorf("capture file name is not valid: %s", err))
+	} else {
+		fileName = args[0]
+	}
+	if (routerTlsCert == "") != (routerTlsKey == "") {
+		validationErrors = append(validationErrors, fmt.Errorf("router-tls-cert and router-tls-key must be specified together"))
+	}
+	return fileName, validationErrors
+}
+
+// VanflowRouter is the router endpoint vanflow messages are exchanged
+// through, along with the TLS configuration used to connect to it.
+type VanflowRouter struct {
+	Endpoint  string
+	TLSConfig *tls.Config
+}
+
+// LoadVanflowTLSConfig returns the TLS configuration for the given CA and
+// client certificate files.
+func LoadVanflowTLSConfig(ca, cert, key string) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if ca != "" {
+		data, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("failed to add CA %s to certificate pool", ca)
+		}
+		config.RootCAs = certPool
+	}
+	if cert != "" {
+		tlsCert, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{tlsCert}
+	}
+	return config, nil
+}
+
+func (r VanflowRouter) container() session.Container {
+	cfg := session.ContainerConfig{
+		ContainerID: fmt.Sprintf("skupper-vanflow-%d", os.Getpid()),
+		TLSConfig:   r.TLSConfig,
+	}
+	if r.TLSConfig != nil && len(r.TLSConfig.Certificates) > 0 {
+		cfg.SASLType = session.SASLTypeExternal
+	}
+	return session.NewContainer(r.Endpoint, cfg)
+}
+
+// VanflowCapture writes the vanflow messages received through the router
+// to fileName, until interrupted or once duration has elapsed, if set.
+func VanflowCapture(router VanflowRouter, fileName string, duration time.Duration, opts capture.RecorderOptions) error {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to create capture file: %w", err)
+	}
+	defer file.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	if duration > 0 {
+		ctx, cancel = context.WithTimeout(ctx, duration)
+		defer cancel()
+	}
+	container := router.container()
+	container.Start(ctx)
+	recorder := capture.NewRecorder(container, capture.NewWriter(file), opts)
+	fmt.Printf("Capturing vanflow messages from %s to %s\n", router.Endpoint, fileName)
+	start := time.Now()
+	if err := recorder.Run(ctx); err != nil {
+		return err
+	}
+	fmt.Printf("Capture complete after %s\n", time.Since(start).Round(time.Second))
+	return nil
+}
+
+// VanflowReplay sends the vanflow messages of the capture in fileName
+// through the router.
+func VanflowReplay(router VanflowRouter, fileName string, opts capture.ReplayOptions) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	container := router.container()
+	container.Start(ctx)
+	fmt.Printf("Replaying vanflow messages from %s to %s\n", fileName, router.Endpoint)
+	stats, err := capture.ReplayFile(ctx, container, fileName, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Replay complete: %d messages sent, %d skipped\n", stats.Sent, stats.Skipped)
+	return nil
+}
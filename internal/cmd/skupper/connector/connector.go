@@ -57,6 +57,7 @@ skupper connector create backend 8080 --workload deployment/backend`,
 		cmd.Flags().StringVar(&cmdFlags.Wait, common.FlagNameWait, "configured", common.FlagDescWait)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "", common.FlagDescHost)
 	} else {
+		cmd.Flags().StringVar(&cmdFlags.Selector, common.FlagNameSelector, "", common.FlagDescSelectorContainers)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "localhost", common.FlagDescHost)
 	}
 
@@ -22,6 +22,7 @@ type CmdConnectorCreate struct {
 	connectorName    string
 	port             int
 	host             string
+	selector         string
 	routingKey       string
 	connectorType    string
 	tlsCredentials   string
@@ -54,6 +55,7 @@ func (cmd *CmdConnectorCreate) ValidateInput(args []string) error {
 	numberValidator := validator.NewNumberValidator()
 	connectorTypeValidator := validator.NewOptionValidator(common.ConnectorTypes)
 	hostStringValidator := validator.NewHostStringValidator()
+	selectorStringValidator := validator.NewSelectorStringValidator()
 
 	// Validate arguments name and port
 	if len(args) < 2 {
@@ -101,6 +103,15 @@ func (cmd *CmdConnectorCreate) ValidateInput(args []string) error {
 			validationErrors = append(validationErrors, fmt.Errorf("host is not valid: a valid IP address or hostname is expected"))
 		}
 	}
+	if cmd.Flags.Selector != "" {
+		if cmd.CobraCmd != nil && cmd.CobraCmd.Flags().Changed(common.FlagNameHost) {
+			validationErrors = append(validationErrors, fmt.Errorf("If selector is configured, cannot configure host"))
+		}
+		ok, err := selectorStringValidator.Evaluate(cmd.Flags.Selector)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("selector is not valid: %s", err))
+		}
+	}
 	if cmd.Flags.TlsCredentials != "" {
 		ok, err := resourceStringValidator.Evaluate(cmd.Flags.TlsCredentials)
 		if !ok {
@@ -123,7 +134,12 @@ func (cmd *CmdConnectorCreate) InputToOptions() {
 		cmd.namespace = "default"
 	}
 
-	cmd.host = cmd.Flags.Host
+	// containers selected by label replace the (default) host
+	if cmd.Flags.Selector != "" {
+		cmd.selector = cmd.Flags.Selector
+	} else {
+		cmd.host = cmd.Flags.Host
+	}
 	cmd.connectorType = cmd.Flags.ConnectorType
 	cmd.tlsCredentials = cmd.Flags.TlsCredentials
 }
@@ -140,6 +156,7 @@ func (cmd *CmdConnectorCreate) Run() error {
 		},
 		Spec: v2alpha1.ConnectorSpec{
 			Host:           cmd.host,
+			Selector:       cmd.selector,
 			Port:           cmd.port,
 			RoutingKey:     cmd.routingKey,
 			TlsCredentials: cmd.tlsCredentials,
@@ -90,6 +90,17 @@ func TestNonKubeCmdConnectorCreate_ValidateInput(t *testing.T) {
 			flags:         &common.CommandConnectorCreateFlags{Host: "not-valid$"},
 			expectedError: "host is not valid: a valid IP address or hostname is expected",
 		},
+		{
+			name:          "selector is not valid",
+			args:          []string{"my-connector-selector", "8080"},
+			flags:         &common.CommandConnectorCreateFlags{Selector: "app=$backend"},
+			expectedError: "selector is not valid: value does not match this regular expression: ^[A-Za-z0-9=:./-]+$",
+		},
+		{
+			name:  "selector is configured",
+			args:  []string{"my-connector-selector", "8080"},
+			flags: &common.CommandConnectorCreateFlags{Selector: "app=backend", Host: "localhost"},
+		},
 		{
 			name:  "host is not configured default",
 			args:  []string{"my-connector-host", "8080"},
@@ -149,6 +160,7 @@ func TestNonKubeCmdConnectorCreate_InputToOptions(t *testing.T) {
 		Connectorname          string
 		expectedTlsCredentials string
 		expectedHost           string
+		expectedSelector       string
 		expectedRoutingKey     string
 		expectedConnectorType  string
 	}
@@ -213,6 +225,20 @@ func TestNonKubeCmdConnectorCreate_InputToOptions(t *testing.T) {
 			expectedConnectorType:  "tcp",
 			expectedNamespace:      "test",
 		},
+		{
+			name:      "selector",
+			namespace: "test",
+			flags: common.CommandConnectorCreateFlags{
+				Host:          "localhost",
+				Selector:      "app=backend",
+				ConnectorType: "tcp",
+			},
+			expectedHost:          "",
+			expectedSelector:      "app=backend",
+			expectedRoutingKey:    "my-Connector",
+			expectedConnectorType: "tcp",
+			expectedNamespace:     "test",
+		},
 	}
 
 	for _, test := range testTable {
@@ -228,6 +254,7 @@ func TestNonKubeCmdConnectorCreate_InputToOptions(t *testing.T) {
 			assert.Check(t, cmd.routingKey == test.expectedRoutingKey)
 			assert.Check(t, cmd.tlsCredentials == test.expectedTlsCredentials)
 			assert.Check(t, cmd.host == test.expectedHost)
+			assert.Check(t, cmd.selector == test.expectedSelector)
 			assert.Check(t, cmd.connectorType == test.expectedConnectorType)
 			assert.Check(t, cmd.namespace == test.expectedNamespace)
 		})
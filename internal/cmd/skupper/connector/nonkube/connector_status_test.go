@@ -1,8 +1,10 @@
 package nonkube
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -119,10 +121,11 @@ func TestCmdConnectorStatus_ValidateInput(t *testing.T) {
 
 func TestCmdConnectorStatus_Run(t *testing.T) {
 	type test struct {
-		name          string
-		connectorName string
-		flags         common.CommandConnectorStatusFlags
-		errorMessage  string
+		name           string
+		connectorName  string
+		flags          common.CommandConnectorStatusFlags
+		errorMessage   string
+		expectedOutput []string
 	}
 
 	if os.Getuid() == 0 {
@@ -142,6 +145,12 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 		{
 			name:          "runs ok, returns 1 connectors",
 			connectorName: "my-connector",
+			expectedOutput: []string{
+				`(?m)^Conditions:$`,
+				`(?m)^\s+TYPE\s+STATUS\s+REASON\s+MESSAGE$`,
+				`(?m)^\s+Configured\s+True\s+Ready\s+OK$`,
+				`(?m)^\s+Ready\s+False\s+Pending\s+No matching listener$`,
+			},
 		},
 		{
 			name:          "runs ok, returns 1 connectors yaml",
@@ -187,8 +196,16 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 			Status: v2alpha1.Status{
 				Conditions: []metav1.Condition{
 					{
-						Type:   "Configured",
-						Status: "True",
+						Type:    "Configured",
+						Status:  "True",
+						Reason:  "Ready",
+						Message: "OK",
+					},
+					{
+						Type:    "Ready",
+						Status:  "False",
+						Reason:  "Pending",
+						Message: "No matching listener",
 					},
 				},
 			},
@@ -244,12 +261,18 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 		command.output = command.Flags.Output
 
 		t.Run(test.name, func(t *testing.T) {
-			err := command.Run()
+			var err error
+			output := captureStdout(t, func() {
+				err = command.Run()
+			})
 			if err != nil {
 				assert.Check(t, strings.HasSuffix(err.Error(), test.errorMessage))
 			} else {
 				assert.NilError(t, err)
 			}
+			for _, expected := range test.expectedOutput {
+				assert.Check(t, regexp.MustCompile(expected).MatchString(output), output)
+			}
 		})
 	}
 }
@@ -292,3 +315,17 @@ func TestCmdConnectorStatus_RunNoDirectory(t *testing.T) {
 		})
 	}
 }
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	assert.Assert(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	fn()
+	os.Stdout = stdout
+	assert.Assert(t, w.Close())
+	output, err := io.ReadAll(r)
+	assert.Assert(t, err)
+	return string(output)
+}
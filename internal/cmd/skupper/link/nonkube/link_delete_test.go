@@ -1 +1,127 @@
 package nonkube
+
+import (
+	"os"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCmdLinkDelete_ValidateInput(t *testing.T) {
+	type test struct {
+		name              string
+		args              []string
+		cobraGenericFlags map[string]string
+		expectedError     string
+	}
+
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+
+	testTable := []test{
+		{
+			name:          "link name is not specified",
+			args:          []string{},
+			expectedError: "link name must be specified",
+		},
+		{
+			name:          "link name is empty",
+			args:          []string{""},
+			expectedError: "link name must not be empty",
+		},
+		{
+			name:          "link name is not valid",
+			args:          []string{"my name"},
+			expectedError: "link name is not valid: value does not match this regular expression: ^[a-z0-9]([-a-z0-9]*[a-z0-9])*(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])*)*$",
+		},
+		{
+			name:          "more than one argument is specified",
+			args:          []string{"my", "link"},
+			expectedError: "only one argument is allowed for this command",
+		},
+		{
+			name:          "link doesn't exist",
+			args:          []string{"no-link"},
+			expectedError: "link no-link does not exist",
+		},
+		{
+			name: "kubernetes flags are not valid on this platform",
+			args: []string{"my-link"},
+			cobraGenericFlags: map[string]string{
+				common.FlagNameContext:    "test",
+				common.FlagNameKubeconfig: "test",
+			},
+		},
+	}
+
+	command := &CmdLinkDelete{Flags: &common.CommandLinkDeleteFlags{}}
+	command.CobraCmd = &cobra.Command{Use: "test"}
+	command.Namespace = "test"
+	command.linkHandler = fs.NewLinkHandler(command.Namespace)
+	createInputLink(t, command.linkHandler, 1, "my-link-tls")
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command.linkName = ""
+			for name, value := range test.cobraGenericFlags {
+				command.CobraCmd.Flags().String(name, value, "")
+			}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdLinkDelete_Run(t *testing.T) {
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+
+	command := &CmdLinkDelete{Namespace: "test", linkName: "my-link"}
+	command.linkHandler = fs.NewLinkHandler(command.Namespace)
+	assert.ErrorContains(t, command.Run(), "no such file or directory")
+
+	createInputLink(t, command.linkHandler, 1, "my-link-tls")
+	command.InputToOptions()
+	assert.Assert(t, command.Run())
+	_, err := command.linkHandler.Get("my-link", fs.GetOptions{})
+	assert.ErrorContains(t, err, "no such file or directory")
+}
+
+func createInputLink(t *testing.T, linkHandler *fs.LinkHandler, cost int, tlsCredentials string) {
+	t.Helper()
+	link := v2alpha1.Link{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "skupper.io/v2alpha1",
+			Kind:       "Link",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-link",
+			Namespace: "test",
+		},
+		Spec: v2alpha1.LinkSpec{
+			Endpoints: []v2alpha1.Endpoint{
+				{
+					Name: "inter-router",
+					Host: "10.0.0.1",
+					Port: "55671",
+				},
+			},
+			TlsCredentials: tlsCredentials,
+			Cost:           cost,
+		},
+	}
+	assert.Assert(t, linkHandler.Add(link))
+}
@@ -5,17 +5,26 @@ Copyright © 2024 Skupper Team <skupper@googlegroups.com>
 package nonkube
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type CmdLinkUpdate struct {
-	CobraCmd  *cobra.Command
-	Flags     *common.CommandLinkUpdateFlags
-	Namespace string
-	siteName  string
+	linkHandler   *fs.LinkHandler
+	secretHandler *fs.SecretHandler
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandLinkUpdateFlags
+	Namespace     string
+	linkName      string
+	link          *v2alpha1.Link
 }
 
 func NewCmdLinkUpdate() *CmdLinkUpdate {
@@ -23,12 +32,105 @@ func NewCmdLinkUpdate() *CmdLinkUpdate {
 }
 
 func (cmd *CmdLinkUpdate) NewClient(cobraCommand *cobra.Command, args []string) {
-	//TODO
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace) != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String() != "" {
+		cmd.Namespace = cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String()
+	}
+
+	cmd.linkHandler = fs.NewLinkHandler(cmd.Namespace)
+	cmd.secretHandler = fs.NewSecretHandler(cmd.Namespace)
+}
+
+func (cmd *CmdLinkUpdate) ValidateInput(args []string) error {
+	var validationErrors []error
+	opts := fs.GetOptions{RuntimeFirst: false, LogWarning: false}
+	resourceStringValidator := validator.NewResourceStringValidator()
+	numberValidator := validator.NewNumberValidator()
+
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameContext) != nil && cmd.CobraCmd.Flag(common.FlagNameContext).Value.String() != "" {
+		fmt.Println("Warning: --context flag is not supported on this platform")
+	}
+
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameKubeconfig) != nil && cmd.CobraCmd.Flag(common.FlagNameKubeconfig).Value.String() != "" {
+		fmt.Println("Warning: --kubeconfig flag is not supported on this platform")
+	}
+
+	// Validate arguments name
+	if len(args) < 1 {
+		validationErrors = append(validationErrors, fmt.Errorf("link name must be configured"))
+	} else if len(args) > 1 {
+		validationErrors = append(validationErrors, fmt.Errorf("only one argument is allowed for this command"))
+	} else if args[0] == "" {
+		validationErrors = append(validationErrors, fmt.Errorf("link name must not be empty"))
+	} else {
+		ok, err := resourceStringValidator.Evaluate(args[0])
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("link name is not valid: %s", err))
+		} else {
+			cmd.linkName = args[0]
+		}
+	}
+
+	// Validate that there is already a link with this name in the namespace
+	if cmd.linkName != "" {
+		link, err := cmd.linkHandler.Get(cmd.linkName, opts)
+		if link == nil || err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("link %s must exist in namespace %s to be updated", cmd.linkName, cmd.Namespace))
+		} else {
+			cmd.link = link
+		}
+	}
+
+	// Validate flags
+	if cmd.Flags != nil && cmd.Flags.TlsCredentials != "" {
+		ok, err := resourceStringValidator.Evaluate(cmd.Flags.TlsCredentials)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("tlsCredentials value is not valid: %s", err))
+		} else if secret, err := cmd.secretHandler.Get(cmd.Flags.TlsCredentials, opts); secret == nil || err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("the TLS secret %q is not available in namespace %s", cmd.Flags.TlsCredentials, cmd.Namespace))
+		}
+	}
+
+	if cmd.Flags != nil && cmd.Flags.Cost != "" {
+		selectedCost, err := strconv.Atoi(cmd.Flags.Cost)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("link cost is not valid: %s", err))
+		} else if ok, err := numberValidator.Evaluate(selectedCost); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("link cost is not valid: %s", err))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdLinkUpdate) InputToOptions() {
+	if cmd.Namespace == "" {
+		cmd.Namespace = "default"
+	}
+	// the cost flag has a default value, so the existing cost is only
+	// replaced when it is explicitly set
+	costChanged := cmd.CobraCmd == nil || cmd.CobraCmd.Flags().Changed(common.FlagNameCost)
+	if costChanged && cmd.Flags.Cost != "" {
+		cmd.link.Spec.Cost, _ = strconv.Atoi(cmd.Flags.Cost)
+	}
+	if cmd.Flags.TlsCredentials != "" {
+		cmd.link.Spec.TlsCredentials = cmd.Flags.TlsCredentials
+	}
 }
 
-func (cmd *CmdLinkUpdate) ValidateInput(args []string) error { return nil }
-func (cmd *CmdLinkUpdate) InputToOptions()                   {}
 func (cmd *CmdLinkUpdate) Run() error {
-	return fmt.Errorf("command not supported by the selected platform")
+	resource := v2alpha1.Link{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "skupper.io/v2alpha1",
+			Kind:       "Link",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmd.linkName,
+			Namespace: cmd.Namespace,
+		},
+		Spec: cmd.link.Spec,
+	}
+
+	return cmd.linkHandler.Add(resource)
 }
+
 func (cmd *CmdLinkUpdate) WaitUntil() error { return nil }
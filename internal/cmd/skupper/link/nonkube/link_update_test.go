@@ -1 +1,180 @@
 package nonkube
+
+import (
+	"os"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCmdLinkUpdate_ValidateInput(t *testing.T) {
+	type test struct {
+		name              string
+		args              []string
+		flags             *common.CommandLinkUpdateFlags
+		cobraGenericFlags map[string]string
+		expectedError     string
+	}
+
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+
+	testTable := []test{
+		{
+			name:          "link name is not specified",
+			args:          []string{},
+			flags:         &common.CommandLinkUpdateFlags{Cost: "1"},
+			expectedError: "link name must be configured",
+		},
+		{
+			name:          "link name is empty",
+			args:          []string{""},
+			flags:         &common.CommandLinkUpdateFlags{Cost: "1"},
+			expectedError: "link name must not be empty",
+		},
+		{
+			name:          "more than one argument is specified",
+			args:          []string{"my", "link"},
+			flags:         &common.CommandLinkUpdateFlags{Cost: "1"},
+			expectedError: "only one argument is allowed for this command",
+		},
+		{
+			name:          "link doesn't exist",
+			args:          []string{"no-link"},
+			flags:         &common.CommandLinkUpdateFlags{Cost: "1"},
+			expectedError: "link no-link must exist in namespace test to be updated",
+		},
+		{
+			name:          "tls secret doesn't exist",
+			args:          []string{"my-link"},
+			flags:         &common.CommandLinkUpdateFlags{TlsCredentials: "no-secret", Cost: "1"},
+			expectedError: "the TLS secret \"no-secret\" is not available in namespace test",
+		},
+		{
+			name:          "cost is not valid",
+			args:          []string{"my-link"},
+			flags:         &common.CommandLinkUpdateFlags{Cost: "one"},
+			expectedError: "link cost is not valid: strconv.Atoi: parsing \"one\": invalid syntax",
+		},
+		{
+			name:          "cost is negative",
+			args:          []string{"my-link"},
+			flags:         &common.CommandLinkUpdateFlags{Cost: "-4"},
+			expectedError: "link cost is not valid: value is not positive",
+		},
+		{
+			name:  "ok",
+			args:  []string{"my-link"},
+			flags: &common.CommandLinkUpdateFlags{TlsCredentials: "other-secret", Cost: "2"},
+			cobraGenericFlags: map[string]string{
+				common.FlagNameContext:    "test",
+				common.FlagNameKubeconfig: "test",
+			},
+		},
+	}
+
+	command := &CmdLinkUpdate{}
+	command.CobraCmd = &cobra.Command{Use: "test"}
+	command.Namespace = "test"
+	command.linkHandler = fs.NewLinkHandler(command.Namespace)
+	command.secretHandler = fs.NewSecretHandler(command.Namespace)
+	createInputLink(t, command.linkHandler, 1, "my-link-tls")
+	createSecretResource(t, command.secretHandler, "other-secret")
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command.linkName = ""
+			command.Flags = test.flags
+			for name, value := range test.cobraGenericFlags {
+				command.CobraCmd.Flags().String(name, value, "")
+			}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdLinkUpdate_Run(t *testing.T) {
+	type test struct {
+		name                   string
+		flags                  *common.CommandLinkUpdateFlags
+		costFlagSet            bool
+		expectedCost           int
+		expectedTlsCredentials string
+	}
+
+	testTable := []test{
+		{
+			name:                   "cost is updated",
+			flags:                  &common.CommandLinkUpdateFlags{Cost: "5"},
+			costFlagSet:            true,
+			expectedCost:           5,
+			expectedTlsCredentials: "my-link-tls",
+		},
+		{
+			name:                   "default cost does not override the existing one",
+			flags:                  &common.CommandLinkUpdateFlags{TlsCredentials: "other-secret", Cost: "1"},
+			expectedCost:           3,
+			expectedTlsCredentials: "other-secret",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			if os.Getuid() == 0 {
+				api.DefaultRootDataHome = t.TempDir()
+			} else {
+				t.Setenv("XDG_DATA_HOME", t.TempDir())
+			}
+			command := &CmdLinkUpdate{Namespace: "test", Flags: test.flags}
+			command.CobraCmd = &cobra.Command{Use: "test"}
+			command.CobraCmd.Flags().String(common.FlagNameCost, "1", "")
+			if test.costFlagSet {
+				assert.Assert(t, command.CobraCmd.Flags().Set(common.FlagNameCost, test.flags.Cost))
+			}
+			command.linkHandler = fs.NewLinkHandler(command.Namespace)
+			command.secretHandler = fs.NewSecretHandler(command.Namespace)
+			createInputLink(t, command.linkHandler, 3, "my-link-tls")
+			createSecretResource(t, command.secretHandler, "other-secret")
+
+			assert.Assert(t, command.ValidateInput([]string{"my-link"}))
+			command.InputToOptions()
+			assert.Assert(t, command.Run())
+
+			link, err := command.linkHandler.Get("my-link", fs.GetOptions{})
+			assert.Assert(t, err)
+			assert.Equal(t, link.Spec.Cost, test.expectedCost)
+			assert.Equal(t, link.Spec.TlsCredentials, test.expectedTlsCredentials)
+			assert.Equal(t, len(link.Spec.Endpoints), 1)
+			assert.Equal(t, link.Spec.Endpoints[0].Host, "10.0.0.1")
+		})
+	}
+}
+
+func createSecretResource(t *testing.T, secretHandler *fs.SecretHandler, name string) {
+	t.Helper()
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte("ca"),
+		},
+	}
+	assert.Assert(t, secretHandler.Add(secret))
+}
@@ -117,9 +117,11 @@ func (cmd *CmdListenerStatus) Run() error {
 			fmt.Println(encodedOutput)
 		} else {
 			tw := tabwriter.NewWriter(os.Stdout, 8, 8, 1, '\t', tabwriter.TabIndent)
-			fmt.Fprintln(tw, fmt.Sprintf("Name:\t%s\nStatus:\t%s\nRouting key:\t%s\nHost:\t%s\nPort:\t%d\nHas Matching Connector:\t%t\nMessage:\t%s\n",
+			fmt.Fprintln(tw, fmt.Sprintf("Name:\t%s\nStatus:\t%s\nRouting key:\t%s\nHost:\t%s\nPort:\t%d\nHas Matching Connector:\t%t\nMessage:\t%s",
 				resource.Name, resource.Status.StatusType, resource.Spec.RoutingKey, resource.Spec.Host,
 				resource.Spec.Port, resource.Status.HasMatchingConnector, resource.Status.Message))
+			utils.PrintConditions(tw, resource.Status.Conditions)
+			fmt.Fprintln(tw)
 			_ = tw.Flush()
 		}
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/skupperproject/skupper/internal/cmd/skupper/site"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/system"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/token"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/vanflow"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/version"
 	"github.com/skupperproject/skupper/internal/config"
 	"github.com/spf13/cobra"
@@ -35,6 +36,7 @@ func NewSkupperRootCommand() *cobra.Command {
 	rootCmd.AddCommand(version.NewCmdVersion())
 	rootCmd.AddCommand(debug.NewCmdDebug())
 	rootCmd.AddCommand(system.NewCmdSystem())
+	rootCmd.AddCommand(vanflow.NewCmdVanflow())
 
 	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
 
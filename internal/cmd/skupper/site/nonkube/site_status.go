@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
 	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	"github.com/spf13/cobra"
 )
 
@@ -101,10 +104,48 @@ func (cmd *CmdSiteStatus) Run() error {
 		}
 
 		writer.Flush()
+		printConditions(sites)
+		cmd.printHealth(time.Now())
 	}
 
 	return nil
 }
 
+// printConditions shows the conditions the system controller set on
+// each site.
+func printConditions(sites []*v2alpha1.Site) {
+	for _, site := range sites {
+		if len(site.Status.Conditions) == 0 {
+			continue
+		}
+		fmt.Printf("\nSite %s\n", site.Name)
+		tw := tabwriter.NewWriter(os.Stdout, 8, 8, 1, '\t', tabwriter.TabIndent)
+		utils.PrintConditions(tw, site.Status.Conditions)
+		_ = tw.Flush()
+	}
+}
+
+// printHealth shows what the system controller reported about the
+// router of the namespace, if it reported anything at all.
+func (cmd *CmdSiteStatus) printHealth(now time.Time) {
+	health, err := api.LoadSiteHealth(cmd.namespace)
+	if err != nil {
+		return
+	}
+	if health.IsStale(now) {
+		fmt.Printf("Warning: the system controller has not reported since %s, it may not be running\n", health.UpdatedAt.Local().Format(time.RFC3339))
+	}
+	router := health.Router
+	if router.Restarts > 0 && router.LastRestart != nil {
+		fmt.Printf("Router restarted %d times, last at %s\n", router.Restarts, router.LastRestart.Local().Format(time.RFC3339))
+	}
+	if router.CrashLoop {
+		fmt.Println("Router is crash looping, last log lines:")
+		for _, line := range router.LastLogLines {
+			fmt.Println("  " + line)
+		}
+	}
+}
+
 func (cmd *CmdSiteStatus) InputToOptions()  {}
 func (cmd *CmdSiteStatus) WaitUntil() error { return nil }
@@ -1,9 +1,13 @@
 package nonkube
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -241,3 +245,112 @@ func TestCmdSiteStatus_RunNoDirectory(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdSiteStatus_PrintHealth(t *testing.T) {
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+	now := time.Now()
+	lastRestart := now.Add(-time.Minute)
+
+	testTable := []struct {
+		name     string
+		health   *api.SiteHealth
+		expected []string
+		missing  []string
+	}{
+		{
+			name:    "no health file",
+			missing: []string{"Router", "Warning"},
+		},
+		{
+			name:    "healthy",
+			health:  &api.SiteHealth{UpdatedAt: now, Router: api.RouterHealth{Running: true, Supervised: true}},
+			missing: []string{"Router", "Warning"},
+		},
+		{
+			name: "crash looping",
+			health: &api.SiteHealth{UpdatedAt: now, Router: api.RouterHealth{
+				Supervised:   true,
+				Restarts:     3,
+				LastRestart:  &lastRestart,
+				CrashLoop:    true,
+				LastLogLines: []string{"fatal error"},
+			}},
+			expected: []string{"Router restarted 3 times", "Router is crash looping, last log lines:\n  fatal error\n"},
+			missing:  []string{"Warning"},
+		},
+		{
+			name:     "stale",
+			health:   &api.SiteHealth{UpdatedAt: now.Add(-time.Hour), Router: api.RouterHealth{Running: true}},
+			expected: []string{"Warning: the system controller has not reported since"},
+		},
+	}
+
+	command := &CmdSiteStatus{namespace: "test4"}
+	assert.Assert(t, os.MkdirAll(api.GetInternalOutputPath("test4", api.RuntimePath), 0755))
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			_ = os.Remove(api.GetHealthFile("test4"))
+			if test.health != nil {
+				assert.Assert(t, api.WriteSiteHealth("test4", test.health))
+			}
+			output := captureStdout(t, func() {
+				command.printHealth(now)
+			})
+			for _, expected := range test.expected {
+				assert.Assert(t, strings.Contains(output, expected), output)
+			}
+			for _, missing := range test.missing {
+				assert.Assert(t, !strings.Contains(output, missing), output)
+			}
+		})
+	}
+}
+
+func TestCmdSiteStatus_PrintConditions(t *testing.T) {
+	sites := []*v2alpha1.Site{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-status"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-site"},
+			Status: v2alpha1.SiteStatus{
+				Status: v2alpha1.Status{
+					Conditions: []metav1.Condition{
+						{Type: "Configured", Status: "True", Reason: "Ready", Message: "OK"},
+						{Type: "Running", Status: "False", Reason: "Pending", Message: "Router is not running"},
+					},
+				},
+			},
+		},
+	}
+	output := captureStdout(t, func() {
+		printConditions(sites)
+	})
+	for _, expected := range []string{
+		`(?m)^Site my-site$`,
+		`(?m)^Conditions:$`,
+		`(?m)^\s+Configured\s+True\s+Ready\s+OK$`,
+		`(?m)^\s+Running\s+False\s+Pending\s+Router is not running$`,
+	} {
+		assert.Assert(t, regexp.MustCompile(expected).MatchString(output), output)
+	}
+	assert.Assert(t, !strings.Contains(output, "no-status"), output)
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	assert.Assert(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	fn()
+	os.Stdout = stdout
+	assert.Assert(t, w.Close())
+	output, err := io.ReadAll(r)
+	assert.Assert(t, err)
+	return string(output)
+}
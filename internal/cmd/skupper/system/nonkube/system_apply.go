@@ -211,7 +211,7 @@ func (cmd *CmdSystemApply) Run() error {
 	}
 
 	if crApplied {
-		fmt.Println("Custom resources are applied. You can now run `skupper system reload` to make effective the changes.")
+		fmt.Println("Custom resources are applied. Changes to listeners, connectors and links are applied by the system controller;\nfor any other change you can now run `skupper system reload` to make effective the changes.")
 	}
 
 	return nil
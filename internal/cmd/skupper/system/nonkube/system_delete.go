@@ -230,7 +230,7 @@ func (cmd *CmdSystemDelete) Run() error {
 	}
 
 	if crDeleted {
-		fmt.Println("Custom resources deleted. You can now run `skupper system reload` to make effective the changes.")
+		fmt.Println("Custom resources deleted. Changes to listeners, connectors and links are applied by the system controller;\nfor any other change you can now run `skupper system reload` to make effective the changes.")
 	}
 
 	return nil
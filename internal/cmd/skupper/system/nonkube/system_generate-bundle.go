@@ -7,6 +7,7 @@ import (
 	"github.com/skupperproject/skupper/internal/config"
 	"github.com/skupperproject/skupper/internal/nonkube/bootstrap"
 	internalbundle "github.com/skupperproject/skupper/internal/nonkube/bundle"
+	nonkubecommon "github.com/skupperproject/skupper/internal/nonkube/common"
 	"github.com/skupperproject/skupper/internal/utils/validator"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	"github.com/spf13/cobra"
@@ -78,6 +79,27 @@ func (cmd *CmdSystemGenerateBundle) ValidateInput(args []string) error {
 		if !ok {
 			validationErrors = append(validationErrors, fmt.Errorf("Invalid bundle type: %s", err))
 		}
+	}
+	if cmd.Flags != nil && cmd.Flags.ServiceFormat != "" {
+		formatValidator := validator.NewOptionValidator(nonkubecommon.ServiceFormats)
+
+		ok, err := formatValidator.Evaluate(cmd.Flags.ServiceFormat)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("Invalid service format: %s", err))
+		}
+	}
+	if cmd.Flags != nil && cmd.Flags.BundleVersion != "" {
+		versionValidator := validator.NewStringValidator()
+
+		ok, err := versionValidator.Evaluate(cmd.Flags.BundleVersion)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("Invalid bundle version: %s", err))
+		}
+	}
+	if cmd.Flags != nil && cmd.Flags.SigningKey != "" {
+		if err := internalbundle.ValidateSigningKey(cmd.Flags.SigningKey); err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("Invalid signing key: %s", err))
+		}
 
 	}
 
@@ -114,6 +136,12 @@ func (cmd *CmdSystemGenerateBundle) InputToOptions() {
 		BundleStrategy: internalbundle.GetBundleStrategy(selectedType),
 		IsBundle:       isBundle,
 		Platform:       selectedPlatform,
+		ServiceFormat:  nonkubecommon.ServiceFormat(cmd.Flags.ServiceFormat),
+		BundleVersion:  cmd.Flags.BundleVersion,
+		BundleTemplate: cmd.Flags.Template,
+	}
+	if cmd.Flags.SigningKey != "" {
+		configBootStrap.BundleSigningKey, _ = filepath.Abs(cmd.Flags.SigningKey)
 	}
 
 	cmd.ConfigBootstrap = configBootStrap
@@ -40,6 +40,30 @@ func TestCmdSystemGenerateBundle_ValidateInput(t *testing.T) {
 			},
 			expectedError: "Invalid bundle type: value not-valid not allowed. It should be one of this options: [tarball shell-script]",
 		},
+		{
+			name: "invalid-service-format",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				ServiceFormat: "init",
+			},
+			expectedError: "Invalid service format: value init not allowed. It should be one of this options: [systemd quadlet kube]",
+		},
+		{
+			name: "invalid-bundle-version",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				BundleVersion: "1.0 beta",
+			},
+			expectedError: "Invalid bundle version: value does not match this regular expression: ^\\S*$",
+		},
+		{
+			name: "invalid-signing-key",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				SigningKey: "/example/key.pem",
+			},
+			expectedError: "Invalid signing key: unable to read key file: open /example/key.pem: no such file or directory",
+		},
 		{
 			name: "invalid-input-path",
 			args: []string{"bundle-name"},
@@ -85,6 +109,9 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 		expectedBundleStrategy string
 		expectedBundleName     string
 		expectedInputPath      string
+		expectedServiceFormat  string
+		expectedBundleVersion  string
+		expectedBundleTemplate bool
 	}
 
 	testTable := []test{
@@ -118,6 +145,36 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 			expectedIsBundle:       true,
 			expectedBundleStrategy: "tarball",
 		},
+		{
+			name: "quadlet",
+			flags: common.CommandSystemGenerateBundleFlags{
+				Input:         "input-path",
+				Type:          "tarball",
+				ServiceFormat: "quadlet",
+				BundleVersion: "1.2.0",
+			},
+			namespace:              "east",
+			platform:               "podman",
+			expectedNamespace:      "east",
+			expectedIsBundle:       true,
+			expectedBundleStrategy: "tarball",
+			expectedServiceFormat:  "quadlet",
+			expectedBundleVersion:  "1.2.0",
+		},
+		{
+			name: "template",
+			flags: common.CommandSystemGenerateBundleFlags{
+				Input:    "input-path",
+				Type:     "tarball",
+				Template: true,
+			},
+			namespace:              "east",
+			platform:               "podman",
+			expectedNamespace:      "east",
+			expectedIsBundle:       true,
+			expectedBundleStrategy: "tarball",
+			expectedBundleTemplate: true,
+		},
 	}
 
 	for _, test := range testTable {
@@ -138,6 +195,9 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 			assert.Check(t, cmd.ConfigBootstrap.IsBundle == test.expectedIsBundle)
 			assert.Check(t, strings.Contains(cmd.ConfigBootstrap.InputPath, cmd.Flags.Input))
 			assert.Check(t, string(cmd.ConfigBootstrap.Platform) == test.platform)
+			assert.Check(t, string(cmd.ConfigBootstrap.ServiceFormat) == test.expectedServiceFormat)
+			assert.Check(t, cmd.ConfigBootstrap.BundleVersion == test.expectedBundleVersion)
+			assert.Check(t, cmd.ConfigBootstrap.BundleTemplate == test.expectedBundleTemplate)
 		})
 	}
 }
@@ -4,16 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/config"
+	"github.com/skupperproject/skupper/internal/utils/validator"
 
 	"github.com/skupperproject/skupper/internal/nonkube/bootstrap"
+	nonkubecommon "github.com/skupperproject/skupper/internal/nonkube/common"
 	"github.com/spf13/cobra"
 )
 
 type CmdSystemInstall struct {
 	CobraCmd      *cobra.Command
 	Namespace     string
+	Flags         *common.CommandSystemInstallFlags
 	SystemInstall func(string) error
+	// SaveConfig records the system configuration, used by the sites
+	// set up afterwards
+	SaveConfig func(*nonkubecommon.SystemConfig) error
 }
 
 func NewCmdSystemInstall() *CmdSystemInstall {
@@ -25,6 +32,9 @@ func NewCmdSystemInstall() *CmdSystemInstall {
 
 func (cmd *CmdSystemInstall) NewClient(cobraCommand *cobra.Command, args []string) {
 	cmd.SystemInstall = bootstrap.Install
+	cmd.SaveConfig = func(systemConfig *nonkubecommon.SystemConfig) error {
+		return systemConfig.Save()
+	}
 }
 
 func (cmd *CmdSystemInstall) ValidateInput(args []string) error {
@@ -38,6 +48,16 @@ func (cmd *CmdSystemInstall) ValidateInput(args []string) error {
 	if config.GetPlatform() != types.PlatformPodman && config.GetPlatform() != types.PlatformDocker {
 		validationErrors = append(validationErrors, fmt.Errorf("the selected platform is not supported by this command. There is nothing to install"))
 	}
+
+	if cmd.Flags != nil && cmd.Flags.ServiceFormat != "" {
+		formatValidator := validator.NewOptionValidator(nonkubecommon.ServiceFormats)
+		ok, err := formatValidator.Evaluate(cmd.Flags.ServiceFormat)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("service format is not valid: %s", err))
+		} else if cmd.Flags.ServiceFormat != "systemd" && config.GetPlatform() != types.PlatformPodman {
+			validationErrors = append(validationErrors, fmt.Errorf("service format %s can only be used with the podman platform", cmd.Flags.ServiceFormat))
+		}
+	}
 	return errors.Join(validationErrors...)
 }
 
@@ -50,6 +70,15 @@ func (cmd *CmdSystemInstall) Run() error {
 		return fmt.Errorf("failed to configure the environment : %s", err)
 	}
 
+	if cmd.Flags != nil && cmd.Flags.ServiceFormat != "" && cmd.SaveConfig != nil {
+		err = cmd.SaveConfig(&nonkubecommon.SystemConfig{
+			ServiceFormat: nonkubecommon.ServiceFormat(cmd.Flags.ServiceFormat),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save the system configuration: %s", err)
+		}
+	}
+
 	fmt.Printf("Platform %s is now configured for Skupper\n", string(config.GetPlatform()))
 
 	return nil
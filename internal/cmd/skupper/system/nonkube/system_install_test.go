@@ -6,7 +6,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	nonkubecommon "github.com/skupperproject/skupper/internal/nonkube/common"
 	"gotest.tools/v3/assert"
 )
 
@@ -15,6 +17,7 @@ func TestCmdSystemInstall_ValidateInput(t *testing.T) {
 		name          string
 		args          []string
 		platform      string
+		flags         *common.CommandSystemInstallFlags
 		expectedError string
 	}
 
@@ -30,6 +33,23 @@ func TestCmdSystemInstall_ValidateInput(t *testing.T) {
 			platform:      "linux",
 			expectedError: "the selected platform is not supported by this command. There is nothing to install",
 		},
+		{
+			name:          "invalid service format",
+			platform:      "podman",
+			flags:         &common.CommandSystemInstallFlags{ServiceFormat: "init"},
+			expectedError: "service format is not valid: value init not allowed. It should be one of this options: [systemd quadlet kube]",
+		},
+		{
+			name:          "quadlet requires podman",
+			platform:      "docker",
+			flags:         &common.CommandSystemInstallFlags{ServiceFormat: "quadlet"},
+			expectedError: "service format quadlet can only be used with the podman platform",
+		},
+		{
+			name:     "kube service format",
+			platform: "podman",
+			flags:    &common.CommandSystemInstallFlags{ServiceFormat: "kube"},
+		},
 	}
 
 	for _, test := range testTable {
@@ -39,7 +59,7 @@ func TestCmdSystemInstall_ValidateInput(t *testing.T) {
 			err := os.Setenv("SKUPPER_PLATFORM", test.platform)
 			assert.Check(t, err == nil)
 
-			command := &CmdSystemInstall{}
+			command := &CmdSystemInstall{Flags: test.flags}
 
 			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
 		})
@@ -50,6 +70,8 @@ func TestCmdSystemInstall_Run(t *testing.T) {
 	type test struct {
 		name                  string
 		socketEnablementFails bool
+		serviceFormat         string
+		saveConfigFails       bool
 		errorMessage          string
 	}
 
@@ -64,10 +86,29 @@ func TestCmdSystemInstall_Run(t *testing.T) {
 			socketEnablementFails: true,
 			errorMessage:          "failed to configure the environment : systemd failed to enable podman socket",
 		},
+		{
+			name:          "service format is saved",
+			serviceFormat: "quadlet",
+		},
+		{
+			name:            "service format cannot be saved",
+			serviceFormat:   "quadlet",
+			saveConfigFails: true,
+			errorMessage:    "failed to save the system configuration: unable to write system configuration",
+		},
 	}
 
 	for _, test := range testTable {
 		command := newCmdSystemInstallWithMocks(test.socketEnablementFails)
+		var savedConfig *nonkubecommon.SystemConfig
+		command.Flags = &common.CommandSystemInstallFlags{ServiceFormat: test.serviceFormat}
+		command.SaveConfig = func(systemConfig *nonkubecommon.SystemConfig) error {
+			if test.saveConfigFails {
+				return fmt.Errorf("unable to write system configuration")
+			}
+			savedConfig = systemConfig
+			return nil
+		}
 
 		t.Run(test.name, func(t *testing.T) {
 
@@ -76,6 +117,11 @@ func TestCmdSystemInstall_Run(t *testing.T) {
 				assert.Check(t, test.errorMessage == err.Error())
 			} else {
 				assert.Check(t, err == nil)
+				if test.serviceFormat != "" {
+					assert.Check(t, savedConfig != nil && string(savedConfig.ServiceFormat) == test.serviceFormat)
+				} else {
+					assert.Check(t, savedConfig == nil)
+				}
 			}
 		})
 	}
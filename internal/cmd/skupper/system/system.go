@@ -112,8 +112,14 @@ func CmdSystemInstallFactory(configuredPlatform common.Platform) *cobra.Command
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdSystemInstallDesc, kubeCommand, nonKubeCommand)
 
+	cmdFlags := common.CommandSystemInstallFlags{}
+
+	cmd.Flags().StringVar(&cmdFlags.ServiceFormat, common.FlagNameServiceFormat, "", common.FlagDescServiceFormat)
+
 	kubeCommand.CobraCmd = cmd
+	kubeCommand.Flags = &cmdFlags
 	nonKubeCommand.CobraCmd = cmd
+	nonKubeCommand.Flags = &cmdFlags
 
 	return cmd
 }
@@ -162,6 +168,10 @@ func CmdSystemGenerateBundleFactory(configuredPlatform common.Platform) *cobra.C
 
 	cmd.Flags().StringVar(&cmdFlags.Input, common.FlagNameInput, "", common.FlagDescInput)
 	cmd.Flags().StringVarP(&cmdFlags.Type, common.FlagNameType, "", "tarball", common.FlagDescType)
+	cmd.Flags().StringVar(&cmdFlags.ServiceFormat, common.FlagNameServiceFormat, "", common.FlagDescBundleServiceFormat)
+	cmd.Flags().StringVar(&cmdFlags.BundleVersion, common.FlagNameBundleVersion, "", common.FlagDescBundleVersion)
+	cmd.Flags().StringVar(&cmdFlags.SigningKey, common.FlagNameSigningKey, "", common.FlagDescSigningKey)
+	cmd.Flags().BoolVar(&cmdFlags.Template, common.FlagNameTemplate, false, common.FlagDescTemplate)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
@@ -36,15 +36,21 @@ func TestCmdSystemFactory(t *testing.T) {
 		{
 			name: "CmdSystemGenerateBundleFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
-				common.FlagNameInput: "",
-				common.FlagNameType:  "tarball",
+				common.FlagNameInput:         "",
+				common.FlagNameType:          "tarball",
+				common.FlagNameServiceFormat: "",
+				common.FlagNameBundleVersion: "",
+				common.FlagNameSigningKey:    "",
+				common.FlagNameTemplate:      "false",
 			},
 			command: CmdSystemGenerateBundleFactory(common.PlatformPodman),
 		},
 		{
-			name:                          "CmdSystemInstallFactory",
-			expectedFlagsWithDefaultValue: map[string]interface{}{},
-			command:                       CmdSystemInstallFactory(common.PlatformKubernetes),
+			name: "CmdSystemInstallFactory",
+			expectedFlagsWithDefaultValue: map[string]interface{}{
+				common.FlagNameServiceFormat: "",
+			},
+			command: CmdSystemInstallFactory(common.PlatformKubernetes),
 		},
 		{
 			name: "CmdSystemUninstallFactory",
@@ -1,17 +1,31 @@
 package nonkube
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
 
+	"github.com/google/uuid"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	nonkubecommon "github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type CmdTokenIssue struct {
-	CobraCmd  *cobra.Command
-	Flags     *common.CommandTokenIssueFlags
-	Namespace string
-	siteName  string
+	CobraCmd           *cobra.Command
+	Flags              *common.CommandTokenIssueFlags
+	Namespace          string
+	siteName           string
+	accessGrantHandler *fs.AccessGrantHandler
+	grantName          string
+	fileName           string
+	cost               int
 }
 
 func NewCmdTokenIssue() *CmdTokenIssue {
@@ -19,12 +33,164 @@ func NewCmdTokenIssue() *CmdTokenIssue {
 }
 
 func (cmd *CmdTokenIssue) NewClient(cobraCommand *cobra.Command, args []string) {
-	//TODO
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace) != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String() != "" {
+		cmd.Namespace = cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String()
+	}
+
+	cmd.accessGrantHandler = fs.NewAccessGrantHandler(cmd.Namespace)
 }
 
-func (cmd *CmdTokenIssue) ValidateInput(args []string) error { return nil }
-func (cmd *CmdTokenIssue) InputToOptions()                   {}
+func (cmd *CmdTokenIssue) ValidateInput(args []string) error {
+	var validationErrors []error
+	tokenStringValidator := validator.NewFilePathStringValidator()
+	timeoutValidator := validator.NewTimeoutInSecondsValidator()
+	expirationValidator := validator.NewExpirationInSecondsValidator()
+	numberValidator := validator.NewNumberValidator()
+
+	// Validate token file name
+	if len(args) < 1 {
+		validationErrors = append(validationErrors, fmt.Errorf("file name must be configured"))
+	} else if len(args) > 1 {
+		validationErrors = append(validationErrors, fmt.Errorf("only one argument is allowed for this command"))
+	} else if args[0] == "" {
+		validationErrors = append(validationErrors, fmt.Errorf("file name must not be empty"))
+	} else {
+		ok, err := tokenStringValidator.Evaluate(args[0])
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("token file name is not valid: %s", err))
+		} else {
+			// check we can use as a filename
+			if _, err := os.ReadDir(args[0]); err == nil {
+				validationErrors = append(validationErrors, fmt.Errorf("token file name is a directory"))
+			}
+			cmd.fileName = args[0]
+		}
+	}
+
+	// Validate there is an active site with link access before a token can be created
+	pathProvider := fs.PathProvider{Namespace: cmd.Namespace}
+	siteStateLoader := &nonkubecommon.FileSystemSiteStateLoader{
+		Path: pathProvider.GetRuntimeNamespace(),
+	}
+	siteState, err := siteStateLoader.Load()
+	if err != nil {
+		validationErrors = append(validationErrors, fmt.Errorf("there is no active skupper site in this namespace"))
+	} else if !siteState.HasLinkAccess() {
+		validationErrors = append(validationErrors, fmt.Errorf("You must enable link access for this site before you can create a token."))
+	} else {
+		cmd.siteName = siteState.Site.Name
+		cmd.grantName = cmd.siteName + "-" + uuid.New().String()
+	}
+
+	// Validate flags
+	if cmd.Flags != nil && cmd.Flags.RedemptionsAllowed < 1 {
+		validationErrors = append(validationErrors, fmt.Errorf("number of redemptions is not valid"))
+	}
+
+	if cmd.Flags != nil && cmd.Flags.ExpirationWindow.String() != "" {
+		ok, err := expirationValidator.Evaluate(cmd.Flags.ExpirationWindow)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("expiration time is not valid: %s", err))
+		}
+	}
+
+	if cmd.Flags != nil && cmd.Flags.Timeout.String() != "" {
+		ok, err := timeoutValidator.Evaluate(cmd.Flags.Timeout)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("timeout is not valid: %s", err))
+		}
+	}
+
+	if cmd.Flags != nil {
+		selectedCost, err := strconv.Atoi(cmd.Flags.Cost)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("link cost is not valid: %s", err))
+		} else if ok, err := numberValidator.Evaluate(selectedCost); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("link cost is not valid: %s", err))
+		} else {
+			cmd.cost = selectedCost
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdTokenIssue) InputToOptions() {}
+
 func (cmd *CmdTokenIssue) Run() error {
-	return fmt.Errorf("command not supported by the selected platform")
+	resource := v2alpha1.AccessGrant{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "skupper.io/v2alpha1",
+			Kind:       "AccessGrant",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmd.grantName,
+			Namespace: cmd.Namespace,
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			RedemptionsAllowed: cmd.Flags.RedemptionsAllowed,
+			ExpirationWindow:   cmd.Flags.ExpirationWindow.String(),
+		},
+	}
+
+	return cmd.accessGrantHandler.Add(resource)
+}
+
+func (cmd *CmdTokenIssue) WaitUntil() error {
+	waitTime := int(cmd.Flags.Timeout.Seconds())
+	err := utils.NewSpinnerWithTimeout("Waiting for token status ...", waitTime, func() error {
+		accessGrant, err := cmd.accessGrantHandler.Get(cmd.grantName, fs.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !isGrantReady(accessGrant) {
+			return fmt.Errorf("error getting the resource")
+		}
+
+		accessToken := v2alpha1.AccessToken{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "AccessToken",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: accessGrant.Name,
+			},
+			Spec: v2alpha1.AccessTokenSpec{
+				Url:      accessGrant.Status.Url,
+				Code:     accessGrant.Status.Code,
+				Ca:       accessGrant.Status.Ca,
+				LinkCost: cmd.cost,
+			},
+		}
+
+		encodedResource, err := utils.Encode("yaml", accessToken)
+		if err != nil {
+			return fmt.Errorf("could not write out generated token: %s", err.Error())
+		}
+
+		err = os.WriteFile(cmd.fileName, []byte(encodedResource), 0644)
+		if err != nil {
+			return fmt.Errorf("could not write to file %s:%s", cmd.fileName, err.Error())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("grant %q not ready yet, make sure the system controller is running with grants enabled (--enable-grants)", cmd.grantName)
+	}
+
+	fmt.Printf("\nGrant %q is ready\n", cmd.grantName)
+	fmt.Printf("Token file %s created\n", cmd.fileName)
+	fmt.Printf("\nTransfer this file to a remote site. At the remote site,\n")
+	fmt.Printf("create a link to this site using the \"skupper token redeem\" command:\n")
+	fmt.Printf("\n\tskupper token redeem <file>\n")
+	fmt.Printf("\nThe token expires after %d use(s) or after %s.\n", cmd.Flags.RedemptionsAllowed, cmd.Flags.ExpirationWindow.String())
+	return nil
+}
+
+// isGrantReady reports whether the system controller has populated the
+// details needed to redeem the grant.
+func isGrantReady(grant *v2alpha1.AccessGrant) bool {
+	return grant != nil && grant.Status.Url != "" && grant.Status.Code != "" && grant.Status.Ca != ""
 }
-func (cmd *CmdTokenIssue) WaitUntil() error { return nil }
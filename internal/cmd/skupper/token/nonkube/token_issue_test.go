@@ -1 +1,194 @@
 package nonkube
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestCmdTokenIssue_ValidateInput(t *testing.T) {
+	type test struct {
+		name           string
+		args           []string
+		flags          *common.CommandTokenIssueFlags
+		noSite         bool
+		noRouterAccess bool
+		expectedError  string
+	}
+
+	validFlags := common.CommandTokenIssueFlags{
+		ExpirationWindow:   15 * time.Minute,
+		RedemptionsAllowed: 1,
+		Timeout:            60 * time.Second,
+		Cost:               "1",
+	}
+
+	testTable := []test{
+		{
+			name:  "ok",
+			args:  []string{"/tmp/token-issue.yaml"},
+			flags: &validFlags,
+		},
+		{
+			name:          "file name is not specified",
+			args:          []string{},
+			flags:         &validFlags,
+			expectedError: "file name must be configured",
+		},
+		{
+			name:          "more than one argument is specified",
+			args:          []string{"token.yaml", "other.yaml"},
+			flags:         &validFlags,
+			expectedError: "only one argument is allowed for this command",
+		},
+		{
+			name:          "file name is a directory",
+			args:          []string{"/tmp"},
+			flags:         &validFlags,
+			expectedError: "token file name is a directory",
+		},
+		{
+			name:          "site is not active",
+			args:          []string{"/tmp/token-issue.yaml"},
+			flags:         &validFlags,
+			noSite:        true,
+			expectedError: "there is no active skupper site in this namespace",
+		},
+		{
+			name:           "site has no link access",
+			args:           []string{"/tmp/token-issue.yaml"},
+			flags:          &validFlags,
+			noRouterAccess: true,
+			expectedError:  "You must enable link access for this site before you can create a token.",
+		},
+		{
+			name: "flags are not valid",
+			args: []string{"/tmp/token-issue.yaml"},
+			flags: &common.CommandTokenIssueFlags{
+				ExpirationWindow:   15 * time.Minute,
+				RedemptionsAllowed: 0,
+				Timeout:            60 * time.Second,
+				Cost:               "one",
+			},
+			expectedError: "number of redemptions is not valid\n" +
+				"link cost is not valid: strconv.Atoi: parsing \"one\": invalid syntax",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			setDataHome(t)
+			if !test.noSite {
+				createRuntimeSiteState(t, "test", !test.noRouterAccess)
+			}
+			command := &CmdTokenIssue{Namespace: "test", Flags: test.flags}
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+			if test.expectedError == "" {
+				assert.Equal(t, command.siteName, "my-site")
+				assert.Assert(t, command.grantName != "")
+				assert.Equal(t, command.cost, 1)
+			}
+		})
+	}
+}
+
+func TestCmdTokenIssue_RunAndWaitUntil(t *testing.T) {
+	setDataHome(t)
+	fileName := filepath.Join(t.TempDir(), "token.yaml")
+	command := &CmdTokenIssue{
+		Namespace:          "test",
+		accessGrantHandler: fs.NewAccessGrantHandler("test"),
+		grantName:          "my-site-grant",
+		fileName:           fileName,
+		cost:               3,
+		Flags: &common.CommandTokenIssueFlags{
+			ExpirationWindow:   15 * time.Minute,
+			RedemptionsAllowed: 2,
+			Timeout:            time.Second,
+		},
+	}
+	assert.Assert(t, command.Run())
+
+	grant, err := command.accessGrantHandler.Get("my-site-grant", fs.GetOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, grant.Spec.RedemptionsAllowed, 2)
+	assert.Equal(t, grant.Spec.ExpirationWindow, "15m0s")
+
+	assert.ErrorContains(t, command.WaitUntil(), "grant \"my-site-grant\" not ready yet")
+
+	// simulate the system controller processing the grant
+	grant.Status.Url = "https://myhost:8443/1234"
+	grant.Status.Code = "secret"
+	grant.Status.Ca = "ca-data"
+	assert.Assert(t, command.accessGrantHandler.Add(*grant))
+
+	assert.Assert(t, command.WaitUntil())
+	data, err := os.ReadFile(fileName)
+	assert.Assert(t, err)
+	token := v2alpha1.AccessToken{}
+	assert.Assert(t, yaml.Unmarshal(data, &token))
+	assert.Equal(t, token.Name, "my-site-grant")
+	assert.DeepEqual(t, token.Spec, v2alpha1.AccessTokenSpec{
+		Url:      "https://myhost:8443/1234",
+		Code:     "secret",
+		Ca:       "ca-data",
+		LinkCost: 3,
+	})
+}
+
+func setDataHome(t *testing.T) {
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+}
+
+func createRuntimeSiteState(t *testing.T, namespace string, linkAccess bool) {
+	t.Helper()
+	siteState := api.SiteState{
+		SiteId: "site-id",
+		Site: &v2alpha1.Site{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "Site",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-site",
+				Namespace: namespace,
+			},
+		},
+		RouterAccesses: map[string]*v2alpha1.RouterAccess{},
+	}
+	if linkAccess {
+		siteState.RouterAccesses["router-access-test"] = &v2alpha1.RouterAccess{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "RouterAccess",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "router-access-test",
+				Namespace: namespace,
+			},
+			Spec: v2alpha1.RouterAccessSpec{
+				Roles: []v2alpha1.RouterAccessRole{
+					{
+						Name: "inter-router",
+						Port: 55671,
+					},
+				},
+			},
+		}
+	}
+	assert.Assert(t, api.MarshalSiteState(siteState, api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)))
+}
@@ -0,0 +1,64 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+type CmdVanflowCapture struct {
+	KubeClient kubernetes.Interface
+	CobraCmd   *cobra.Command
+	Flags      *common.CommandVanflowCaptureFlags
+	namespace  string
+	fileName   string
+	options    capture.RecorderOptions
+	capture    func(router utils.VanflowRouter, fileName string, duration time.Duration, opts capture.RecorderOptions) error
+}
+
+func NewCmdVanflowCapture() *CmdVanflowCapture {
+	return &CmdVanflowCapture{
+		capture: utils.VanflowCapture,
+	}
+}
+
+func (cmd *CmdVanflowCapture) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.KubeClient = cli.GetKubeClient()
+	cmd.namespace = cli.Namespace
+}
+
+func (cmd *CmdVanflowCapture) ValidateInput(args []string) error {
+	fileName, validationErrors := utils.ValidateVanflowInput(args, cmd.Flags.RouterTlsCert, cmd.Flags.RouterTlsKey)
+	cmd.fileName = fileName
+	if cmd.Flags.Duration < 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("duration must not be negative"))
+	}
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdVanflowCapture) InputToOptions() {
+	cmd.options = capture.RecorderOptions{
+		Sources: cmd.Flags.Sources,
+		Flush:   cmd.Flags.Flush,
+	}
+}
+
+func (cmd *CmdVanflowCapture) Run() error {
+	router, err := vanflowRouter(cmd.KubeClient, cmd.namespace, cmd.Flags.CommandVanflowRouterFlags)
+	if err != nil {
+		return err
+	}
+	return cmd.capture(router, cmd.fileName, cmd.Flags.Duration, cmd.options)
+}
+
+func (cmd *CmdVanflowCapture) WaitUntil() error { return nil }
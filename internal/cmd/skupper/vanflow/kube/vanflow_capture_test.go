@@ -0,0 +1,141 @@
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/certs"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCmdVanflowCapture_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         common.CommandVanflowCaptureFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "missing file name",
+			args:          []string{},
+			expectedError: "capture file name must be specified",
+		},
+		{
+			name:          "too many args",
+			args:          []string{"capture.jsonl", "other.jsonl"},
+			expectedError: "only one argument is allowed for this command",
+		},
+		{
+			name: "negative duration and cert without key",
+			args: []string{"capture.jsonl"},
+			flags: common.CommandVanflowCaptureFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{RouterTlsCert: "tls.crt"},
+				Duration:                  -time.Minute,
+			},
+			expectedError: "router-tls-cert and router-tls-key must be specified together\nduration must not be negative",
+		},
+		{
+			name:  "ok",
+			args:  []string{"capture.jsonl"},
+			flags: common.CommandVanflowCaptureFlags{Duration: time.Minute},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := NewCmdVanflowCapture()
+			command.Flags = &test.flags
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdVanflowCapture_Run(t *testing.T) {
+	type test struct {
+		name             string
+		flags            common.CommandVanflowCaptureFlags
+		k8sObjects       []runtime.Object
+		expectedEndpoint string
+		expectedError    string
+	}
+
+	testTable := []test{
+		{
+			name:          "client secret missing",
+			expectedError: "unable to retrieve router client certificate",
+		},
+		{
+			name: "client secret invalid",
+			k8sObjects: []runtime.Object{
+				&corev1.Secret{ObjectMeta: localClientSecretMeta()},
+			},
+			expectedError: "invalid router client certificate",
+		},
+		{
+			name: "tls files missing",
+			flags: common.CommandVanflowCaptureFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{RouterTlsCa: "/does/not/exist/ca.crt"},
+			},
+			expectedError: "unable to load router TLS configuration",
+		},
+		{
+			name:             "ok",
+			flags:            common.CommandVanflowCaptureFlags{Duration: time.Minute, Sources: []string{"ROUTER"}, Flush: true},
+			k8sObjects:       []runtime.Object{fakeLocalClientSecret()},
+			expectedEndpoint: defaultRouterEndpoint,
+		},
+		{
+			name: "ok router endpoint",
+			flags: common.CommandVanflowCaptureFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{RouterEndpoint: "amqps://localhost:15671"},
+			},
+			k8sObjects:       []runtime.Object{fakeLocalClientSecret()},
+			expectedEndpoint: "amqps://localhost:15671",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := NewCmdVanflowCapture()
+			command.KubeClient = fake.NewSimpleClientset(test.k8sObjects...)
+			command.namespace = "test"
+			command.Flags = &test.flags
+			command.fileName = "capture.jsonl"
+			var captured bool
+			command.capture = func(router utils.VanflowRouter, fileName string, duration time.Duration, opts capture.RecorderOptions) error {
+				captured = true
+				assert.Equal(t, router.Endpoint, test.expectedEndpoint)
+				assert.Equal(t, router.TLSConfig.ServerName, localServerName)
+				assert.Equal(t, len(router.TLSConfig.Certificates), 1)
+				assert.Equal(t, fileName, "capture.jsonl")
+				assert.Equal(t, duration, test.flags.Duration)
+				assert.DeepEqual(t, opts, capture.RecorderOptions{Sources: test.flags.Sources, Flush: test.flags.Flush})
+				return nil
+			}
+			command.InputToOptions()
+			err := command.Run()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				assert.Assert(t, !captured)
+			} else {
+				assert.Assert(t, err)
+				assert.Assert(t, captured)
+			}
+		})
+	}
+}
+
+func fakeLocalClientSecret() *corev1.Secret {
+	secret := certs.GenerateSecret(localClientSecret, localClientSecret, "", 0, nil)
+	secret.ObjectMeta = localClientSecretMeta()
+	return &secret
+}
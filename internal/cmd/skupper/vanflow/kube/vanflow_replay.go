@@ -0,0 +1,62 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+type CmdVanflowReplay struct {
+	KubeClient kubernetes.Interface
+	CobraCmd   *cobra.Command
+	Flags      *common.CommandVanflowReplayFlags
+	namespace  string
+	fileName   string
+	options    capture.ReplayOptions
+	replay     func(router utils.VanflowRouter, fileName string, opts capture.ReplayOptions) error
+}
+
+func NewCmdVanflowReplay() *CmdVanflowReplay {
+	return &CmdVanflowReplay{
+		replay: utils.VanflowReplay,
+	}
+}
+
+func (cmd *CmdVanflowReplay) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.KubeClient = cli.GetKubeClient()
+	cmd.namespace = cli.Namespace
+}
+
+func (cmd *CmdVanflowReplay) ValidateInput(args []string) error {
+	fileName, validationErrors := utils.ValidateVanflowInput(args, cmd.Flags.RouterTlsCert, cmd.Flags.RouterTlsKey)
+	cmd.fileName = fileName
+	if cmd.Flags.Speed < 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("speed must not be negative"))
+	}
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdVanflowReplay) InputToOptions() {
+	cmd.options = capture.ReplayOptions{
+		Speed: cmd.Flags.Speed,
+	}
+}
+
+func (cmd *CmdVanflowReplay) Run() error {
+	router, err := vanflowRouter(cmd.KubeClient, cmd.namespace, cmd.Flags.CommandVanflowRouterFlags)
+	if err != nil {
+		return err
+	}
+	return cmd.replay(router, cmd.fileName, cmd.options)
+}
+
+func (cmd *CmdVanflowReplay) WaitUntil() error { return nil }
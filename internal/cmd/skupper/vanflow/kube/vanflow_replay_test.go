@@ -0,0 +1,76 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCmdVanflowReplay_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         common.CommandVanflowReplayFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "invalid file name",
+			args:          []string{"capture?.jsonl"},
+			expectedError: "capture file name is not valid: value does not match this regular expression: ^[A-Za-z0-9./~-]+$",
+		},
+		{
+			name:          "negative speed",
+			args:          []string{"capture.jsonl"},
+			flags:         common.CommandVanflowReplayFlags{Speed: -2},
+			expectedError: "speed must not be negative",
+		},
+		{
+			name:  "ok",
+			args:  []string{"capture.jsonl"},
+			flags: common.CommandVanflowReplayFlags{Speed: 1},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := NewCmdVanflowReplay()
+			command.Flags = &test.flags
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdVanflowReplay_Run(t *testing.T) {
+	command := NewCmdVanflowReplay()
+	command.KubeClient = fake.NewSimpleClientset(fakeLocalClientSecret())
+	command.namespace = "test"
+	command.Flags = &common.CommandVanflowReplayFlags{Speed: 0}
+	var replayed bool
+	command.replay = func(router utils.VanflowRouter, fileName string, opts capture.ReplayOptions) error {
+		replayed = true
+		assert.Equal(t, router.Endpoint, defaultRouterEndpoint)
+		assert.Equal(t, router.TLSConfig.ServerName, localServerName)
+		assert.Equal(t, fileName, "capture.jsonl")
+		assert.DeepEqual(t, opts, capture.ReplayOptions{})
+		return nil
+	}
+	assert.Assert(t, command.ValidateInput([]string{"capture.jsonl"}))
+	command.InputToOptions()
+	assert.Assert(t, command.Run())
+	assert.Assert(t, replayed)
+}
+
+func localClientSecretMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      localClientSecret,
+		Namespace: "test",
+	}
+}
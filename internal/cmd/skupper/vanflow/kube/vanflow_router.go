@@ -0,0 +1,61 @@
+package kube
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+)
+
+const (
+	// defaultRouterEndpoint is where the local endpoint of the site router
+	// is expected to be forwarded to.
+	defaultRouterEndpoint = "amqps://127.0.0.1:5671"
+	localClientSecret     = "skupper-local-client"
+	localServerName       = "skupper-router-local"
+)
+
+// vanflowRouter returns the router given through the flags. Unless
+// provided, the client certificate is the one the controller uses to
+// reach the site router, read from its secret.
+func vanflowRouter(kubeClient kubernetes.Interface, namespace string, flags common.CommandVanflowRouterFlags) (utils.VanflowRouter, error) {
+	router := utils.VanflowRouter{Endpoint: flags.RouterEndpoint}
+	if router.Endpoint == "" {
+		router.Endpoint = defaultRouterEndpoint
+	}
+	if flags.RouterTlsCa != "" || flags.RouterTlsCert != "" {
+		tlsConfig, err := utils.LoadVanflowTLSConfig(flags.RouterTlsCa, flags.RouterTlsCert, flags.RouterTlsKey)
+		if err != nil {
+			return router, fmt.Errorf("unable to load router TLS configuration: %w", err)
+		}
+		router.TLSConfig = tlsConfig
+		return router, nil
+	}
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), localClientSecret, metav1.GetOptions{})
+	if err != nil {
+		return router, fmt.Errorf("unable to retrieve router client certificate: %w", err)
+	}
+	tlsCert, err := tls.X509KeyPair(secret.Data["tls.crt"], secret.Data["tls.key"])
+	if err != nil {
+		return router, fmt.Errorf("invalid router client certificate: %w", err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(secret.Data["ca.crt"]) {
+		return router, fmt.Errorf("invalid router CA in secret %s", localClientSecret)
+	}
+	router.TLSConfig = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{tlsCert},
+		RootCAs:      certPool,
+		// the endpoint is forwarded, so the router certificate does not
+		// name the host it is reached through
+		ServerName: localServerName,
+	}
+	return router, nil
+}
@@ -0,0 +1,203 @@
+package nonkube
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/certs"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCmdVanflowCapture_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         common.CommandVanflowCaptureFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "missing file name",
+			args:          []string{},
+			expectedError: "capture file name must be specified",
+		},
+		{
+			name:          "too many args",
+			args:          []string{"capture.jsonl", "other.jsonl"},
+			expectedError: "only one argument is allowed for this command",
+		},
+		{
+			name:          "invalid file name",
+			args:          []string{"capture?.jsonl"},
+			expectedError: "capture file name is not valid: value does not match this regular expression: ^[A-Za-z0-9./~-]+$",
+		},
+		{
+			name: "cert without key",
+			args: []string{"capture.jsonl"},
+			flags: common.CommandVanflowCaptureFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{RouterTlsCert: "tls.crt"},
+			},
+			expectedError: "router-tls-cert and router-tls-key must be specified together",
+		},
+		{
+			name:          "negative duration",
+			args:          []string{"capture.jsonl"},
+			flags:         common.CommandVanflowCaptureFlags{Duration: -time.Minute},
+			expectedError: "duration must not be negative",
+		},
+		{
+			name:  "ok",
+			args:  []string{"capture.jsonl"},
+			flags: common.CommandVanflowCaptureFlags{Duration: time.Minute, Sources: []string{"ROUTER"}},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := NewCmdVanflowCapture()
+			command.Flags = &test.flags
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdVanflowCapture_Run(t *testing.T) {
+	setDataHome(t)
+	namespace := "test-vanflow-capture"
+	ss := api.NewSiteState(false)
+	ss.RouterAccesses["skupper-local"] = fakeSkupperLocalRouterAccess(namespace)
+	assert.Assert(t, api.MarshalSiteState(*ss, api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)))
+
+	type test struct {
+		name             string
+		namespace        string
+		flags            common.CommandVanflowCaptureFlags
+		clientCert       bool
+		expectedEndpoint string
+		expectedError    string
+	}
+
+	testTable := []test{
+		{
+			name:          "site not initialized",
+			namespace:     "not-initialized",
+			expectedError: "unable to determine router port",
+		},
+		{
+			name:          "router certificate missing",
+			namespace:     namespace,
+			expectedError: "unable to load router TLS configuration",
+		},
+		{
+			name:      "router endpoint",
+			namespace: "not-initialized",
+			flags: common.CommandVanflowCaptureFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{
+					RouterEndpoint: "amqp://10.0.0.1:5672",
+					RouterTlsCa:    "/does/not/exist/ca.crt",
+				},
+			},
+			expectedError: "unable to load router TLS configuration",
+		},
+		{
+			name:             "ok",
+			namespace:        namespace,
+			flags:            common.CommandVanflowCaptureFlags{Duration: time.Minute, Sources: []string{"ROUTER"}, Flush: true},
+			clientCert:       true,
+			expectedEndpoint: "amqps://127.0.0.1:5671",
+		},
+		{
+			name:      "ok router endpoint",
+			namespace: "not-initialized",
+			flags: common.CommandVanflowCaptureFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{RouterEndpoint: "amqp://10.0.0.1:5672"},
+			},
+			clientCert:       true,
+			expectedEndpoint: "amqp://10.0.0.1:5672",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := NewCmdVanflowCapture()
+			command.namespace = test.namespace
+			command.Flags = &test.flags
+			command.fileName = "capture.jsonl"
+			if test.clientCert {
+				writeClientCert(t, test.namespace)
+			}
+			var captured bool
+			command.capture = func(router utils.VanflowRouter, fileName string, duration time.Duration, opts capture.RecorderOptions) error {
+				captured = true
+				assert.Equal(t, router.Endpoint, test.expectedEndpoint)
+				assert.Assert(t, router.TLSConfig != nil)
+				assert.Equal(t, fileName, "capture.jsonl")
+				assert.Equal(t, duration, test.flags.Duration)
+				assert.DeepEqual(t, opts, capture.RecorderOptions{Sources: test.flags.Sources, Flush: test.flags.Flush})
+				return nil
+			}
+			command.InputToOptions()
+			err := command.Run()
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				assert.Assert(t, !captured)
+			} else {
+				assert.Assert(t, err)
+				assert.Assert(t, captured)
+			}
+		})
+	}
+}
+
+func setDataHome(t *testing.T) {
+	t.Helper()
+	if os.Getuid() == 0 {
+		defaultRootDataHome := api.DefaultRootDataHome
+		api.DefaultRootDataHome = t.TempDir()
+		t.Cleanup(func() { api.DefaultRootDataHome = defaultRootDataHome })
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+}
+
+func writeClientCert(t *testing.T, namespace string) {
+	t.Helper()
+	ca := certs.GenerateSecret("skupper-local-client", "skupper-local-client", "", 0, nil)
+	certPath := path.Join(api.GetInternalOutputPath(namespace, api.CertificatesPath), "skupper-local-client")
+	assert.Assert(t, os.MkdirAll(certPath, 0755))
+	for _, name := range []string{"ca.crt", "tls.crt", "tls.key"} {
+		assert.Assert(t, os.WriteFile(path.Join(certPath, name), ca.Data[name], 0644))
+	}
+}
+
+func fakeSkupperLocalRouterAccess(namespace string) *v2alpha1.RouterAccess {
+	return &v2alpha1.RouterAccess{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "skupper.io/v2alpha1",
+			Kind:       "RouterAccess",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "skupper-local",
+			Namespace: namespace,
+		},
+		Spec: v2alpha1.RouterAccessSpec{
+			Roles: []v2alpha1.RouterAccessRole{
+				{
+					Name: "normal",
+					Port: 5671,
+				},
+			},
+			BindHost: "127.0.0.1",
+		},
+	}
+}
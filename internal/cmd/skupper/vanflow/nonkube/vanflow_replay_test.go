@@ -0,0 +1,82 @@
+package nonkube
+
+import (
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"github.com/skupperproject/skupper/pkg/vanflow/capture"
+	"gotest.tools/v3/assert"
+)
+
+func TestCmdVanflowReplay_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         common.CommandVanflowReplayFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "missing file name",
+			args:          []string{""},
+			expectedError: "capture file name must be specified",
+		},
+		{
+			name: "key without cert",
+			args: []string{"capture.jsonl"},
+			flags: common.CommandVanflowReplayFlags{
+				CommandVanflowRouterFlags: common.CommandVanflowRouterFlags{RouterTlsKey: "tls.key"},
+			},
+			expectedError: "router-tls-cert and router-tls-key must be specified together",
+		},
+		{
+			name:          "negative speed",
+			args:          []string{"capture.jsonl"},
+			flags:         common.CommandVanflowReplayFlags{Speed: -1},
+			expectedError: "speed must not be negative",
+		},
+		{
+			name:  "ok",
+			args:  []string{"capture.jsonl"},
+			flags: common.CommandVanflowReplayFlags{Speed: 0},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := NewCmdVanflowReplay()
+			command.Flags = &test.flags
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdVanflowReplay_Run(t *testing.T) {
+	setDataHome(t)
+	namespace := "test-vanflow-replay"
+	ss := api.NewSiteState(false)
+	ss.RouterAccesses["skupper-local"] = fakeSkupperLocalRouterAccess(namespace)
+	assert.Assert(t, api.MarshalSiteState(*ss, api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)))
+	writeClientCert(t, namespace)
+
+	command := NewCmdVanflowReplay()
+	command.namespace = namespace
+	command.Flags = &common.CommandVanflowReplayFlags{Speed: 10}
+	var replayed bool
+	command.replay = func(router utils.VanflowRouter, fileName string, opts capture.ReplayOptions) error {
+		replayed = true
+		assert.Equal(t, router.Endpoint, "amqps://127.0.0.1:5671")
+		assert.Assert(t, router.TLSConfig != nil)
+		assert.Equal(t, fileName, "capture.jsonl")
+		assert.DeepEqual(t, opts, capture.ReplayOptions{Speed: 10})
+		return nil
+	}
+	assert.Assert(t, command.ValidateInput([]string{"capture.jsonl"}))
+	command.InputToOptions()
+	assert.Assert(t, command.Run())
+	assert.Assert(t, replayed)
+}
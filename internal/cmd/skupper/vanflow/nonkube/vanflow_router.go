@@ -0,0 +1,38 @@
+package nonkube
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/nonkube/client/runtime"
+)
+
+// vanflowRouter returns the router given through the flags, defaulting to
+// the local endpoint of the router of the namespace and the client
+// certificate the controller uses to reach it.
+func vanflowRouter(namespace string, flags common.CommandVanflowRouterFlags) (utils.VanflowRouter, error) {
+	router := utils.VanflowRouter{Endpoint: flags.RouterEndpoint}
+	if router.Endpoint == "" {
+		address, err := runtime.GetLocalRouterAddress(namespace)
+		if err != nil {
+			return router, err
+		}
+		router.Endpoint = address
+	}
+	var err error
+	if flags.RouterTlsCa != "" || flags.RouterTlsCert != "" {
+		router.TLSConfig, err = utils.LoadVanflowTLSConfig(flags.RouterTlsCa, flags.RouterTlsCert, flags.RouterTlsKey)
+	} else {
+		router.TLSConfig, err = runtime.GetRuntimeTlsCert(namespace, "skupper-local-client").GetTlsConfig()
+		if err == nil {
+			router.TLSConfig.MinVersion = tls.VersionTLS13
+		}
+	}
+	if err != nil {
+		return router, errors.Join(fmt.Errorf("unable to load router TLS configuration"), err)
+	}
+	return router, nil
+}
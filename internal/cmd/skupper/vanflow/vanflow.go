@@ -0,0 +1,93 @@
+package vanflow
+
+import (
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/vanflow/kube"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/vanflow/nonkube"
+	"github.com/skupperproject/skupper/internal/config"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmdVanflow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vanflow",
+		Short: "Capture and replay the vanflow messages of the network",
+		Long: `Capture the vanflow messages sent by the routers and controllers of the network,
+which describe its sites, services and connections, and replay them later on.
+Captures can help telling apart problems of the routers from those of the
+network observer, and can be replayed into a network observer for offline debugging.`,
+		Example: `skupper vanflow capture capture.jsonl --duration 5m
+skupper vanflow replay capture.jsonl`,
+	}
+
+	platform := common.Platform(config.GetPlatform())
+	cmd.AddCommand(CmdVanflowCaptureFactory(platform))
+	cmd.AddCommand(CmdVanflowReplayFactory(platform))
+
+	return cmd
+}
+
+func addRouterFlags(cmd *cobra.Command, flags *common.CommandVanflowRouterFlags) {
+	cmd.Flags().StringVar(&flags.RouterEndpoint, common.FlagNameRouterEndpoint, "", common.FlagDescRouterEndpoint)
+	cmd.Flags().StringVar(&flags.RouterTlsCa, common.FlagNameRouterTlsCa, "", common.FlagDescRouterTlsCa)
+	cmd.Flags().StringVar(&flags.RouterTlsCert, common.FlagNameRouterTlsCert, "", common.FlagDescRouterTlsCert)
+	cmd.Flags().StringVar(&flags.RouterTlsKey, common.FlagNameRouterTlsKey, "", common.FlagDescRouterTlsKey)
+}
+
+func CmdVanflowCaptureFactory(configuredPlatform common.Platform) *cobra.Command {
+	kubeCommand := kube.NewCmdVanflowCapture()
+	nonKubeCommand := nonkube.NewCmdVanflowCapture()
+
+	cmdVanflowCaptureDesc := common.SkupperCmdDescription{
+		Use:   "capture <fileName>",
+		Short: "Capture the vanflow messages of the network to a file",
+		Long: `Discover the vanflow event sources of the network and write the beacons,
+records, logs and heartbeats they send to a file, along with the time they
+were received at. On Kubernetes, the local endpoint of the site router must
+first be made reachable, i.e. with "kubectl port-forward service/skupper-router-local 5671".`,
+		Example: "skupper vanflow capture capture.jsonl --source ROUTER --duration 10m",
+	}
+
+	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdVanflowCaptureDesc, kubeCommand, nonKubeCommand)
+
+	cmdFlags := common.CommandVanflowCaptureFlags{}
+	addRouterFlags(cmd, &cmdFlags.CommandVanflowRouterFlags)
+	cmd.Flags().DurationVar(&cmdFlags.Duration, common.FlagNameDuration, 0, common.FlagDescCaptureDuration)
+	cmd.Flags().StringSliceVar(&cmdFlags.Sources, common.FlagNameSource, nil, common.FlagDescCaptureSource)
+	cmd.Flags().BoolVar(&cmdFlags.Flush, common.FlagNameFlush, true, common.FlagDescCaptureFlush)
+
+	kubeCommand.CobraCmd = cmd
+	kubeCommand.Flags = &cmdFlags
+	nonKubeCommand.CobraCmd = cmd
+	nonKubeCommand.Flags = &cmdFlags
+
+	return cmd
+}
+
+func CmdVanflowReplayFactory(configuredPlatform common.Platform) *cobra.Command {
+	kubeCommand := kube.NewCmdVanflowReplay()
+	nonKubeCommand := nonkube.NewCmdVanflowReplay()
+
+	cmdVanflowReplayDesc := common.SkupperCmdDescription{
+		Use:   "replay <fileName>",
+		Short: "Send the vanflow messages of a capture through the router",
+		Long: `Send the vanflow messages of a capture produced by "skupper vanflow capture"
+through the router, standing in for the event sources they were captured from,
+so that a network observer attached to the router collects them.`,
+		Example: "skupper vanflow replay capture.jsonl --speed 10",
+	}
+
+	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdVanflowReplayDesc, kubeCommand, nonKubeCommand)
+
+	cmdFlags := common.CommandVanflowReplayFlags{}
+	addRouterFlags(cmd, &cmdFlags.CommandVanflowRouterFlags)
+	cmd.Flags().Float64Var(&cmdFlags.Speed, common.FlagNameSpeed, 1, common.FlagDescReplaySpeed)
+
+	kubeCommand.CobraCmd = cmd
+	kubeCommand.Flags = &cmdFlags
+	nonKubeCommand.CobraCmd = cmd
+	nonKubeCommand.Flags = &cmdFlags
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package vanflow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gotest.tools/v3/assert"
+)
+
+func TestCmdVanflowFactory(t *testing.T) {
+
+	type test struct {
+		name                          string
+		expectedFlagsWithDefaultValue map[string]interface{}
+		command                       *cobra.Command
+	}
+
+	routerFlags := map[string]interface{}{
+		common.FlagNameRouterEndpoint: "",
+		common.FlagNameRouterTlsCa:    "",
+		common.FlagNameRouterTlsCert:  "",
+		common.FlagNameRouterTlsKey:   "",
+	}
+	withRouterFlags := func(flags map[string]interface{}) map[string]interface{} {
+		for name, value := range routerFlags {
+			flags[name] = value
+		}
+		return flags
+	}
+
+	testTable := []test{
+		{
+			name: "CmdVanflowCaptureFactory",
+			expectedFlagsWithDefaultValue: withRouterFlags(map[string]interface{}{
+				common.FlagNameDuration: "0s",
+				common.FlagNameSource:   "[]",
+				common.FlagNameFlush:    "true",
+			}),
+			command: CmdVanflowCaptureFactory(common.PlatformKubernetes),
+		},
+		{
+			name: "CmdVanflowReplayFactory",
+			expectedFlagsWithDefaultValue: withRouterFlags(map[string]interface{}{
+				common.FlagNameSpeed: "1",
+			}),
+			command: CmdVanflowReplayFactory(common.PlatformKubernetes),
+		},
+	}
+
+	for _, test := range testTable {
+
+		var flagList []string
+		t.Run(test.name, func(t *testing.T) {
+
+			test.command.Flags().VisitAll(func(flag *pflag.Flag) {
+				flagList = append(flagList, flag.Name)
+				assert.Check(t, test.expectedFlagsWithDefaultValue[flag.Name] != nil, fmt.Sprintf("flag %q not expected", flag.Name))
+				assert.Check(t, test.expectedFlagsWithDefaultValue[flag.Name] == flag.DefValue, fmt.Sprintf("default value %q for flag %q not expected", flag.DefValue, flag.Name))
+			})
+
+			assert.Check(t, len(flagList) == len(test.expectedFlagsWithDefaultValue))
+
+			assert.Assert(t, test.command.PreRunE != nil)
+			assert.Assert(t, test.command.Run != nil)
+			assert.Assert(t, test.command.PostRun != nil)
+			assert.Assert(t, test.command.Use != "")
+			assert.Assert(t, test.command.Short != "")
+			assert.Assert(t, test.command.Long != "")
+		})
+	}
+}
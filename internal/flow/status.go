@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/skupperproject/skupper/internal/network"
 	"github.com/skupperproject/skupper/pkg/vanflow"
 	"github.com/skupperproject/skupper/pkg/vanflow/eventsource"
@@ -32,6 +33,20 @@ var recordTypes []vanflow.Record = []vanflow.Record{
 	vanflow.ProcessRecord{},
 }
 
+var recordFilter = vanflow.RecordTypeFilter(recordTypes...)
+
+// ignoreLoad excludes the traffic counters, which change with nearly
+// every record update, when comparing network status.
+var ignoreLoad = cmp.Options{
+	cmpopts.IgnoreFields(network.LinkInfo{}, "Octets", "OctetRate", "OctetsReverse", "OctetRateReverse"),
+	cmpopts.IgnoreFields(network.ListenerInfo{}, "FlowCount", "FlowRate"),
+	cmpopts.IgnoreFields(network.ConnectorInfo{}, "FlowCount", "FlowRate"),
+}
+
+// defaultLoadInterval is the minimum time between updates to the
+// network status that only change traffic counters.
+const defaultLoadInterval = time.Minute
+
 type StatusSync struct {
 	records       store.Interface
 	recordMapping eventsource.RecordStoreMap
@@ -51,6 +66,9 @@ type StatusSync struct {
 	purgeQueue chan store.SourceRef
 	limit      rate.Limit
 	burst      int
+
+	loadInterval  time.Duration
+	lastPublished time.Time
 }
 
 type StatusSyncClient interface {
@@ -80,6 +98,7 @@ func NewStatusSync(factory session.ContainerFactory, localSources map[string][]s
 		hasNext:       make(chan struct{}, 1),
 		limit:         rate.Every(time.Second),
 		burst:         1,
+		loadInterval:  defaultLoadInterval,
 
 		logger: logger,
 	}
@@ -179,8 +198,16 @@ func (s *StatusSync) buildAndPublish(prev network.NetworkStatusInfo) (network.Ne
 		s.logger.Debug("no change since last publish")
 		return prev, nil
 	}
+	if cmp.Equal(prev, next, ignoreLoad) && time.Since(s.lastPublished) < s.loadInterval {
+		s.logger.Debug("only traffic counters changed since last publish")
+		return prev, nil
+	}
 	s.logger.Info("updating network status info", slog.String("configmap", s.configMapName))
-	return next, s.publish(next)
+	if err := s.publish(next); err != nil {
+		return next, err
+	}
+	s.lastPublished = time.Now()
+	return next, nil
 }
 
 func (s *StatusSync) build() network.NetworkStatusInfo {
@@ -416,7 +443,9 @@ func (s *StatusSync) handleDiscovery(source eventsource.Info) {
 		Source: sourceRef(source),
 	}
 	client.OnRecord(router.Route)
-	client.Listen(s.ctx, eventsource.FromSourceAddress())
+	// only the records the network status is made of are sent by sources
+	// accepting subscriptions, rather than all of their records
+	client.Subscribe(s.ctx, recordFilter)
 	if source.Type == "CONTROLLER" {
 		client.Listen(s.ctx, eventsource.FromSourceAddressHeartbeats())
 	}
@@ -425,6 +454,10 @@ func (s *StatusSync) handleDiscovery(source eventsource.Info) {
 	defer s.mu.Unlock()
 	s.clients[source.ID] = client
 
+	if source.Subscriptions {
+		// subscriptions start with all records selected
+		return
+	}
 	go func() {
 		ctx, cancel := context.WithTimeout(s.ctx, time.Second*5)
 		defer cancel()
@@ -483,6 +516,11 @@ func asLinkInfo(link vanflow.LinkRecord) network.LinkInfo {
 		LinkCost: dref(link.LinkCost),
 		Role:     dref(link.Role),
 		Peer:     dref(link.Peer),
+
+		Octets:           dref(link.Octets),
+		OctetRate:        dref(link.OctetRate),
+		OctetsReverse:    dref(link.OctetsReverse),
+		OctetRateReverse: dref(link.OctetRateReverse),
 	}
 }
 
@@ -498,6 +536,9 @@ func asConnectorInfo(connector vanflow.ConnectorRecord) network.ConnectorInfo {
 		DestPort: dref(connector.DestPort),
 		Address:  dref(connector.Address),
 		Process:  dref(connector.ProcessID),
+
+		FlowCount: dref(connector.FlowCountL4) + dref(connector.FlowCountL7),
+		FlowRate:  dref(connector.FlowRateL4) + dref(connector.FlowRateL7),
 	}
 }
 
@@ -509,6 +550,9 @@ func asListenerInfo(listener vanflow.ListenerRecord) network.ListenerInfo {
 		Protocol: dref(listener.Protocol),
 		Address:  dref(listener.Address),
 		Name:     dref(listener.Name),
+
+		FlowCount: dref(listener.FlowCountL4) + dref(listener.FlowCountL7),
+		FlowRate:  dref(listener.FlowRateL4) + dref(listener.FlowRateL7),
 	}
 }
 
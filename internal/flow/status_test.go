@@ -169,6 +169,67 @@ func TestStatusSyncEndToEnd(t *testing.T) {
 	}
 }
 
+type countingStatusSyncClient struct {
+	StatusSyncClient
+	updates int
+}
+
+func (c *countingStatusSyncClient) Update(ctx context.Context, latest *v1.ConfigMap) error {
+	c.updates++
+	return c.StatusSyncClient.Update(ctx, latest)
+}
+
+func TestStatusSyncTrafficCounters(t *testing.T) {
+	client := &countingStatusSyncClient{StatusSyncClient: fakeCmClient()}
+	ss := NewStatusSync(session.NewMockContainerFactory(), nil, client, "test")
+	ss.ctx = context.Background()
+
+	records := func(flowCount uint64, linkName string) []store.Entry {
+		return asEntries([]vanflow.Record{
+			vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a")},
+			vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a"), Parent: ptrTo("site-a")},
+			vanflow.ListenerRecord{BaseRecord: vanflow.NewBase("listener01"), Parent: ptrTo("router-a"),
+				Address: ptrTo("web"), FlowCountL4: ptrTo(flowCount), FlowRateL4: ptrTo(flowCount)},
+			vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link01"), Parent: ptrTo("router-a"),
+				Name: ptrTo(linkName), Octets: ptrTo(flowCount * 1024)},
+		})
+	}
+
+	var (
+		prev network.NetworkStatusInfo
+		err  error
+	)
+	publish := func(entries []store.Entry) {
+		t.Helper()
+		ss.records.Replace(entries)
+		prev, err = ss.buildAndPublish(prev)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	publish(records(1, "link-a"))
+	if client.updates != 1 {
+		t.Fatalf("expected initial status to be published, got %d updates", client.updates)
+	}
+	publish(records(2, "link-a"))
+	if client.updates != 1 {
+		t.Fatalf("expected counter change not to be published within load interval, got %d updates", client.updates)
+	}
+	publish(records(3, "link-b"))
+	if client.updates != 2 {
+		t.Fatalf("expected status change to be published, got %d updates", client.updates)
+	}
+	if actual := prev.SiteStatus[0].RouterStatus[0].Listeners[0].FlowCount; actual != 3 {
+		t.Fatalf("expected published status to include latest counters, got flow count %d", actual)
+	}
+	ss.lastPublished = time.Now().Add(-ss.loadInterval)
+	publish(records(4, "link-b"))
+	if client.updates != 3 {
+		t.Fatalf("expected counter change to be published after load interval, got %d updates", client.updates)
+	}
+}
+
 type fakeKubeStatusSyncClient struct {
 	cm corev1.ConfigMapInterface
 }
@@ -6,6 +6,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	iflag "github.com/skupperproject/skupper/internal/flag"
+	"github.com/skupperproject/skupper/internal/kube/conversion"
 	"github.com/skupperproject/skupper/internal/kube/grants"
 	"github.com/skupperproject/skupper/internal/kube/securedaccess"
 )
@@ -13,6 +14,7 @@ import (
 type Config struct {
 	GrantConfig            *grants.GrantConfig
 	SecuredAccessConfig    *securedaccess.Config
+	ConversionConfig       *conversion.Config
 	Namespace              string
 	Kubeconfig             string
 	WatchNamespace         string
@@ -39,9 +41,14 @@ func BoundConfig(flags *flag.FlagSet) (*Config, error) {
 	} else if err := securedAccessConfig.Verify(); err != nil {
 		return nil, err
 	}
+	conversionConfig, err := conversion.BoundConfig(flags)
+	if err != nil {
+		return nil, err
+	}
 	c := &Config{
 		GrantConfig:         grantConfig,
 		SecuredAccessConfig: securedAccessConfig,
+		ConversionConfig:    conversionConfig,
 	}
 	iflag.StringVar(flags, &c.Namespace, "namespace", "NAMESPACE", "", "The Kubernetes namespace scope for the controller")
 	iflag.StringVar(flags, &c.Kubeconfig, "kubeconfig", "KUBECONFIG", "", "A path to the kubeconfig file to use")
@@ -17,6 +17,7 @@ import (
 
 	"github.com/skupperproject/skupper/internal/kube/certificates"
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/conversion"
 	"github.com/skupperproject/skupper/internal/kube/grants"
 	"github.com/skupperproject/skupper/internal/kube/securedaccess"
 	"github.com/skupperproject/skupper/internal/kube/site"
@@ -42,6 +43,7 @@ type Controller struct {
 	grantWatcher         *watchers.AccessGrantWatcher
 	sites                map[string]*site.Site
 	startGrantServer     func()
+	startConversion      func()
 	accessMgr            *securedaccess.SecuredAccessManager
 	accessRecovery       *securedaccess.SecuredAccessResourceWatcher
 	certMgr              *certificates.CertificateManagerImpl
@@ -138,12 +140,16 @@ func NewController(cli internalclient.Clients, config *Config) (*Controller, err
 	controller.certMgr.Watch(config.WatchNamespace)
 
 	controller.accessMgr = securedaccess.NewSecuredAccessManager(controller.eventProcessor, controller.certMgr, config.SecuredAccessConfig, controller)
+	controller.accessMgr.SetCallbackScheduler(controller.eventProcessor)
 	controller.accessRecovery = securedaccess.NewSecuredAccessResourceWatcher(controller.accessMgr)
 	controller.accessRecovery.WatchResources(controller.eventProcessor, config.WatchNamespace)
 	controller.accessRecovery.WatchSecuredAccesses(controller.eventProcessor, config.WatchNamespace, controller.checkSecuredAccess)
 	controller.accessRecovery.WatchGateway(controller.eventProcessor, config.Namespace)
 
 	controller.startGrantServer = grants.Initialise(controller.eventProcessor, config.Namespace, config.WatchNamespace, config.GrantConfig, controller.generateLinkConfig, controller.IsControlled)
+	if config.ConversionConfig != nil {
+		controller.startConversion = conversion.Initialise(controller.eventProcessor, config.Namespace, config.ConversionConfig)
+	}
 
 	controller.eventProcessor.WatchConfigMaps(skupperLogConfig(), config.Namespace, controller.logConfigUpdate)
 
@@ -303,6 +309,9 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 	if c.startGrantServer != nil {
 		c.startGrantServer()
 	}
+	if c.startConversion != nil {
+		c.startConversion()
+	}
 	return nil
 }
 
@@ -479,7 +488,11 @@ func (c *Controller) networkStatusUpdate(key string, cm *corev1.ConfigMap) error
 		return nil
 	}
 	c.log.Debug("Updating network status", slog.String("site", key))
-	return c.getSite(cm.ObjectMeta.Namespace).NetworkStatusUpdated(network.ExtractSiteRecords(status))
+	site := c.getSite(cm.ObjectMeta.Namespace)
+	if err := site.NetworkStatusUpdated(network.ExtractSiteRecords(status)); err != nil {
+		return err
+	}
+	return site.LoadUpdated(status)
 }
 
 func filter[V any](controller *Controller, handler func(string, V) error) func(string, V) error {
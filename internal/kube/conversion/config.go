@@ -0,0 +1,35 @@
+package conversion
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	iflag "github.com/skupperproject/skupper/internal/flag"
+)
+
+type Config struct {
+	Enabled              bool
+	Port                 int
+	TlsCredentialsSecret string
+}
+
+func BoundConfig(flags *flag.FlagSet) (*Config, error) {
+	c := &Config{}
+	var errors []string
+	if err := iflag.BoolVar(flags, &c.Enabled, "enable-conversion-webhook", "SKUPPER_ENABLE_CONVERSION_WEBHOOK", false, "Serve the CRD conversion webhook for skupper.io resources."); err != nil {
+		errors = append(errors, err.Error())
+	}
+	if err := iflag.IntVar(flags, &c.Port, "conversion-webhook-port", "SKUPPER_CONVERSION_WEBHOOK_PORT", 9443, "The port on which the CRD conversion webhook should listen."); err != nil {
+		errors = append(errors, err.Error())
+	}
+	iflag.StringVar(flags, &c.TlsCredentialsSecret, "conversion-webhook-tls-credentials", "SKUPPER_CONVERSION_WEBHOOK_TLS_CREDENTIALS", "skupper-conversion-webhook", "The name of a secret in the controller's namespace in which TLS credentials for the CRD conversion webhook are found.")
+	if len(errors) > 0 {
+		return c, fmt.Errorf("Invalid environment variable(s): %s", strings.Join(errors, ", "))
+	}
+	return c, nil
+}
+
+func (c *Config) addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
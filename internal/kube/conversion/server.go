@@ -0,0 +1,101 @@
+package conversion
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/skupperproject/skupper/internal/kube/watchers"
+	"github.com/skupperproject/skupper/internal/utils/tlscfg"
+)
+
+// Server serves the conversion webhook over TLS, using credentials
+// read from a secret that is watched for updates.
+type Server struct {
+	lock          sync.RWMutex
+	cert          *tls.Certificate
+	server        *http.Server
+	secretWatcher *watchers.SecretWatcher
+	started       bool
+	log           *slog.Logger
+}
+
+// Initialise sets up the conversion webhook if it is enabled and
+// returns a function that starts it, or nil if it is disabled.
+func Initialise(events *watchers.EventProcessor, namespace string, config *Config) func() {
+	if !config.Enabled {
+		return nil
+	}
+	s := newServer(config.addr(), NewWebhook())
+	s.secretWatcher = events.WatchSecrets(watchers.ByName(config.TlsCredentialsSecret), namespace, s.tlsCredentialsUpdated)
+	return s.Start
+}
+
+func newServer(addr string, webhook http.Handler) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/convert", webhook)
+	s := &Server{
+		server: &http.Server{
+			Addr:         addr,
+			Handler:      mux,
+			ReadTimeout:  30 * time.Second,
+			WriteTimeout: 30 * time.Second,
+			TLSConfig:    tlscfg.Modern(),
+		},
+		log: slog.New(slog.Default().Handler()).With(slog.String("component", "kube.conversion.server")),
+	}
+	s.server.TLSConfig.GetCertificate = s.getCertificate
+	return s
+}
+
+func (s *Server) Start() {
+	for _, secret := range s.secretWatcher.List() {
+		s.tlsCredentialsUpdated(secret.Namespace+"/"+secret.Name, secret)
+	}
+}
+
+func (s *Server) tlsCredentialsUpdated(key string, secret *corev1.Secret) error {
+	if secret == nil {
+		return nil
+	}
+	cert, err := tls.X509KeyPair(secret.Data["tls.crt"], secret.Data["tls.key"])
+	if err != nil {
+		s.log.Error("Could not load TLS credentials for conversion webhook", slog.String("key", key), slog.Any("error", err))
+		return nil
+	}
+	s.setCertificate(&cert)
+	s.log.Info("Conversion webhook TLS credentials updated", slog.String("key", key))
+	s.start()
+	return nil
+}
+
+func (s *Server) start() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+	go func() {
+		s.log.Info("Conversion webhook listening", slog.String("address", s.server.Addr))
+		if err := s.server.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			s.log.Error("Conversion webhook failed", slog.Any("error", err))
+		}
+	}()
+}
+
+func (s *Server) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.cert, nil
+}
+
+func (s *Server) setCertificate(cert *tls.Certificate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cert = cert
+}
@@ -0,0 +1,100 @@
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+)
+
+// Webhook handles ConversionReview requests sent by the Kubernetes
+// API server for skupper.io custom resources.
+type Webhook struct {
+	log *slog.Logger
+}
+
+func NewWebhook() *Webhook {
+	return &Webhook{
+		log: slog.New(slog.Default().Handler()).With(slog.String("component", "kube.conversion.webhook")),
+	}
+}
+
+func (w *Webhook) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "Only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil {
+		http.Error(writer, fmt.Sprintf("Could not decode ConversionReview: %s", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(writer, "ConversionReview has no request", http.StatusBadRequest)
+		return
+	}
+	review.Response = w.convert(review.Request)
+	review.Request = nil
+	data, err := json.Marshal(review)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Write(data)
+}
+
+func (w *Webhook) convert(request *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	response := &apiextensionsv1.ConversionResponse{
+		UID: request.UID,
+	}
+	for _, in := range request.Objects {
+		out, err := convertObject(in, request.DesiredAPIVersion)
+		if err != nil {
+			w.log.Error("Conversion failed",
+				slog.String("desiredAPIVersion", request.DesiredAPIVersion),
+				slog.Any("error", err),
+			)
+			response.ConvertedObjects = nil
+			response.Result = metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: err.Error(),
+			}
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, out)
+	}
+	response.Result = metav1.Status{
+		Status: metav1.StatusSuccess,
+	}
+	return response
+}
+
+func convertObject(in runtime.RawExtension, apiVersion string) (runtime.RawExtension, error) {
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(in.Raw); err != nil {
+		return runtime.RawExtension{}, err
+	}
+	converted, err := skupperv2beta1.Convert(obj, apiVersion)
+	if err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("Could not convert %s %s/%s: %s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+	data, err := converted.MarshalJSON()
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	return runtime.RawExtension{Raw: data}, nil
+}
@@ -0,0 +1,123 @@
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func review(desiredAPIVersion string, objects ...string) *apiextensionsv1.ConversionReview {
+	r := &apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apiextensions.k8s.io/v1",
+			Kind:       "ConversionReview",
+		},
+		Request: &apiextensionsv1.ConversionRequest{
+			UID:               "abc",
+			DesiredAPIVersion: desiredAPIVersion,
+		},
+	}
+	for _, o := range objects {
+		r.Request.Objects = append(r.Request.Objects, runtime.RawExtension{Raw: []byte(o)})
+	}
+	return r
+}
+
+func TestWebhook(t *testing.T) {
+	var tests = []struct {
+		name            string
+		method          string
+		body            interface{}
+		expectedCode    int
+		expectedStatus  string
+		expectedMessage string
+		expectedObjects []string
+	}{
+		{
+			name:   "to v2beta1",
+			method: http.MethodPost,
+			body: review("skupper.io/v2beta1",
+				`{"apiVersion":"skupper.io/v2alpha1","kind":"Site","metadata":{"name":"a"},"spec":{"settings":{"size":"large"}}}`,
+				`{"apiVersion":"skupper.io/v2alpha1","kind":"SecuredAccess","metadata":{"name":"b"},"spec":{"settings":{"domain":"example.com","x":"y"}}}`,
+			),
+			expectedCode:   http.StatusOK,
+			expectedStatus: metav1.StatusSuccess,
+			expectedObjects: []string{
+				`{"apiVersion":"skupper.io/v2beta1","kind":"Site","metadata":{"name":"a"},"spec":{"size":"large"}}`,
+				`{"apiVersion":"skupper.io/v2beta1","kind":"SecuredAccess","metadata":{"name":"b"},"spec":{"domain":"example.com","settings":{"x":"y"}}}`,
+			},
+		},
+		{
+			name:   "to v2alpha1",
+			method: http.MethodPost,
+			body: review("skupper.io/v2alpha1",
+				`{"apiVersion":"skupper.io/v2beta1","kind":"Site","metadata":{"name":"a"},"spec":{"routerDataConnectionCount":4}}`,
+			),
+			expectedCode:   http.StatusOK,
+			expectedStatus: metav1.StatusSuccess,
+			expectedObjects: []string{
+				`{"apiVersion":"skupper.io/v2alpha1","kind":"Site","metadata":{"name":"a"},"spec":{"settings":{"router-data-connection-count":"4"}}}`,
+			},
+		},
+		{
+			name:   "unsupported version",
+			method: http.MethodPost,
+			body: review("skupper.io/v3",
+				`{"apiVersion":"skupper.io/v2beta1","kind":"Site","metadata":{"name":"a"}}`,
+			),
+			expectedCode:    http.StatusOK,
+			expectedStatus:  metav1.StatusFailure,
+			expectedMessage: "Could not convert Site /a: Unsupported API version skupper.io/v3",
+		},
+		{
+			name:         "no request",
+			method:       http.MethodPost,
+			body:         &apiextensionsv1.ConversionReview{},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "bad body",
+			method:       http.MethodPost,
+			body:         "not a review",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "wrong method",
+			method:       http.MethodGet,
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.body)
+			assert.Assert(t, err)
+			request := httptest.NewRequest(tt.method, "/convert", bytes.NewReader(data))
+			recorder := httptest.NewRecorder()
+			NewWebhook().ServeHTTP(recorder, request)
+			assert.Equal(t, recorder.Code, tt.expectedCode)
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+			result := &apiextensionsv1.ConversionReview{}
+			assert.Assert(t, json.Unmarshal(recorder.Body.Bytes(), result))
+			assert.Assert(t, result.Response != nil)
+			assert.Equal(t, result.Response.UID, tt.body.(*apiextensionsv1.ConversionReview).Request.UID)
+			assert.Equal(t, result.Response.Result.Status, tt.expectedStatus)
+			assert.Equal(t, result.Response.Result.Message, tt.expectedMessage)
+			assert.Equal(t, len(result.Response.ConvertedObjects), len(tt.expectedObjects))
+			for i, expected := range tt.expectedObjects {
+				var e, a map[string]interface{}
+				assert.Assert(t, json.Unmarshal([]byte(expected), &e))
+				assert.Assert(t, json.Unmarshal(result.Response.ConvertedObjects[i].Raw, &a))
+				assert.DeepEqual(t, a, e)
+			}
+		})
+	}
+}
@@ -16,9 +16,9 @@ func enabled(controller *watchers.EventProcessor, currentNamespace string, watch
 	gc := &GrantsEnabled{
 		grants: newGrants(controller, generator, config.scheme(), config.BaseUrl),
 	}
-	gc.server = newServer(config.addr(), config.tlsEnabled(), gc.grants)
+	gc.server = NewServer(config.addr(), config.tlsEnabled(), gc.grants)
 
-	gc.grantWatcher = controller.WatchAccessGrants(watchNamespace, watchers.FilterByNamespace(filter, gc.grants.checkGrant))
+	gc.grantWatcher = controller.WatchAccessGrants(watchNamespace, watchers.FilterByNamespace(filter, gc.grants.CheckGrant))
 	gc.secretWatcher = controller.WatchSecrets(watchers.ByName(config.TlsCredentialsSecret), watchNamespace, watchers.FilterByNamespace(filter, gc.tlsCredentialsUpdated))
 
 	if config.AutoConfigure {
@@ -45,7 +45,7 @@ func (c *GrantsEnabled) Start() {
 	c.recoverGrants()
 	c.recoverSecrets()
 	if c.autoConfigure == nil {
-		c.server.start()
+		c.server.Start()
 	}
 }
 
@@ -54,7 +54,7 @@ func (c *GrantsEnabled) recoverGrants() {
 		if c.filter != nil && !c.filter(grant.Namespace) {
 			continue
 		}
-		c.grants.checkGrant(fmt.Sprintf("%s/%s", grant.Namespace, grant.Name), grant)
+		c.grants.CheckGrant(fmt.Sprintf("%s/%s", grant.Namespace, grant.Name), grant)
 	}
 }
 
@@ -75,7 +75,7 @@ func (s *GrantsEnabled) securedAccessChanged(key string, se *skupperv2alpha1.Sec
 		if !s.started {
 			s.started = true
 			log.Print("Starting grant server")
-			s.server.start()
+			s.server.Start()
 		}
 	}
 	return nil
@@ -32,7 +32,7 @@ func Test_tlsCredentialsUpdated(t *testing.T) {
 			gc := &GrantsEnabled{
 				grants: newGrants(nil, nil, "https", ""),
 			}
-			gc.server = newServer(":0", true, gc.grants)
+			gc.server = NewServer(":0", true, gc.grants)
 			err := gc.tlsCredentialsUpdated(tt.key, tt.secret)
 			if err != nil {
 				t.Error(err)
@@ -66,7 +66,7 @@ func TestGrantRegistryGeneral(t *testing.T) {
 	registry := newGrants(client, dummyGenerator, "https", "")
 	for _, grant := range grants {
 		key := grant.Namespace + "/" + grant.Name
-		err = registry.checkGrant(key, grant)
+		err = registry.CheckGrant(key, grant)
 		if err != nil {
 			t.Error(err)
 		}
@@ -302,7 +302,7 @@ func Test_ServeHttp(t *testing.T) {
 			}
 			registry := newGrants(client, generator, "https", "")
 			for _, grant := range []*v2alpha1.AccessGrant{good, expired, used, badExpiration, deleted} {
-				err = registry.checkGrant(grant.Namespace+"/"+grant.Name, grant)
+				err = registry.CheckGrant(grant.Namespace+"/"+grant.Name, grant)
 				if err != nil {
 					t.Error(err)
 				}
@@ -499,7 +499,7 @@ func Test_checkGrant(t *testing.T) {
 				if call.ca != "" {
 					registry.setCA(call.ca)
 				}
-				err = registry.checkGrant(call.key, call.grant)
+				err = registry.CheckGrant(call.key, call.grant)
 				if call.expectedError != "" {
 					assert.ErrorContains(t, err, call.expectedError)
 				} else if err != nil {
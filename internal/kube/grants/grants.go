@@ -20,8 +20,12 @@ import (
 
 type GrantResponse func(namespace string, name string, subject string, writer io.Writer) error
 
+// StatusUpdater persists a change to the status of an AccessGrant,
+// returning the grant as updated.
+type StatusUpdater func(grant *skupperv2alpha1.AccessGrant) (*skupperv2alpha1.AccessGrant, error)
+
 type Grants struct {
-	clients    internalclient.Clients
+	updater    StatusUpdater
 	generator  GrantResponse
 	url        string
 	ca         string
@@ -32,8 +36,16 @@ type Grants struct {
 }
 
 func newGrants(clients internalclient.Clients, generator GrantResponse, scheme string, url string) *Grants {
+	return NewGrants(kubeStatusUpdater(clients), generator, scheme, url)
+}
+
+// NewGrants returns an http.Handler implementing redemption of
+// AccessGrants, for use where grants are not stored as kubernetes
+// resources. Grants are registered through CheckGrant, and any
+// changes to their status are persisted through the supplied updater.
+func NewGrants(updater StatusUpdater, generator GrantResponse, scheme string, url string) *Grants {
 	return &Grants{
-		clients:    clients,
+		updater:    updater,
 		generator:  generator,
 		scheme:     scheme,
 		url:        url,
@@ -42,6 +54,29 @@ func newGrants(clients internalclient.Clients, generator GrantResponse, scheme s
 	}
 }
 
+func kubeStatusUpdater(clients internalclient.Clients) StatusUpdater {
+	return func(grant *skupperv2alpha1.AccessGrant) (*skupperv2alpha1.AccessGrant, error) {
+		return clients.GetSkupperClient().SkupperV2alpha1().AccessGrants(grant.ObjectMeta.Namespace).UpdateStatus(context.TODO(), grant, metav1.UpdateOptions{})
+	}
+}
+
+// SetCA sets the CA that will be included in the status of all grants,
+// for validating the certificate of the server through which they are
+// redeemed.
+func (g *Grants) SetCA(ca string) {
+	if g.setCA(ca) {
+		g.recheckCa()
+	}
+}
+
+// SetUrl sets the host (and optionally port) through which grants can
+// be redeemed.
+func (g *Grants) SetUrl(url string) {
+	if g.setUrl(url) {
+		g.recheckUrl()
+	}
+}
+
 func (g *Grants) setCA(ca string) bool {
 	g.lock.Lock()
 	defer g.lock.Unlock()
@@ -170,7 +205,9 @@ func (g *Grants) checkCa(key string, grant *skupperv2alpha1.AccessGrant) bool {
 	return true
 }
 
-func (g *Grants) checkGrant(key string, grant *skupperv2alpha1.AccessGrant) error {
+// CheckGrant records the current state of the grant with the given
+// key, or removes it if grant is nil, updating its status as needed.
+func (g *Grants) CheckGrant(key string, grant *skupperv2alpha1.AccessGrant) error {
 	if grant == nil {
 		g.remove(key)
 		return nil
@@ -229,7 +266,7 @@ func (g *Grants) checkGrant(key string, grant *skupperv2alpha1.AccessGrant) erro
 }
 
 func (g *Grants) updateGrantStatus(grant *skupperv2alpha1.AccessGrant) error {
-	updated, err := g.clients.GetSkupperClient().SkupperV2alpha1().AccessGrants(grant.ObjectMeta.Namespace).UpdateStatus(context.TODO(), grant, metav1.UpdateOptions{})
+	updated, err := g.updater(grant)
 	if err != nil {
 		return err
 	}
@@ -401,11 +401,11 @@ func Test_RedeemAccessToken(t *testing.T) {
 			}
 
 			grants := newGrants(client, generator(site, client), tt.scheme, "")
-			server := newServer(":0", tt.scheme == "https", grants)
+			server := NewServer(":0", tt.scheme == "https", grants)
 			server.listen()
 			grants.setUrl(fmt.Sprintf("localhost:%d", server.port()))
 			go server.serve()
-			defer server.stop()
+			defer server.Stop()
 			if tt.scheme == "https" {
 				secret := tf.secret("my-creds", "test", "grant server", []string{"localhost"})
 				err = server.setCertificateFromSecret(secret)
@@ -420,7 +420,7 @@ func Test_RedeemAccessToken(t *testing.T) {
 			if err != nil {
 				t.Error(err)
 			}
-			err = grants.checkGrant(grant.Namespace+"/"+grant.Name, grant)
+			err = grants.CheckGrant(grant.Namespace+"/"+grant.Name, grant)
 			if err != nil {
 				t.Error(err)
 			}
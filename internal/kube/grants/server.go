@@ -22,7 +22,7 @@ type Server struct {
 	listener   net.Listener
 }
 
-func newServer(addr string, tlsEnabled bool, handler http.Handler) *Server {
+func NewServer(addr string, tlsEnabled bool, handler http.Handler) *Server {
 	return &Server{
 		server: &http.Server{
 			Addr:         addr,
@@ -35,7 +35,7 @@ func newServer(addr string, tlsEnabled bool, handler http.Handler) *Server {
 	}
 }
 
-func (s *Server) start() {
+func (s *Server) Start() {
 	go s.listenAndServe()
 }
 
@@ -45,7 +45,7 @@ func (s *Server) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
 	return s.cert, nil
 }
 
-func (s *Server) setCertificate(cert *tls.Certificate) {
+func (s *Server) SetCertificate(cert *tls.Certificate) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	s.cert = cert
@@ -56,7 +56,7 @@ func (s *Server) setCertificateFromSecret(secret *corev1.Secret) error {
 	if err != nil {
 		return err
 	}
-	s.setCertificate(&cert)
+	s.SetCertificate(&cert)
 	return nil
 }
 
@@ -91,7 +91,7 @@ func (s *Server) listenAndServe() error {
 	return s.serve()
 }
 
-func (s *Server) stop() error {
+func (s *Server) Stop() error {
 	err := s.server.Close()
 	s.listener.Close()
 	s.listener = nil
@@ -32,7 +32,7 @@ func Test_setCertificateFromSecret(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := newServer(":0", true, &TestHandler{})
+			server := NewServer(":0", true, &TestHandler{})
 			err := server.setCertificateFromSecret(tt.secret)
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
@@ -55,25 +55,25 @@ func Test_setCertificateFromSecret(t *testing.T) {
 }
 
 func Test_handlesErrorOnListen(t *testing.T) {
-	server1 := newServer(":0", true, &TestHandler{})
+	server1 := NewServer(":0", true, &TestHandler{})
 	err := server1.listen()
 	if err != nil {
 		t.Error(err)
 	}
-	defer server1.stop()
-	server2 := newServer(fmt.Sprintf(":%d", server1.port()), true, &TestHandler{})
+	defer server1.Stop()
+	server2 := NewServer(fmt.Sprintf(":%d", server1.port()), true, &TestHandler{})
 	err = server2.listenAndServe()
 	assert.ErrorContains(t, err, server2.server.Addr)
 }
 
 func Test_handlesServeBeforeListen(t *testing.T) {
-	server := newServer(":0", true, &TestHandler{})
+	server := NewServer(":0", true, &TestHandler{})
 	err := server.serve()
 	assert.ErrorContains(t, err, "Cannot serve before listen() is called")
 }
 
 func Test_handlesPortBeforeListen(t *testing.T) {
-	server := newServer(":1234", true, &TestHandler{})
+	server := NewServer(":1234", true, &TestHandler{})
 	assert.Equal(t, 0, server.port())
 }
 
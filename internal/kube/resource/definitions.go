@@ -29,6 +29,30 @@ func TlsRouteResource() schema.GroupVersionResource {
 	}
 }
 
+func TcpRouteResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1alpha2",
+		Resource: "tcproutes",
+	}
+}
+
+func IstioGatewayResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "networking.istio.io",
+		Version:  "v1beta1",
+		Resource: "gateways",
+	}
+}
+
+func IstioVirtualServiceResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "networking.istio.io",
+		Version:  "v1beta1",
+		Resource: "virtualservices",
+	}
+}
+
 func DeploymentResource() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
 		Group:    "apps",
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -41,11 +42,17 @@ type SecuredAccessManager struct {
 	ingresses          map[string]*networkingv1.Ingress
 	httpProxies        map[string]*unstructured.Unstructured
 	tlsRoutes          map[string]*unstructured.Unstructured
+	tcpRoutes          map[string]*unstructured.Unstructured
+	tcpGateways        map[string]*unstructured.Unstructured
+	virtualServices    map[string]*unstructured.Unstructured
 	clients            internalclient.Clients
 	certMgr            certificates.CertificateManager
 	enabledAccessTypes map[string]AccessType
 	defaultAccessType  string
 	gatewayInit        func() error
+	istioGatewayInit   func() error
+	resolutionStarted  map[string]time.Time
+	scheduler          CallbackScheduler
 	context            ControllerContext
 }
 
@@ -57,6 +64,10 @@ func NewSecuredAccessManager(clients internalclient.Clients, certMgr certificate
 		ingresses:          map[string]*networkingv1.Ingress{},
 		httpProxies:        map[string]*unstructured.Unstructured{},
 		tlsRoutes:          map[string]*unstructured.Unstructured{},
+		tcpRoutes:          map[string]*unstructured.Unstructured{},
+		tcpGateways:        map[string]*unstructured.Unstructured{},
+		virtualServices:    map[string]*unstructured.Unstructured{},
+		resolutionStarted:  map[string]time.Time{},
 		clients:            clients,
 		certMgr:            certMgr,
 		enabledAccessTypes: map[string]AccessType{},
@@ -80,6 +91,16 @@ func NewSecuredAccessManager(clients internalclient.Clients, certMgr certificate
 				mgr.enabledAccessTypes[accessType] = at
 				mgr.gatewayInit = init
 			}
+		} else if accessType == ACCESS_TYPE_GATEWAY_TCP {
+			mgr.enabledAccessTypes[accessType] = newTcpRouteAccess(mgr, config.GatewayClass)
+		} else if accessType == ACCESS_TYPE_ISTIO_GATEWAY {
+			at, init, err := newIstioGatewayAccess(mgr, config, context)
+			if err != nil {
+				log.Printf("Failed to create istio gateway, istio-gateway access type will not be enabled: %s", err)
+			} else {
+				mgr.enabledAccessTypes[accessType] = at
+				mgr.istioGatewayInit = init
+			}
 		} else if accessType == ACCESS_TYPE_NODEPORT {
 			mgr.enabledAccessTypes[accessType] = newNodeportAccess(mgr, config.ClusterHost)
 		} else if accessType == ACCESS_TYPE_LOCAL {
@@ -208,6 +229,9 @@ func (m *SecuredAccessManager) SecuredAccessChanged(key string, current *skupper
 }
 
 func (m *SecuredAccessManager) actualAccessType(sa *skupperv2alpha1.SecuredAccess) string {
+	if len(sa.Spec.AccessTypes) > 0 {
+		return selectedAccessType(sa)
+	}
 	if sa.Spec.AccessType == "" {
 		return m.defaultAccessType
 	}
@@ -215,26 +239,34 @@ func (m *SecuredAccessManager) actualAccessType(sa *skupperv2alpha1.SecuredAcces
 }
 
 func (m *SecuredAccessManager) accessType(sa *skupperv2alpha1.SecuredAccess) AccessType {
-	accessType := sa.Spec.AccessType
-	if accessType == "" {
-		accessType = m.defaultAccessType
-	}
-	if at, ok := m.enabledAccessTypes[accessType]; ok {
+	if at, ok := m.enabledAccessTypes[m.actualAccessType(sa)]; ok {
 		return at
 	}
 	return newUnsupportedAccess(m)
 }
 
 func (m *SecuredAccessManager) reconcile(sa *skupperv2alpha1.SecuredAccess) error {
+	updated := false
+	if accessType := m.actualAccessType(sa); sa.Status.AccessType != accessType {
+		sa.Status.AccessType = accessType
+		updated = true
+	}
 	svc, err := m.checkService(sa)
 	if err != nil {
-		if sa.SetConfigured(err) {
+		if sa.SetConfigured(err) || updated {
 			return m.updateStatus(sa)
 		}
 		return nil
 	}
-	updated := false
 	endpoints, resourceErr := m.accessType(sa).RealiseAndResolve(sa, svc)
+	if next := m.nextAccessType(sa, len(endpoints) > 0); next != "" {
+		log.Printf("Access type %s not resolved for %s, falling back to %s", sa.Status.AccessType, sa.Key(), next)
+		sa.Status.AccessType = next
+		if err := m.updateStatus(sa); err != nil {
+			return err
+		}
+		return m.reconcile(m.definitions[sa.Key()])
+	}
 
 	if sa.SetResolved(endpoints) {
 		if len(endpoints) > 0 {
@@ -357,6 +389,7 @@ func (m *SecuredAccessManager) SecuredAccessDeleted(key string) error {
 		//definition, so deleting this will cause them to be
 		//deleted also
 		delete(m.definitions, key)
+		delete(m.resolutionStarted, key)
 	}
 	return nil
 }
@@ -376,6 +409,21 @@ func (m *SecuredAccessManager) RecoverTlsRoute(o *unstructured.Unstructured) {
 	m.tlsRoutes[key] = o
 }
 
+func (m *SecuredAccessManager) RecoverTcpRoute(o *unstructured.Unstructured) {
+	key := fmt.Sprintf("%s/%s", o.GetNamespace(), o.GetName())
+	m.tcpRoutes[key] = o
+}
+
+func (m *SecuredAccessManager) RecoverTcpGateway(o *unstructured.Unstructured) {
+	key := fmt.Sprintf("%s/%s", o.GetNamespace(), o.GetName())
+	m.tcpGateways[key] = o
+}
+
+func (m *SecuredAccessManager) RecoverVirtualService(o *unstructured.Unstructured) {
+	key := fmt.Sprintf("%s/%s", o.GetNamespace(), o.GetName())
+	m.virtualServices[key] = o
+}
+
 func (m *SecuredAccessManager) RecoverIngress(ingress *networkingv1.Ingress) {
 	key := fmt.Sprintf("%s/%s", ingress.Namespace, ingress.Name)
 	m.ingresses[key] = ingress
@@ -452,6 +500,60 @@ func (m *SecuredAccessManager) CheckTlsRoute(key string, o *unstructured.Unstruc
 	return m.reconcile(sa)
 }
 
+func (m *SecuredAccessManager) CheckTcpRoute(key string, o *unstructured.Unstructured) error {
+	sa := m.getDefinitionForPortQualifiedResourceKey(key, ACCESS_TYPE_GATEWAY_TCP)
+	if o == nil {
+		delete(m.tcpRoutes, key)
+		if sa == nil {
+			return nil
+		}
+	} else {
+		m.tcpRoutes[key] = o
+		if sa == nil {
+			log.Printf("Deleting redundant TCPRoute %s/%s", o.GetNamespace(), o.GetName())
+			return m.clients.GetDynamicClient().Resource(resource.TcpRouteResource()).Namespace(o.GetNamespace()).Delete(context.Background(), o.GetName(), metav1.DeleteOptions{})
+		}
+	}
+	return m.reconcile(sa)
+}
+
+func (m *SecuredAccessManager) CheckTcpGateway(key string, o *unstructured.Unstructured) error {
+	sa, ok := m.definitions[key]
+	if ok && m.actualAccessType(sa) != ACCESS_TYPE_GATEWAY_TCP {
+		sa, ok = nil, false
+	}
+	if o == nil {
+		delete(m.tcpGateways, key)
+		if !ok {
+			return nil
+		}
+	} else {
+		m.tcpGateways[key] = o
+		if !ok {
+			log.Printf("Deleting redundant Gateway %s/%s", o.GetNamespace(), o.GetName())
+			return m.clients.GetDynamicClient().Resource(resource.GatewayResource()).Namespace(o.GetNamespace()).Delete(context.Background(), o.GetName(), metav1.DeleteOptions{})
+		}
+	}
+	return m.reconcile(sa)
+}
+
+func (m *SecuredAccessManager) CheckVirtualService(key string, o *unstructured.Unstructured) error {
+	sa := m.getDefinitionForPortQualifiedResourceKey(key, ACCESS_TYPE_ISTIO_GATEWAY)
+	if o == nil {
+		delete(m.virtualServices, key)
+		if sa == nil {
+			return nil
+		}
+	} else {
+		m.virtualServices[key] = o
+		if sa == nil {
+			log.Printf("Deleting redundant VirtualService %s/%s", o.GetNamespace(), o.GetName())
+			return m.clients.GetDynamicClient().Resource(resource.IstioVirtualServiceResource()).Namespace(o.GetNamespace()).Delete(context.Background(), o.GetName(), metav1.DeleteOptions{})
+		}
+	}
+	return m.reconcile(sa)
+}
+
 func (m *SecuredAccessManager) CheckIngress(key string, ingress *networkingv1.Ingress) error {
 	sa, ok := m.definitions[key]
 	if ingress == nil {
@@ -480,6 +582,13 @@ func (m *SecuredAccessManager) CheckGateway(key string, o *unstructured.Unstruct
 	return m.gatewayInit()
 }
 
+func (m *SecuredAccessManager) CheckIstioGateway(key string, o *unstructured.Unstructured) error {
+	if m.istioGatewayInit == nil {
+		return nil
+	}
+	return m.istioGatewayInit()
+}
+
 func (m *SecuredAccessManager) CheckService(key string, svc *corev1.Service) error {
 	if svc == nil {
 		delete(m.services, key)
@@ -1874,6 +1874,293 @@ func TestSecuredAccessGeneral(t *testing.T) {
 			},
 			expectedStatus: "Gateway base domain not yet resolved",
 		},
+		{
+			name: "istio gateway",
+			config: Config{
+				EnabledAccessTypes: []string{
+					ACCESS_TYPE_ISTIO_GATEWAY,
+				},
+				IstioGatewayDomain:   "mesh.example.com",
+				IstioGatewaySelector: "istio=ingressgateway",
+				IstioGatewayPort:     443,
+			},
+			ssaRecorder: newServerSideApplyRecorder(),
+			expectedSSA: map[string]*unstructured.Unstructured{
+				"test/skupper": istioGateway("skupper", "test"),
+				"test/mysvc-a": virtualservice("mysvc-a", "test"),
+				"test/mysvc-b": virtualservice("mysvc-b", "test"),
+			},
+			definition: &skupperv2alpha1.SecuredAccess{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "skupper.io/v2alpha1",
+					Kind:       "SecuredAccess",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mysvc",
+					Namespace: "test",
+				},
+				Spec: skupperv2alpha1.SecuredAccessSpec{
+					AccessType: ACCESS_TYPE_ISTIO_GATEWAY,
+					Selector: map[string]string{
+						"app": "foo",
+					},
+					Ports: []skupperv2alpha1.SecuredAccessPort{
+						{
+							Name:       "a",
+							Port:       8080,
+							TargetPort: 8081,
+							Protocol:   "TCP",
+						},
+						{
+							Name:       "b",
+							Port:       9090,
+							TargetPort: 9191,
+							Protocol:   "TCP",
+						},
+					},
+					Certificate: "my-cert",
+					Issuer:      "skupper-site-ca",
+				},
+			},
+			expectedServices: []*corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mysvc",
+						Namespace: "test",
+					},
+					Spec: corev1.ServiceSpec{
+						Selector: map[string]string{
+							"app": "foo",
+						},
+						Ports: []corev1.ServicePort{
+							{
+								Name:       "a",
+								Port:       8080,
+								TargetPort: intstr.IntOrString{IntVal: int32(8081)},
+								Protocol:   corev1.Protocol("TCP"),
+							},
+							{
+								Name:       "b",
+								Port:       9090,
+								TargetPort: intstr.IntOrString{IntVal: int32(9191)},
+								Protocol:   corev1.Protocol("TCP"),
+							},
+						},
+					},
+				},
+			},
+			expectedCertificates: []MockCertificate{
+				{
+					namespace: "test",
+					name:      "my-cert",
+					ca:        "skupper-site-ca",
+					subject:   "mysvc",
+					hosts:     []string{"mysvc", "mysvc.test", "mysvc-a.test.mesh.example.com", "mysvc-b.test.mesh.example.com"},
+					client:    false,
+					server:    true,
+					refs:      nil,
+				},
+			},
+			expectedStatus: "OK",
+			expectedEndpoints: []skupperv2alpha1.Endpoint{
+				{
+					Name: "a",
+					Port: "443",
+					Host: "mysvc-a.test.mesh.example.com",
+				},
+				{
+					Name: "b",
+					Port: "443",
+					Host: "mysvc-b.test.mesh.example.com",
+				},
+			},
+		},
+		{
+			name: "gateway tcp",
+			config: Config{
+				EnabledAccessTypes: []string{
+					ACCESS_TYPE_GATEWAY_TCP,
+				},
+				GatewayClass: "xyz",
+			},
+			ssaRecorder: newServerSideApplyRecorder().setGatewayIP("test/mysvc", "10.1.1.10"),
+			expectedSSA: map[string]*unstructured.Unstructured{
+				"test/mysvc":   gateway("mysvc", "test"),
+				"test/mysvc-a": tcproute("mysvc-a", "test"),
+				"test/mysvc-b": tcproute("mysvc-b", "test"),
+			},
+			definition: &skupperv2alpha1.SecuredAccess{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "skupper.io/v2alpha1",
+					Kind:       "SecuredAccess",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mysvc",
+					Namespace: "test",
+				},
+				Spec: skupperv2alpha1.SecuredAccessSpec{
+					AccessType: ACCESS_TYPE_GATEWAY_TCP,
+					Selector: map[string]string{
+						"app": "foo",
+					},
+					Ports: []skupperv2alpha1.SecuredAccessPort{
+						{
+							Name:       "a",
+							Port:       8080,
+							TargetPort: 8081,
+							Protocol:   "TCP",
+						},
+						{
+							Name:       "b",
+							Port:       9090,
+							TargetPort: 9191,
+							Protocol:   "TCP",
+						},
+					},
+					Certificate: "my-cert",
+					Issuer:      "skupper-site-ca",
+				},
+			},
+			expectedServices: []*corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mysvc",
+						Namespace: "test",
+					},
+					Spec: corev1.ServiceSpec{
+						Selector: map[string]string{
+							"app": "foo",
+						},
+						Ports: []corev1.ServicePort{
+							{
+								Name:       "a",
+								Port:       8080,
+								TargetPort: intstr.IntOrString{IntVal: int32(8081)},
+								Protocol:   corev1.Protocol("TCP"),
+							},
+							{
+								Name:       "b",
+								Port:       9090,
+								TargetPort: intstr.IntOrString{IntVal: int32(9191)},
+								Protocol:   corev1.Protocol("TCP"),
+							},
+						},
+					},
+				},
+			},
+			expectedCertificates: []MockCertificate{
+				{
+					namespace: "test",
+					name:      "my-cert",
+					ca:        "skupper-site-ca",
+					subject:   "mysvc",
+					hosts:     []string{"10.1.1.10", "mysvc", "mysvc.test"},
+					client:    false,
+					server:    true,
+					refs:      nil,
+				},
+			},
+			expectedStatus: "OK",
+			expectedEndpoints: []skupperv2alpha1.Endpoint{
+				{
+					Name: "a",
+					Port: "8080",
+					Host: "10.1.1.10",
+				},
+				{
+					Name: "b",
+					Port: "9090",
+					Host: "10.1.1.10",
+				},
+			},
+		},
+		{
+			name: "gateway tcp address not resolved",
+			config: Config{
+				EnabledAccessTypes: []string{
+					ACCESS_TYPE_GATEWAY_TCP,
+				},
+				GatewayClass: "xyz",
+			},
+			ssaRecorder: newServerSideApplyRecorder(),
+			expectedSSA: map[string]*unstructured.Unstructured{
+				"test/mysvc":   gateway("mysvc", "test"),
+				"test/mysvc-a": tcproute("mysvc-a", "test"),
+				"test/mysvc-b": tcproute("mysvc-b", "test"),
+			},
+			definition: &skupperv2alpha1.SecuredAccess{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "skupper.io/v2alpha1",
+					Kind:       "SecuredAccess",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mysvc",
+					Namespace: "test",
+				},
+				Spec: skupperv2alpha1.SecuredAccessSpec{
+					AccessType: ACCESS_TYPE_GATEWAY_TCP,
+					Selector: map[string]string{
+						"app": "foo",
+					},
+					Ports: []skupperv2alpha1.SecuredAccessPort{
+						{
+							Name:       "a",
+							Port:       8080,
+							TargetPort: 8081,
+							Protocol:   "TCP",
+						},
+						{
+							Name:       "b",
+							Port:       9090,
+							TargetPort: 9191,
+							Protocol:   "TCP",
+						},
+					},
+					Certificate: "my-cert",
+					Issuer:      "skupper-site-ca",
+				},
+			},
+			expectedServices: []*corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mysvc",
+						Namespace: "test",
+					},
+					Spec: corev1.ServiceSpec{
+						Selector: map[string]string{
+							"app": "foo",
+						},
+						Ports: []corev1.ServicePort{
+							{
+								Name:       "a",
+								Port:       8080,
+								TargetPort: intstr.IntOrString{IntVal: int32(8081)},
+								Protocol:   corev1.Protocol("TCP"),
+							},
+							{
+								Name:       "b",
+								Port:       9090,
+								TargetPort: intstr.IntOrString{IntVal: int32(9191)},
+								Protocol:   corev1.Protocol("TCP"),
+							},
+						},
+					},
+				},
+			},
+			expectedCertificates: []MockCertificate{
+				{
+					namespace: "test",
+					name:      "my-cert",
+					ca:        "skupper-site-ca",
+					subject:   "mysvc",
+					hosts:     []string{"mysvc", "mysvc.test"},
+					client:    false,
+					server:    true,
+					refs:      nil,
+				},
+			},
+			expectedStatus: "Gateway address not yet resolved",
+		},
 	}
 	for _, tt := range testTable {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2000,8 +2287,13 @@ func TestSecuredAccessGeneral(t *testing.T) {
 						assert.Equal(t, desired.GetName(), actual.GetName())
 						assert.Equal(t, desired.GetNamespace(), actual.GetNamespace())
 						assert.Equal(t, desired.GroupVersionKind(), actual.GroupVersionKind())
-						if actual.GroupVersionKind().Kind == "TLSRoute" {
+						switch actual.GroupVersionKind().Kind {
+						case "TLSRoute":
 							m.CheckTlsRoute(actual.GetNamespace()+"/"+actual.GetName(), actual)
+						case "TCPRoute":
+							m.CheckTcpRoute(actual.GetNamespace()+"/"+actual.GetName(), actual)
+						case "VirtualService":
+							m.CheckVirtualService(actual.GetNamespace()+"/"+actual.GetName(), actual)
 						}
 					}
 				}
@@ -3113,6 +3405,34 @@ func tlsroute(name string, namespace string) *unstructured.Unstructured {
 	return obj
 }
 
+func tcproute(name string, namespace string) *unstructured.Unstructured {
+	obj := tlsroute(name, namespace)
+	obj.SetKind("TCPRoute")
+	return obj
+}
+
+func istioGateway(name string, namespace string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1beta1",
+		Kind:    "Gateway",
+	})
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	return obj
+}
+
+func virtualservice(name string, namespace string) *unstructured.Unstructured {
+	obj := tlsroute(name, namespace)
+	obj.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1beta1",
+		Kind:    "VirtualService",
+	})
+	return obj
+}
+
 type ServerSideApplyRecorder struct {
 	objects   map[string]*unstructured.Unstructured
 	modifiers map[string]func(*unstructured.Unstructured)
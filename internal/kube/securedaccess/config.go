@@ -3,6 +3,7 @@ package securedaccess
 import (
 	"flag"
 	"fmt"
+	"strings"
 
 	iflag "github.com/skupperproject/skupper/internal/flag"
 
@@ -15,17 +16,22 @@ const ACCESS_TYPE_NODEPORT = "nodeport"
 const ACCESS_TYPE_INGRESS_NGINX = "ingress-nginx"
 const ACCESS_TYPE_CONTOUR_HTTP_PROXY = "contour-http-proxy"
 const ACCESS_TYPE_GATEWAY = "gateway"
+const ACCESS_TYPE_GATEWAY_TCP = "gateway-tcp"
+const ACCESS_TYPE_ISTIO_GATEWAY = "istio-gateway"
 const ACCESS_TYPE_LOCAL = "local"
 
 type Config struct {
-	EnabledAccessTypes []string
-	DefaultAccessType  string
-	ClusterHost        string
-	IngressDomain      string
-	HttpProxyDomain    string
-	GatewayPort        int
-	GatewayClass       string
-	GatewayDomain      string
+	EnabledAccessTypes   []string
+	DefaultAccessType    string
+	ClusterHost          string
+	IngressDomain        string
+	HttpProxyDomain      string
+	GatewayPort          int
+	GatewayClass         string
+	GatewayDomain        string
+	IstioGatewayPort     int
+	IstioGatewayDomain   string
+	IstioGatewaySelector string
 }
 
 func (c *Config) isEnabled(accessType string) bool {
@@ -50,6 +56,19 @@ func (c *Config) Verify() error {
 	if c.isEnabled("gateway") && c.GatewayClass == "" {
 		return fmt.Errorf("Gateway class must be set to enable gateway access type.")
 	}
+	// if gateway-tcp is in enabled list, check that the class is set
+	if c.isEnabled("gateway-tcp") && c.GatewayClass == "" {
+		return fmt.Errorf("Gateway class must be set to enable gateway-tcp access type.")
+	}
+	// if istio-gateway is in enabled list, check that the domain and selector are set
+	if c.isEnabled("istio-gateway") {
+		if c.IstioGatewayDomain == "" {
+			return fmt.Errorf("Istio gateway domain must be set to enable istio-gateway access type.")
+		}
+		if _, err := c.istioGatewaySelector(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -78,9 +97,31 @@ func BoundConfig(flags *flag.FlagSet) (*Config, error) {
 	iflag.StringVar(flags, &c.GatewayDomain, "gateway-domain", "SKUPPER_GATEWAY_DOMAIN", "", "The domain to use in constructing the fully qualified hostname for TLSRoutes resources. Only used when selecting gateway as an access type.")
 	iflag.StringVar(flags, &c.GatewayClass, "gateway-class", "SKUPPER_GATEWAY_CLASS", "", "The class of Gateway to use. This is required to enable gateway as an access type.")
 	iflag.IntVar(flags, &c.GatewayPort, "gateway-port", "SKUPPER_GATEWAY_PORT", 8443, "The port the Gateway should be configured to listen on. This is only used if gateway is enabled as an access type.")
+	iflag.StringVar(flags, &c.IstioGatewayDomain, "istio-gateway-domain", "SKUPPER_ISTIO_GATEWAY_DOMAIN", "", "The domain to use in constructing the fully qualified hostname for Istio VirtualService resources. This is required to enable istio-gateway as an access type.")
+	iflag.StringVar(flags, &c.IstioGatewaySelector, "istio-gateway-selector", "SKUPPER_ISTIO_GATEWAY_SELECTOR", "istio=ingressgateway", "The label selector (as a comma separated list of key=value pairs) identifying the Istio ingress gateway pods. Only used if istio-gateway is enabled as an access type.")
+	iflag.IntVar(flags, &c.IstioGatewayPort, "istio-gateway-port", "SKUPPER_ISTIO_GATEWAY_PORT", 443, "The port the Istio ingress gateway should be configured to listen on. Only used if istio-gateway is enabled as an access type.")
 	return c, nil
 }
 
+func (c *Config) istioGatewaySelector() (map[string]string, error) {
+	selector := map[string]string{}
+	for _, pair := range strings.Split(c.IstioGatewaySelector, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("Invalid Istio gateway selector %q, expected comma separated key=value pairs.", c.IstioGatewaySelector)
+		}
+		selector[key] = value
+	}
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("Istio gateway selector must be set to enable istio-gateway access type.")
+	}
+	return selector, nil
+}
+
 func defaultEnabledAccessTypes() []string {
 	return []string{
 		"local",
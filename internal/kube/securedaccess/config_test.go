@@ -23,7 +23,9 @@ func Test_BoundConfig(t *testing.T) {
 					"loadbalancer",
 					"route",
 				},
-				GatewayPort: 8443,
+				GatewayPort:          8443,
+				IstioGatewayPort:     443,
+				IstioGatewaySelector: "istio=ingressgateway",
 			},
 		},
 		{
@@ -40,11 +42,13 @@ func Test_BoundConfig(t *testing.T) {
 					"nodeport",
 					"ingress-nginx",
 				},
-				DefaultAccessType: "nodeport",
-				ClusterHost:       "mycluster.org",
-				IngressDomain:     "gateway.ingress.com",
-				HttpProxyDomain:   "gateway.contour.com",
-				GatewayPort:       8443,
+				DefaultAccessType:    "nodeport",
+				ClusterHost:          "mycluster.org",
+				IngressDomain:        "gateway.ingress.com",
+				HttpProxyDomain:      "gateway.contour.com",
+				GatewayPort:          8443,
+				IstioGatewayPort:     443,
+				IstioGatewaySelector: "istio=ingressgateway",
 			},
 		},
 		{
@@ -61,11 +65,34 @@ func Test_BoundConfig(t *testing.T) {
 					"ingress-nginx",
 					"nodeport",
 				},
-				DefaultAccessType: "ingress-nginx",
-				ClusterHost:       "foo.bar.com",
-				IngressDomain:     "baz.com",
-				HttpProxyDomain:   "bif.baf.bof.com",
-				GatewayPort:       8443,
+				DefaultAccessType:    "ingress-nginx",
+				ClusterHost:          "foo.bar.com",
+				IngressDomain:        "baz.com",
+				HttpProxyDomain:      "bif.baf.bof.com",
+				GatewayPort:          8443,
+				IstioGatewayPort:     443,
+				IstioGatewaySelector: "istio=ingressgateway",
+			},
+		},
+		{
+			name: "istio args",
+			args: []string{
+				"--enabled-access-types=istio-gateway,gateway-tcp",
+				"--gateway-class=eg",
+				"--istio-gateway-domain=mesh.example.com",
+				"--istio-gateway-selector=istio=custom-gateway,app=foo",
+				"--istio-gateway-port=15443",
+			},
+			expectedValue: &Config{
+				EnabledAccessTypes: []string{
+					"istio-gateway",
+					"gateway-tcp",
+				},
+				GatewayClass:         "eg",
+				GatewayPort:          8443,
+				IstioGatewayDomain:   "mesh.example.com",
+				IstioGatewaySelector: "istio=custom-gateway,app=foo",
+				IstioGatewayPort:     15443,
 			},
 		},
 	}
@@ -150,6 +177,56 @@ func Test_Verify(t *testing.T) {
 			},
 			expectedError: "Gateway class must be set to enable gateway access type.",
 		},
+		{
+			name: "gateway-tcp class not configured",
+			config: &Config{
+				EnabledAccessTypes: []string{
+					"gateway-tcp",
+				},
+			},
+			expectedError: "Gateway class must be set to enable gateway-tcp access type.",
+		},
+		{
+			name: "istio gateway domain not configured",
+			config: &Config{
+				EnabledAccessTypes: []string{
+					"istio-gateway",
+				},
+				IstioGatewaySelector: "istio=ingressgateway",
+			},
+			expectedError: "Istio gateway domain must be set to enable istio-gateway access type.",
+		},
+		{
+			name: "istio gateway selector invalid",
+			config: &Config{
+				EnabledAccessTypes: []string{
+					"istio-gateway",
+				},
+				IstioGatewayDomain:   "example.com",
+				IstioGatewaySelector: "istio",
+			},
+			expectedError: "Invalid Istio gateway selector \"istio\", expected comma separated key=value pairs.",
+		},
+		{
+			name: "istio gateway selector empty",
+			config: &Config{
+				EnabledAccessTypes: []string{
+					"istio-gateway",
+				},
+				IstioGatewayDomain: "example.com",
+			},
+			expectedError: "Istio gateway selector must be set to enable istio-gateway access type.",
+		},
+		{
+			name: "istio gateway configured",
+			config: &Config{
+				EnabledAccessTypes: []string{
+					"istio-gateway",
+				},
+				IstioGatewayDomain:   "example.com",
+				IstioGatewaySelector: "istio=ingressgateway",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
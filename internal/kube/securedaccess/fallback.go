@@ -0,0 +1,97 @@
+package securedaccess
+
+import (
+	"log"
+	"slices"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/kube/watchers"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+const defaultResolutionTimeout = 2 * time.Minute
+
+// CallbackScheduler is implemented by the EventProcessor and is used
+// to recheck resolution once the timeout for an access type has
+// expired.
+type CallbackScheduler interface {
+	CallbackAfter(delay time.Duration, callback watchers.Callback, context string)
+}
+
+// Allows a CallbackScheduler to be set for this SecuredAccessManager.
+func (m *SecuredAccessManager) SetCallbackScheduler(scheduler CallbackScheduler) {
+	m.scheduler = scheduler
+}
+
+// selectedAccessType returns the access type from the fallback chain
+// that is currently in use, i.e. the one recorded in status if that
+// is still part of the chain, otherwise the first.
+func selectedAccessType(sa *skupperv2alpha1.SecuredAccess) string {
+	if slices.Contains(sa.Spec.AccessTypes, sa.Status.AccessType) {
+		return sa.Status.AccessType
+	}
+	return sa.Spec.AccessTypes[0]
+}
+
+func resolutionTimeout(sa *skupperv2alpha1.SecuredAccess) time.Duration {
+	if sa.Spec.ResolutionTimeout == "" {
+		return defaultResolutionTimeout
+	}
+	timeout, err := time.ParseDuration(sa.Spec.ResolutionTimeout)
+	if err != nil || timeout < 0 {
+		log.Printf("Invalid resolution timeout %q for SecuredAccess %s, using %s", sa.Spec.ResolutionTimeout, sa.Key(), defaultResolutionTimeout)
+		return defaultResolutionTimeout
+	}
+	return timeout
+}
+
+// nextAccessType returns the access type to fall back to if the
+// current one has not resolved within the timeout, or is not
+// enabled at all. If no fallback is (yet) required, it returns the
+// empty string.
+func (m *SecuredAccessManager) nextAccessType(sa *skupperv2alpha1.SecuredAccess, resolved bool) string {
+	key := sa.Key()
+	if resolved || len(sa.Spec.AccessTypes) < 2 {
+		delete(m.resolutionStarted, key)
+		return ""
+	}
+	current := m.actualAccessType(sa)
+	index := slices.Index(sa.Spec.AccessTypes, current)
+	if index+1 >= len(sa.Spec.AccessTypes) {
+		delete(m.resolutionStarted, key)
+		return ""
+	}
+	next := sa.Spec.AccessTypes[index+1]
+	if !m.IsValidAccessType(current) {
+		delete(m.resolutionStarted, key)
+		return next
+	}
+	timeout := resolutionTimeout(sa)
+	started, ok := m.resolutionStarted[key]
+	if !ok {
+		m.resolutionStarted[key] = time.Now()
+		m.recheckAfter(key, timeout)
+		return ""
+	}
+	if time.Since(started) < timeout {
+		return ""
+	}
+	delete(m.resolutionStarted, key)
+	return next
+}
+
+func (m *SecuredAccessManager) recheckAfter(key string, delay time.Duration) {
+	if m.scheduler == nil {
+		log.Printf("No scheduler set, SecuredAccess %s will not fall back until it is next updated", key)
+		return
+	}
+	m.scheduler.CallbackAfter(delay, m.recheck, key)
+}
+
+func (m *SecuredAccessManager) recheck(key string) error {
+	sa, ok := m.definitions[key]
+	if !ok {
+		return nil
+	}
+	return m.reconcile(sa)
+}
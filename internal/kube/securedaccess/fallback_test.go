@@ -0,0 +1,156 @@
+package securedaccess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"github.com/skupperproject/skupper/internal/kube/watchers"
+)
+
+type scheduledCallback struct {
+	delay    time.Duration
+	callback watchers.Callback
+	context  string
+}
+
+// fakeScheduler records callbacks to be fired by the test
+type fakeScheduler struct {
+	scheduled []scheduledCallback
+}
+
+func (s *fakeScheduler) CallbackAfter(delay time.Duration, callback watchers.Callback, context string) {
+	s.scheduled = append(s.scheduled, scheduledCallback{delay: delay, callback: callback, context: context})
+}
+
+// fire invokes the callbacks scheduled so far, as the EventProcessor
+// would once their delay has passed.
+func (s *fakeScheduler) fire() error {
+	pending := s.scheduled
+	s.scheduled = nil
+	for _, c := range pending {
+		if err := c.callback(c.context); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAccessTypeFallback(t *testing.T) {
+	testTable := []struct {
+		name                string
+		enabled             []string
+		accessTypes         []string
+		resolutionTimeout   string
+		elapsed             time.Duration
+		expectedAccessType  string
+		expectedServiceType corev1.ServiceType
+		expectedResolved    bool
+		expectedDelay       time.Duration
+	}{
+		{
+			name:                "timeout not yet expired",
+			enabled:             []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			accessTypes:         []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_LOCAL},
+			expectedAccessType:  ACCESS_TYPE_LOADBALANCER,
+			expectedServiceType: corev1.ServiceTypeLoadBalancer,
+			expectedDelay:       defaultResolutionTimeout,
+		},
+		{
+			name:               "default timeout expired",
+			enabled:            []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			accessTypes:        []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_LOCAL},
+			elapsed:            defaultResolutionTimeout,
+			expectedAccessType: ACCESS_TYPE_LOCAL,
+			expectedResolved:   true,
+			expectedDelay:      defaultResolutionTimeout,
+		},
+		{
+			name:                "custom timeout expired",
+			enabled:             []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			accessTypes:         []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			resolutionTimeout:   "10s",
+			elapsed:             10 * time.Second,
+			expectedAccessType:  ACCESS_TYPE_NODEPORT,
+			expectedServiceType: corev1.ServiceTypeNodePort,
+			expectedDelay:       10 * time.Second,
+		},
+		{
+			name:                "custom timeout not expired",
+			enabled:             []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			accessTypes:         []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			resolutionTimeout:   "1h",
+			elapsed:             defaultResolutionTimeout,
+			expectedAccessType:  ACCESS_TYPE_LOADBALANCER,
+			expectedServiceType: corev1.ServiceTypeLoadBalancer,
+			expectedDelay:       time.Hour,
+		},
+		{
+			name:               "disabled access types skipped",
+			enabled:            []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_LOCAL},
+			accessTypes:        []string{ACCESS_TYPE_ROUTE, ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOCAL},
+			expectedAccessType: ACCESS_TYPE_LOCAL,
+			expectedResolved:   true,
+		},
+		{
+			name:                "last access type retained",
+			enabled:             []string{ACCESS_TYPE_LOADBALANCER, ACCESS_TYPE_NODEPORT},
+			accessTypes:         []string{ACCESS_TYPE_NODEPORT, ACCESS_TYPE_LOADBALANCER},
+			elapsed:             defaultResolutionTimeout,
+			expectedAccessType:  ACCESS_TYPE_LOADBALANCER,
+			expectedServiceType: corev1.ServiceTypeLoadBalancer,
+			expectedDelay:       defaultResolutionTimeout,
+		},
+		{
+			name:               "resolved access type retained",
+			enabled:            []string{ACCESS_TYPE_LOCAL, ACCESS_TYPE_LOADBALANCER},
+			accessTypes:        []string{ACCESS_TYPE_LOCAL, ACCESS_TYPE_LOADBALANCER},
+			elapsed:            defaultResolutionTimeout,
+			expectedAccessType: ACCESS_TYPE_LOCAL,
+			expectedResolved:   true,
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			sa := securedAccess("mysvc", "test", selector(), securedAccessPorts())
+			sa.Spec.AccessTypes = tt.accessTypes
+			sa.Spec.ResolutionTimeout = tt.resolutionTimeout
+			client, err := fakeclient.NewFakeClient("test", nil, []runtime.Object{sa}, "")
+			assert.Assert(t, err)
+			config := &Config{
+				EnabledAccessTypes: tt.enabled,
+			}
+			scheduler := &fakeScheduler{}
+			m := NewSecuredAccessManager(client, newMockCertificateManager(), config, &FakeControllerContext{namespace: "test"})
+			m.SetCallbackScheduler(scheduler)
+			assert.Assert(t, m.SecuredAccessChanged("test/mysvc", sa))
+			if tt.expectedDelay > 0 {
+				assert.Equal(t, len(scheduler.scheduled), 1)
+				assert.Equal(t, scheduler.scheduled[0].delay, tt.expectedDelay)
+				assert.Equal(t, scheduler.scheduled[0].context, "test/mysvc")
+			} else {
+				assert.Equal(t, len(scheduler.scheduled), 0)
+			}
+			if tt.elapsed > 0 {
+				if _, ok := m.resolutionStarted["test/mysvc"]; ok {
+					m.resolutionStarted["test/mysvc"] = time.Now().Add(-tt.elapsed)
+				}
+				assert.Assert(t, scheduler.fire())
+			}
+
+			actual, err := client.GetSkupperClient().SkupperV2alpha1().SecuredAccesses("test").Get(context.Background(), "mysvc", metav1.GetOptions{})
+			assert.Assert(t, err)
+			assert.Equal(t, actual.Status.AccessType, tt.expectedAccessType)
+			assert.Equal(t, len(actual.Status.Endpoints) > 0, tt.expectedResolved)
+			svc, err := client.GetKubeClient().CoreV1().Services("test").Get(context.Background(), "mysvc", metav1.GetOptions{})
+			assert.Assert(t, err)
+			assert.Equal(t, svc.Spec.Type, tt.expectedServiceType)
+		})
+	}
+}
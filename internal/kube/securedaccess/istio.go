@@ -0,0 +1,131 @@
+package securedaccess
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/skupperproject/skupper/internal/kube/resource"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+//go:embed istio-gateway.yaml
+var istioGatewayTemplate string
+
+type IstioGatewayParameters struct {
+	Name     string
+	Selector map[string]string
+	Port     int
+	Domain   string
+}
+
+//go:embed istio-virtual-service.yaml
+var istioVirtualServiceTemplate string
+
+type IstioVirtualServiceParameters struct {
+	Name             string
+	GatewayName      string
+	GatewayNamespace string
+	GatewayPort      int
+	OwnerUID         string
+	Hostname         string
+	ServiceName      string
+	ServiceNamespace string
+	ServicePort      int
+	Labels           map[string]string
+	Annotations      map[string]string
+}
+
+// IstioGatewayAccessType exposes SecuredAccess ports through an
+// Istio ingress gateway, using a Gateway configured for TLS
+// passthrough on a single port and a VirtualService per port that
+// routes on the SNI hostname.
+type IstioGatewayAccessType struct {
+	manager          *SecuredAccessManager
+	selector         map[string]string
+	domain           string
+	port             int
+	gatewayNamespace string
+}
+
+func newIstioGatewayAccess(manager *SecuredAccessManager, config *Config, context ControllerContext) (AccessType, func() error, error) {
+	selector, err := config.istioGatewaySelector()
+	if err != nil {
+		return nil, nil, err
+	}
+	at := &IstioGatewayAccessType{
+		manager:  manager,
+		selector: selector,
+		domain:   config.IstioGatewayDomain,
+		port:     config.IstioGatewayPort,
+	}
+	if context != nil {
+		at.gatewayNamespace = context.Namespace()
+	}
+	if err := at.init(); err != nil {
+		return nil, nil, err
+	}
+	return at, at.init, nil
+}
+
+func (o *IstioGatewayAccessType) init() error {
+	template := resource.Template{
+		Name:     "istio-gateway",
+		Template: istioGatewayTemplate,
+		Parameters: IstioGatewayParameters{
+			Name:     "skupper",
+			Selector: o.selector,
+			Port:     o.port,
+			Domain:   o.domain,
+		},
+		Resource: resource.IstioGatewayResource(),
+	}
+	_, err := template.Apply(o.manager.clients.GetDynamicClient(), context.Background(), o.gatewayNamespace)
+	return err
+}
+
+func (o *IstioGatewayAccessType) RealiseAndResolve(access *skupperv2alpha1.SecuredAccess, svc *corev1.Service) ([]skupperv2alpha1.Endpoint, error) {
+	var endpoints []skupperv2alpha1.Endpoint
+	for _, port := range access.Spec.Ports {
+		name := fmt.Sprintf("%s-%s", access.Name, port.Name)
+		hostname := fmt.Sprintf("%s.%s.%s", name, access.Namespace, o.domain)
+		var labels map[string]string
+		var annotations map[string]string
+		if o.manager.context != nil {
+			labels = map[string]string{}
+			annotations = map[string]string{}
+			o.manager.context.SetLabels(access.Namespace, name, "VirtualService", labels)
+			o.manager.context.SetAnnotations(access.Namespace, name, "VirtualService", annotations)
+		}
+		template := resource.Template{
+			Name:     "virtualservice",
+			Template: istioVirtualServiceTemplate,
+			Parameters: IstioVirtualServiceParameters{
+				Name:             name,
+				GatewayName:      "skupper",
+				GatewayNamespace: o.gatewayNamespace,
+				GatewayPort:      o.port,
+				OwnerUID:         string(access.ObjectMeta.UID),
+				Hostname:         hostname,
+				ServiceName:      access.Name,
+				ServiceNamespace: access.Namespace,
+				ServicePort:      port.Port,
+				Labels:           labels,
+				Annotations:      annotations,
+			},
+			Resource: resource.IstioVirtualServiceResource(),
+		}
+		if _, err := template.Apply(o.manager.clients.GetDynamicClient(), context.Background(), access.Namespace); err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, skupperv2alpha1.Endpoint{
+			Name: port.Name,
+			Host: hostname,
+			Port: strconv.Itoa(o.port),
+		})
+	}
+	return endpoints, nil
+}
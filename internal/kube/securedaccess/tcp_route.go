@@ -0,0 +1,142 @@
+package securedaccess
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/skupperproject/skupper/internal/kube/resource"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+//go:embed tcp-gateway.yaml
+var tcpGatewayTemplate string
+
+type TcpGatewayParameters struct {
+	Name        string
+	Class       string
+	OwnerUID    string
+	Ports       []skupperv2alpha1.SecuredAccessPort
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+//go:embed tcp-route.yaml
+var tcpRouteTemplate string
+
+type TcpRouteParameters struct {
+	Name        string
+	GatewayName string
+	SectionName string
+	OwnerUID    string
+	ServiceName string
+	ServicePort int
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// TcpRouteAccessType exposes SecuredAccess ports through a Gateway
+// API implementation that does not support TLS passthrough. As plain
+// TCP cannot be routed on hostname, each SecuredAccess gets its own
+// Gateway with a listener per port.
+type TcpRouteAccessType struct {
+	manager *SecuredAccessManager
+	class   string
+}
+
+func newTcpRouteAccess(manager *SecuredAccessManager, class string) AccessType {
+	return &TcpRouteAccessType{
+		manager: manager,
+		class:   class,
+	}
+}
+
+func (o *TcpRouteAccessType) RealiseAndResolve(access *skupperv2alpha1.SecuredAccess, svc *corev1.Service) ([]skupperv2alpha1.Endpoint, error) {
+	labels, annotations := o.labelsAndAnnotations(access.Namespace, access.Name, "Gateway")
+	template := resource.Template{
+		Name:     "tcpgateway",
+		Template: tcpGatewayTemplate,
+		Parameters: TcpGatewayParameters{
+			Name:        access.Name,
+			Class:       o.class,
+			OwnerUID:    string(access.ObjectMeta.UID),
+			Ports:       access.Spec.Ports,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Resource: resource.GatewayResource(),
+	}
+	gateway, err := template.Apply(o.manager.clients.GetDynamicClient(), context.Background(), access.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, port := range access.Spec.Ports {
+		name := fmt.Sprintf("%s-%s", access.Name, port.Name)
+		labels, annotations := o.labelsAndAnnotations(access.Namespace, name, "TcpRoute")
+		template := resource.Template{
+			Name:     "tcproute",
+			Template: tcpRouteTemplate,
+			Parameters: TcpRouteParameters{
+				Name:        name,
+				GatewayName: access.Name,
+				SectionName: port.Name,
+				OwnerUID:    string(access.ObjectMeta.UID),
+				ServiceName: access.Name,
+				ServicePort: port.Port,
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Resource: resource.TcpRouteResource(),
+		}
+		if _, err := template.Apply(o.manager.clients.GetDynamicClient(), context.Background(), access.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	host := getGatewayAddress(gateway)
+	if host == "" {
+		return nil, errors.New("Gateway address not yet resolved")
+	}
+	var endpoints []skupperv2alpha1.Endpoint
+	for _, port := range access.Spec.Ports {
+		endpoints = append(endpoints, skupperv2alpha1.Endpoint{
+			Name: port.Name,
+			Host: host,
+			Port: strconv.Itoa(port.Port),
+		})
+	}
+	return endpoints, nil
+}
+
+func (o *TcpRouteAccessType) labelsAndAnnotations(namespace string, name string, kind string) (map[string]string, map[string]string) {
+	if o.manager.context == nil {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	annotations := map[string]string{}
+	o.manager.context.SetLabels(namespace, name, kind, labels)
+	o.manager.context.SetAnnotations(namespace, name, kind, annotations)
+	return labels, annotations
+}
+
+func getGatewayAddress(obj *unstructured.Unstructured) string {
+	if obj == nil {
+		return ""
+	}
+	addresses, _, _ := unstructured.NestedSlice(obj.UnstructuredContent(), "status", "addresses")
+	for _, addressType := range []string{"Hostname", "IPAddress"} {
+		for _, a := range addresses {
+			if address, ok := a.(map[string]interface{}); ok {
+				value, _, _ := unstructured.NestedString(address, "value")
+				if t, _, _ := unstructured.NestedString(address, "type"); t == addressType && value != "" {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
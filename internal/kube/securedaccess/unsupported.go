@@ -20,6 +20,6 @@ func newUnsupportedAccess(m *SecuredAccessManager) AccessType {
 }
 
 func (o *UnsupportedAccessType) RealiseAndResolve(access *skupperv2alpha1.SecuredAccess, service *corev1.Service) ([]skupperv2alpha1.Endpoint, error) {
-	log.Printf("Unsupported access type %q in SecuredAccess %s/%s", access.Spec.AccessType, access.Namespace, access.Name)
+	log.Printf("Unsupported access type %q in SecuredAccess %s/%s", o.manager.actualAccessType(access), access.Namespace, access.Name)
 	return nil, errors.New("unsupported access type")
 }
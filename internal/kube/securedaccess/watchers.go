@@ -18,6 +18,9 @@ type SecuredAccessResourceWatcher struct {
 	ingressWatcher       *watchers.IngressWatcher
 	httpProxyWatcher     *watchers.DynamicWatcher
 	tlsRouteWatcher      *watchers.DynamicWatcher
+	tcpRouteWatcher      *watchers.DynamicWatcher
+	tcpGatewayWatcher    *watchers.DynamicWatcher
+	virtualSvcWatcher    *watchers.DynamicWatcher
 	securedAccessWatcher *watchers.SecuredAccessWatcher
 }
 
@@ -33,10 +36,14 @@ func (m *SecuredAccessResourceWatcher) WatchResources(processor *watchers.EventP
 	m.routeWatcher = processor.WatchRoutes(routeSecuredAccess(), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckRoute))
 	m.httpProxyWatcher = processor.WatchContourHttpProxies(dynamicSecuredAccess(), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckHttpProxy))
 	m.tlsRouteWatcher = processor.WatchTlsRoutes(dynamicSecuredAccess(), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckTlsRoute))
+	m.tcpRouteWatcher = processor.WatchTcpRoutes(dynamicSecuredAccess(), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckTcpRoute))
+	m.tcpGatewayWatcher = processor.WatchGateways(dynamicSecuredAccess(), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckTcpGateway))
+	m.virtualSvcWatcher = processor.WatchIstioVirtualServices(dynamicSecuredAccess(), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckVirtualService))
 }
 
 func (m *SecuredAccessResourceWatcher) WatchGateway(processor *watchers.EventProcessor, namespace string) {
 	processor.WatchGateways(dynamicByName("skupper"), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckGateway))
+	processor.WatchIstioGateways(dynamicByName("skupper"), namespace, watchers.FilterByNamespace(m.isControlledResource, m.accessMgr.CheckIstioGateway))
 }
 
 func (m *SecuredAccessResourceWatcher) WatchSecuredAccesses(processor *watchers.EventProcessor, namespace string, handler watchers.SecuredAccessHandler) {
@@ -91,6 +98,30 @@ func (m *SecuredAccessResourceWatcher) Recover() {
 			m.accessMgr.RecoverTlsRoute(route)
 		}
 	}
+	if m.tcpRouteWatcher != nil {
+		for _, route := range m.tcpRouteWatcher.List() {
+			if !m.isControlledResource(route.GetNamespace()) {
+				continue
+			}
+			m.accessMgr.RecoverTcpRoute(route)
+		}
+	}
+	if m.tcpGatewayWatcher != nil {
+		for _, gateway := range m.tcpGatewayWatcher.List() {
+			if !m.isControlledResource(gateway.GetNamespace()) {
+				continue
+			}
+			m.accessMgr.RecoverTcpGateway(gateway)
+		}
+	}
+	if m.virtualSvcWatcher != nil {
+		for _, vs := range m.virtualSvcWatcher.List() {
+			if !m.isControlledResource(vs.GetNamespace()) {
+				continue
+			}
+			m.accessMgr.RecoverVirtualService(vs)
+		}
+	}
 	//once all resources are recovered, can process definitions
 	for _, sa := range m.securedAccessWatcher.List() {
 		if !m.isControlledResource(sa.Namespace) {
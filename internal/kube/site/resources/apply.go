@@ -176,7 +176,7 @@ func Apply(clients internalclient.Clients, ctx context.Context, site *skupperv2a
 }
 
 func enableAntiAffinity(site *skupperv2alpha1.Site) bool {
-	return site.Spec.HA && !getValueAsBool(site.Spec.Settings, "disable-anti-affinity")
+	return site.GetRouterReplicas() > 1 && !getValueAsBool(site.Spec.Settings, "disable-anti-affinity")
 }
 
 func getValueAsBool(settings map[string]string, key string) bool {
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	internalnetwork "github.com/skupperproject/skupper/internal/network"
 	corev1 "k8s.io/api/core/v1"
@@ -54,6 +56,7 @@ type Site struct {
 	certs         certificates.CertificateManager
 	access        SecuredAccessFactory
 	sizes         *sizing.Registry
+	autoscaler    sizing.Autoscaler
 	routerPods    map[string]*corev1.Pod
 	logger        *slog.Logger
 	currentGroups []string
@@ -168,7 +171,7 @@ func (s *Site) reconcile(siteDef *skupperv2alpha1.Site, inRecovery bool) error {
 		s.setBindingsConfiguredStatus(nil)
 		s.checkSecuredAccess()
 	} else if len(s.currentGroups) != len(s.groups()) {
-		s.logger.Info("EnableHA setting changed for site",
+		s.logger.Info("Router groups changed for site",
 			slog.String("namespace", siteDef.Namespace),
 			slog.String("name", siteDef.Name),
 			slog.String("latest", strings.Join(s.groups(), ",")),
@@ -215,6 +218,10 @@ func (s *Site) reconcile(siteDef *skupperv2alpha1.Site, inRecovery bool) error {
 	}
 
 	// 3. deployment
+	return s.applyRouterResources(ctxt)
+}
+
+func (s *Site) applyRouterResources(ctxt context.Context) error {
 	size, err := s.sizes.GetSizing(s.site)
 	if err != nil {
 		s.logger.Info("Did not retrieve size for site",
@@ -261,10 +268,26 @@ func (s *Site) initialRouterConfig() *qdr.RouterConfig {
 }
 
 func (s *Site) groups() []string {
+	groups := []string{"skupper-router"}
+	for i := 2; i <= s.site.GetRouterReplicas(); i++ {
+		groups = append(groups, fmt.Sprintf("skupper-router-%d", i))
+	}
+	return groups
+}
+
+// targetSpread returns the update that limits the pod targets
+// configured on the given router group to those assigned to it. With
+// HA enabled, every target is configured on two groups.
+func (s *Site) targetSpread(group string) qdr.ConfigUpdate {
+	groups := s.groups()
+	redundancy := 1
 	if s.site.Spec.HA {
-		return []string{"skupper-router", "skupper-router-2"}
-	} else {
-		return []string{"skupper-router"}
+		redundancy = 2
+	}
+	return site.TargetSpread{
+		Group:      slices.Index(groups, group),
+		Groups:     len(groups),
+		Redundancy: redundancy,
 	}
 }
 
@@ -468,7 +491,7 @@ func (s *Site) Apply(config *qdr.RouterConfig) bool {
 		updated = true
 		config.Metadata.Mode = mode
 	}
-	if dcc := s.site.Spec.GetRouterDataConnectionCount(); config.Metadata.DataConnectionCount != dcc {
+	if dcc := s.routerDataConnectionCount(); config.Metadata.DataConnectionCount != dcc {
 		updated = true
 		config.Metadata.DataConnectionCount = dcc
 	}
@@ -684,7 +707,7 @@ func (s *Site) recoverRouterConfig(update bool) ([]*qdr.RouterConfig, error) {
 	for i, group := range groups {
 		if config, ok := byName[group]; ok {
 			if update {
-				op := ConfigUpdateList{s.bindings, s, s.linkAccess.DesiredConfig(groups[:i], SSL_PROFILE_PATH)}
+				op := ConfigUpdateList{s.bindings, s, s.linkAccess.DesiredConfig(groups[:i], SSL_PROFILE_PATH), s.targetSpread(group)}
 				if err := kubeqdr.UpdateRouterConfig(s.clients.GetKubeClient(), group, s.namespace, context.TODO(), op, s.labelling); err != nil {
 					s.logger.Error("Failed to update router config map",
 						slog.String("namespace", s.namespace),
@@ -698,6 +721,7 @@ func (s *Site) recoverRouterConfig(update bool) ([]*qdr.RouterConfig, error) {
 			routerConfig := s.initialRouterConfig()
 			s.bindings.Apply(routerConfig)
 			s.linkAccess.DesiredConfig(groups[:i], SSL_PROFILE_PATH).Apply(routerConfig)
+			s.targetSpread(group).Apply(routerConfig)
 			if err := s.createRouterConfigForGroup(group, routerConfig); err != nil {
 				s.logger.Error("Failed to create router config map",
 					slog.String("namespace", s.namespace),
@@ -804,7 +828,8 @@ func (s *Site) updateRouterConfigForGroup(update qdr.ConfigUpdate, group string)
 	if !s.initialised {
 		return nil
 	}
-	if err := kubeqdr.UpdateRouterConfig(s.clients.GetKubeClient(), group, s.namespace, context.TODO(), update, s.labelling); err != nil {
+	op := ConfigUpdateList{update, s.targetSpread(group)}
+	if err := kubeqdr.UpdateRouterConfig(s.clients.GetKubeClient(), group, s.namespace, context.TODO(), op, s.labelling); err != nil {
 		return err
 	}
 	return nil
@@ -1074,6 +1099,18 @@ func (s *Site) updateResolved() error {
 	return nil
 }
 
+// routerDataConnectionCount returns the value explicitly configured
+// for the site if there is one, else that specified by its size.
+func (s *Site) routerDataConnectionCount() string {
+	if dcc := s.site.Spec.GetRouterDataConnectionCount(); dcc != "" {
+		return dcc
+	}
+	if size, err := s.sizes.GetSizing(s.site); err == nil {
+		return size.RouterDataConnectionCount
+	}
+	return ""
+}
+
 func (s *Site) updateSiteStatus() error {
 	updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Sites(s.site.ObjectMeta.Namespace).UpdateStatus(context.TODO(), s.site, metav1.UpdateOptions{})
 	if err != nil {
@@ -1098,6 +1135,42 @@ func (s *Site) CheckSslProfiles(config *qdr.RouterConfig) error {
 	return nil
 }
 
+// LoadUpdated is called with the latest network status for sites
+// whose size is selected automatically. If the load on the site's
+// routers calls for a different size, the decision is recorded in
+// the site status and the router resources are updated to match.
+func (s *Site) LoadUpdated(status internalnetwork.NetworkStatusInfo) error {
+	if s.site == nil || !sizing.IsAutoscaled(s.site) {
+		return nil
+	}
+	load := internalnetwork.GetSiteLoad(s.site.GetSiteId(), status)
+	decision, changed := s.autoscaler.Evaluate(s.site, s.sizes.OrderedSizes(), load, time.Now())
+	if !changed {
+		return nil
+	}
+	previous := ""
+	if s.site.Status.Sizing != nil {
+		previous = s.site.Status.Sizing.Size
+	}
+	s.logger.Info("Selected size for site",
+		slog.String("namespace", s.site.Namespace),
+		slog.String("name", s.site.Name),
+		slog.String("size", decision.Size),
+		slog.String("previous", previous),
+		slog.String("reason", decision.Reason),
+		slog.Uint64("flowRate", load.FlowRate),
+		slog.Uint64("octetRate", load.OctetRate),
+	)
+	s.site.Status.Sizing = decision
+	if err := s.updateSiteStatus(); err != nil {
+		return err
+	}
+	if err := s.updateRouterConfig(s); err != nil {
+		return err
+	}
+	return s.applyRouterResources(context.TODO())
+}
+
 func (s *Site) NetworkStatusUpdated(network []skupperv2alpha1.SiteRecord) error {
 	if s.site == nil || reflect.DeepEqual(s.site.Status.Network, network) {
 		return nil
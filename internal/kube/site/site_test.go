@@ -2,22 +2,31 @@ package site
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/skupperproject/skupper/internal/kube/certificates"
 	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
 	"github.com/skupperproject/skupper/internal/kube/securedaccess"
+	"github.com/skupperproject/skupper/internal/kube/site/sizing"
 	"github.com/skupperproject/skupper/internal/kube/watchers"
+	internalnetwork "github.com/skupperproject/skupper/internal/network"
 	"github.com/skupperproject/skupper/internal/qdr"
 	site1 "github.com/skupperproject/skupper/internal/site"
 	"github.com/skupperproject/skupper/internal/version"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"log/slog"
 )
 
@@ -781,6 +790,76 @@ func TestSite_CheckRouterAccess(t *testing.T) {
 	}
 }
 
+func TestSite_RouterReplicas(t *testing.T) {
+	tests := []struct {
+		name           string
+		ha             bool
+		routerReplicas int
+		expectedGroups []string
+	}{
+		{
+			name:           "default",
+			expectedGroups: []string{"skupper-router"},
+		},
+		{
+			name:           "ha",
+			ha:             true,
+			expectedGroups: []string{"skupper-router", "skupper-router-2"},
+		},
+		{
+			name:           "replicas",
+			routerReplicas: 4,
+			expectedGroups: []string{"skupper-router", "skupper-router-2", "skupper-router-3", "skupper-router-4"},
+		},
+		{
+			name:           "ha with more replicas",
+			ha:             true,
+			routerReplicas: 3,
+			expectedGroups: []string{"skupper-router", "skupper-router-2", "skupper-router-3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSiteMocks("test", nil, nil, "", false)
+			assert.Assert(t, err)
+			s.site.Spec.HA = tt.ha
+			s.site.Spec.RouterReplicas = tt.routerReplicas
+			s.linkAccess["skupper-router"] = &skupperv2alpha1.RouterAccess{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "skupper-router",
+					Namespace: "test",
+				},
+				Spec: skupperv2alpha1.RouterAccessSpec{
+					TlsCredentials: "skupper-site-server",
+					Roles: []skupperv2alpha1.RouterAccessRole{
+						{
+							Name: "inter-router",
+							Port: 55671,
+						},
+					},
+				},
+			}
+			assert.DeepEqual(t, s.groups(), tt.expectedGroups)
+			_, err = s.recoverRouterConfig(false)
+			assert.Assert(t, err)
+			for i, group := range tt.expectedGroups {
+				cm, err := s.clients.GetKubeClient().CoreV1().ConfigMaps("test").Get(context.Background(), group, metav1.GetOptions{})
+				assert.Assert(t, err)
+				config, err := qdr.GetRouterConfigFromConfigMap(cm)
+				assert.Assert(t, err)
+				// each group links to all the groups before it
+				assert.Equal(t, len(config.Connectors), i)
+				for _, target := range tt.expectedGroups[:i] {
+					connector, ok := config.Connectors[target]
+					assert.Assert(t, ok, "no connector to %s from %s", target, group)
+					assert.Equal(t, connector.Host, target)
+					assert.Equal(t, connector.Port, "55671")
+				}
+			}
+		})
+	}
+}
+
 func Test_NetworkStatusUpdate(t *testing.T) {
 	type args struct {
 		siteRecord []skupperv2alpha1.SiteRecord
@@ -881,6 +960,136 @@ func Test_NetworkStatusUpdate(t *testing.T) {
 	}
 }
 
+func TestSite_LoadUpdated(t *testing.T) {
+	sizeConfig := func(name string, cpu string, dcc string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Labels: map[string]string{
+					sizing.SiteSizingLabel: name,
+				},
+			},
+			Data: map[string]string{
+				"router-cpu-request":           cpu,
+				"router-data-connection-count": dcc,
+			},
+		}
+	}
+	status := func(flowRate uint64) internalnetwork.NetworkStatusInfo {
+		return internalnetwork.NetworkStatusInfo{
+			SiteStatus: []internalnetwork.SiteStatusInfo{
+				{
+					Site: internalnetwork.SiteInfo{Identity: "8a96ffdf-403b-4e4a-83a8-97d3d459adb6"},
+					RouterStatus: []internalnetwork.RouterStatusInfo{
+						{
+							Listeners: []internalnetwork.ListenerInfo{
+								{Name: "backend", FlowRate: flowRate},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		settings     map[string]string
+		flowRate     uint64
+		expectedSize string
+		expectedCpu  string
+		expectedDcc  string
+	}{
+		{
+			name:     "not autoscaled",
+			settings: map[string]string{"size": "small"},
+			flowRate: 1000,
+		},
+		{
+			name:         "low load",
+			settings:     map[string]string{"size": "auto"},
+			flowRate:     10,
+			expectedSize: "small",
+			expectedCpu:  "500m",
+			expectedDcc:  "2",
+		},
+		{
+			name:         "high load",
+			settings:     map[string]string{"size": "auto"},
+			flowRate:     1000,
+			expectedSize: "large",
+			expectedCpu:  "2",
+			expectedDcc:  "8",
+		},
+		{
+			name:         "high load limited by max size",
+			settings:     map[string]string{"size": "auto", "autoscale-max-size": "small"},
+			flowRate:     1000,
+			expectedSize: "small",
+			expectedCpu:  "500m",
+			expectedDcc:  "2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSiteMocks("test", nil, nil, "", false)
+			assert.Assert(t, err)
+			s.sizes.Update("test/small", sizeConfig("small", "500m", "2"))
+			s.sizes.Update("test/large", sizeConfig("large", "2", "8"))
+			s.site.Spec.Settings = tt.settings
+			assert.Assert(t, createRouterConfigMock(s))
+			s.initialised = true
+			deployments := recordAppliedDeployments(s.clients.GetDynamicClient())
+
+			assert.Assert(t, s.LoadUpdated(status(tt.flowRate)))
+			if tt.expectedSize == "" {
+				assert.Assert(t, s.site.Status.Sizing == nil)
+				return
+			}
+			assert.Assert(t, s.site.Status.Sizing != nil)
+			assert.Equal(t, s.site.Status.Sizing.Size, tt.expectedSize)
+			assert.Equal(t, s.site.Status.Sizing.FlowRate, int64(tt.flowRate))
+
+			deployment, ok := deployments["skupper-router"]
+			assert.Assert(t, ok, "router deployment not applied")
+			router := deployment.Spec.Template.Spec.Containers[0]
+			assert.Equal(t, router.Resources.Requests.Cpu().String(), tt.expectedCpu)
+
+			cm, err := s.clients.GetKubeClient().CoreV1().ConfigMaps("test").Get(context.Background(), "skupper-router", metav1.GetOptions{})
+			assert.Assert(t, err)
+			config, err := qdr.GetRouterConfigFromConfigMap(cm)
+			assert.Assert(t, err)
+			assert.Equal(t, config.Metadata.DataConnectionCount, tt.expectedDcc)
+		})
+	}
+}
+
+// recordAppliedDeployments captures the deployments applied through
+// the fake dynamic client, which does not itself support server side
+// apply.
+func recordAppliedDeployments(client dynamic.Interface) map[string]*appsv1.Deployment {
+	deployments := map[string]*appsv1.Deployment{}
+	client.(*fakedynamic.FakeDynamicClient).PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pa := action.(k8stesting.PatchAction)
+		if pa.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(pa.GetPatch(), obj); err != nil {
+			return true, nil, err
+		}
+		if pa.GetResource().Resource == "deployments" {
+			deployment := &appsv1.Deployment{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+				return true, nil, err
+			}
+			deployments[pa.GetName()] = deployment
+		}
+		return true, obj, nil
+	})
+	return deployments
+}
+
 func Test_CheckSecuredAccess(t *testing.T) {
 	type args struct {
 		sa *skupperv2alpha1.SecuredAccess
@@ -1046,6 +1255,7 @@ func newSiteMocks(namespace string, k8sObjects []runtime.Object, skupperObjects
 		linkAccess: make(map[string]*skupperv2alpha1.RouterAccess),
 		certs:      certificates.NewCertificateManager(controller),
 		access:     securedaccess.NewSecuredAccessManager(client, nil, &securedaccess.Config{DefaultAccessType: "loadbalancer"}, nil),
+		sizes:      sizing.NewRegistry(),
 		routerPods: make(map[string]*corev1.Pod),
 		logger: slog.New(slog.Default().Handler()).With(
 			slog.String("component", "kube.site.site"),
@@ -0,0 +1,141 @@
+package sizing
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	internalnetwork "github.com/skupperproject/skupper/internal/network"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+const (
+	// A site whose size setting is 'auto' has its size selected
+	// by the controller based on the load observed on its routers.
+	AutoSize = "auto"
+	// The largest size that may be selected for an autoscaled site.
+	AutoscaleMaxSizeSetting = "autoscale-max-size"
+	// The flow rate (new flows per second) handled by a router that
+	// warrants moving up one size.
+	AutoscaleFlowRateSetting = "autoscale-flow-rate"
+	// The byte rate (bytes per second) carried over a router's
+	// links that warrants moving up one size.
+	AutoscaleOctetRateSetting = "autoscale-octet-rate"
+
+	defaultFlowRateStep  = 100
+	defaultOctetRateStep = 10 * 1024 * 1024
+
+	// To avoid flapping, a site is only scaled down once its load
+	// is this fraction below the threshold for its current size,
+	// and has stayed there for at least scaleDownDelay.
+	hysteresis     = 0.2
+	scaleDownDelay = 5 * time.Minute
+
+	ReasonInitial    = "Initial"
+	ReasonScaledUp   = "ScaledUp"
+	ReasonScaledDown = "ScaledDown"
+	ReasonMaxSize    = "MaxSizeChanged"
+)
+
+func IsAutoscaled(site *skupperv2alpha1.Site) bool {
+	return desiredSize(site) == AutoSize
+}
+
+// autoscaledSize returns the size most recently selected for an
+// autoscaled site, or the smallest permitted size if none has been
+// selected yet.
+func autoscaledSize(site *skupperv2alpha1.Site, sizes []string) string {
+	sizes = permittedSizes(site, sizes)
+	if site.Status.Sizing != nil && slices.Contains(sizes, site.Status.Sizing.Size) {
+		return site.Status.Sizing.Size
+	}
+	if len(sizes) > 0 {
+		return sizes[0]
+	}
+	return ""
+}
+
+// permittedSizes removes any sizes larger than the configured maximum
+// from the ordered list of sizes.
+func permittedSizes(site *skupperv2alpha1.Site, sizes []string) []string {
+	if site.Spec.Settings == nil {
+		return sizes
+	}
+	if max := slices.Index(sizes, site.Spec.Settings[AutoscaleMaxSizeSetting]); max >= 0 {
+		return sizes[:max+1]
+	}
+	return sizes
+}
+
+func rateSetting(site *skupperv2alpha1.Site, key string, defaultValue uint64) uint64 {
+	if site.Spec.Settings == nil {
+		return defaultValue
+	}
+	if value, err := strconv.ParseUint(site.Spec.Settings[key], 10, 64); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+// Autoscaler selects a size for a site from the load observed on its
+// routers. Scaling up happens as soon as the load requires it;
+// scaling down is subject to hysteresis and a delay.
+type Autoscaler struct {
+	belowSince time.Time
+}
+
+// Evaluate returns the sizing that should be recorded in the site's
+// status for the given load, and whether that differs from what is
+// currently recorded there. Sizes must be ordered from smallest to
+// largest.
+func (a *Autoscaler) Evaluate(site *skupperv2alpha1.Site, sizes []string, load internalnetwork.SiteLoad, now time.Time) (*skupperv2alpha1.Sizing, bool) {
+	sizes = permittedSizes(site, sizes)
+	if len(sizes) == 0 {
+		return nil, false
+	}
+	flowStep := rateSetting(site, AutoscaleFlowRateSetting, defaultFlowRateStep)
+	octetStep := rateSetting(site, AutoscaleOctetRateSetting, defaultOctetRateStep)
+	level := func(factor float64) int {
+		l := max(
+			math.Floor(float64(load.FlowRate)*factor/float64(flowStep)),
+			math.Floor(float64(load.OctetRate)*factor/float64(octetStep)),
+		)
+		return int(min(l, float64(len(sizes)-1)))
+	}
+	decision := func(index int, reason string) (*skupperv2alpha1.Sizing, bool) {
+		a.belowSince = time.Time{}
+		return &skupperv2alpha1.Sizing{
+			Size:               sizes[index],
+			Reason:             reason,
+			FlowRate:           int64(load.FlowRate),
+			OctetRate:          int64(load.OctetRate),
+			LastTransitionTime: metav1.NewTime(now),
+		}, true
+	}
+
+	desired := level(1)
+	if site.Status.Sizing == nil || site.Status.Sizing.Size == "" {
+		return decision(desired, ReasonInitial)
+	}
+	current := slices.Index(sizes, site.Status.Sizing.Size)
+	if current < 0 {
+		// the recorded size no longer exists or exceeds the maximum
+		return decision(desired, ReasonMaxSize)
+	}
+	if desired > current {
+		return decision(desired, ReasonScaledUp)
+	}
+	if relaxed := level(1 + hysteresis); relaxed < current {
+		if a.belowSince.IsZero() {
+			a.belowSince = now
+		} else if now.Sub(a.belowSince) >= scaleDownDelay {
+			return decision(relaxed, ReasonScaledDown)
+		}
+		return nil, false
+	}
+	a.belowSince = time.Time{}
+	return nil, false
+}
@@ -0,0 +1,200 @@
+package sizing
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	internalnetwork "github.com/skupperproject/skupper/internal/network"
+)
+
+func TestAutoscaler(t *testing.T) {
+	type Step struct {
+		elapsed  time.Duration
+		load     internalnetwork.SiteLoad
+		expected string
+		reason   string
+	}
+	sizes := []string{"small", "medium", "large"}
+	tests := []struct {
+		name     string
+		settings []string
+		steps    []Step
+	}{
+		{
+			name: "initial size",
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 10},
+					expected: "small",
+					reason:   ReasonInitial,
+				},
+				{
+					load: internalnetwork.SiteLoad{FlowRate: 20},
+				},
+			},
+		},
+		{
+			name: "scale up on flow rate",
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{},
+					expected: "small",
+					reason:   ReasonInitial,
+				},
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 150},
+					expected: "medium",
+					reason:   ReasonScaledUp,
+				},
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 1000},
+					expected: "large",
+					reason:   ReasonScaledUp,
+				},
+			},
+		},
+		{
+			name: "scale up on octet rate",
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{OctetRate: 25 * 1024 * 1024},
+					expected: "large",
+					reason:   ReasonInitial,
+				},
+			},
+		},
+		{
+			name:     "custom thresholds",
+			settings: []string{AutoscaleFlowRateSetting, "10", AutoscaleOctetRateSetting, "1000"},
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 5, OctetRate: 1500},
+					expected: "medium",
+					reason:   ReasonInitial,
+				},
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 25},
+					expected: "large",
+					reason:   ReasonScaledUp,
+				},
+			},
+		},
+		{
+			name: "scale down after delay",
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 250},
+					expected: "large",
+					reason:   ReasonInitial,
+				},
+				{
+					load: internalnetwork.SiteLoad{FlowRate: 50},
+				},
+				{
+					elapsed: time.Minute,
+					load:    internalnetwork.SiteLoad{FlowRate: 50},
+				},
+				{
+					elapsed:  scaleDownDelay,
+					load:     internalnetwork.SiteLoad{FlowRate: 50},
+					expected: "small",
+					reason:   ReasonScaledDown,
+				},
+			},
+		},
+		{
+			name: "no scale down within hysteresis band",
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 150},
+					expected: "medium",
+					reason:   ReasonInitial,
+				},
+				{
+					load: internalnetwork.SiteLoad{FlowRate: 90},
+				},
+				{
+					elapsed: scaleDownDelay * 2,
+					load:    internalnetwork.SiteLoad{FlowRate: 90},
+				},
+			},
+		},
+		{
+			name: "scale down delay reset by increased load",
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 150},
+					expected: "medium",
+					reason:   ReasonInitial,
+				},
+				{
+					load: internalnetwork.SiteLoad{FlowRate: 10},
+				},
+				{
+					elapsed: time.Minute,
+					load:    internalnetwork.SiteLoad{FlowRate: 120},
+				},
+				{
+					elapsed: scaleDownDelay,
+					load:    internalnetwork.SiteLoad{FlowRate: 10},
+				},
+				{
+					elapsed:  scaleDownDelay,
+					load:     internalnetwork.SiteLoad{FlowRate: 10},
+					expected: "small",
+					reason:   ReasonScaledDown,
+				},
+			},
+		},
+		{
+			name:     "max size",
+			settings: []string{AutoscaleMaxSizeSetting, "medium"},
+			steps: []Step{
+				{
+					load:     internalnetwork.SiteLoad{FlowRate: 1000},
+					expected: "medium",
+					reason:   ReasonInitial,
+				},
+				{
+					load: internalnetwork.SiteLoad{FlowRate: 2000},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			site := f.autoscaledSite("", tt.settings...)
+			autoscaler := &Autoscaler{}
+			now := time.Now()
+			for i, step := range tt.steps {
+				now = now.Add(step.elapsed)
+				decision, changed := autoscaler.Evaluate(site, sizes, step.load, now)
+				if step.expected == "" {
+					assert.Assert(t, !changed, "step %d: unexpected change to %v", i, decision)
+					continue
+				}
+				assert.Assert(t, changed, "step %d: expected change to %s", i, step.expected)
+				assert.Equal(t, decision.Size, step.expected, "step %d", i)
+				assert.Equal(t, decision.Reason, step.reason, "step %d", i)
+				assert.Equal(t, decision.FlowRate, int64(step.load.FlowRate))
+				assert.Equal(t, decision.OctetRate, int64(step.load.OctetRate))
+				site.Status.Sizing = decision
+			}
+		})
+	}
+
+	t.Run("recorded size exceeds new max size", func(t *testing.T) {
+		site := f.autoscaledSite("large", AutoscaleMaxSizeSetting, "medium")
+		decision, changed := (&Autoscaler{}).Evaluate(site, sizes, internalnetwork.SiteLoad{FlowRate: 1000}, time.Now())
+		assert.Assert(t, changed)
+		assert.Equal(t, decision.Size, "medium")
+		assert.Equal(t, decision.Reason, ReasonMaxSize)
+	})
+
+	t.Run("no sizes", func(t *testing.T) {
+		_, changed := (&Autoscaler{}).Evaluate(f.autoscaledSite(""), nil, internalnetwork.SiteLoad{FlowRate: 1000}, time.Now())
+		assert.Assert(t, !changed)
+	})
+}
@@ -3,6 +3,9 @@ package sizing
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -13,6 +16,10 @@ import (
 const (
 	SiteSizingLabel             = "skupper.io/site-sizing"
 	DefaultSiteSizingAnnotation = "skupper.io/default-site-sizing"
+	// If set to 'site', the resources in a sizing ConfigMap are
+	// totals for the site and are divided between its router
+	// replicas. Otherwise they apply to each router replica.
+	SiteSizingScopeAnnotation = "skupper.io/site-sizing-scope"
 )
 
 type Registry struct {
@@ -63,9 +70,24 @@ func isDefault(cm *corev1.ConfigMap) bool {
 	return ok
 }
 
+func isSiteScoped(cm *corev1.ConfigMap) bool {
+	if cm == nil || cm.ObjectMeta.Annotations == nil {
+		return false
+	}
+	return cm.ObjectMeta.Annotations[SiteSizingScopeAnnotation] == "site"
+}
+
 func (r *Registry) GetSizing(site *skupperv2alpha1.Site) (Sizing, error) {
-	if config := r.getSizeConfiguration(desiredSize(site)); config != nil {
-		return parse(config)
+	name := desiredSize(site)
+	if name == AutoSize {
+		name = autoscaledSize(site, r.OrderedSizes())
+	}
+	if config := r.getSizeConfiguration(name); config != nil {
+		sizing, err := parse(config)
+		if isSiteScoped(config) {
+			sizing = sizing.perReplica(site.GetRouterReplicas())
+		}
+		return sizing, err
 	}
 	return Sizing{}, nil
 }
@@ -77,6 +99,34 @@ func desiredSize(site *skupperv2alpha1.Site) string {
 	return site.Spec.Settings["size"]
 }
 
+// OrderedSizes returns the names of all known sizes, from smallest to
+// largest as determined by the router cpu and then memory requests.
+func (r *Registry) OrderedSizes() []string {
+	var names []string
+	requests := map[string]map[string]string{}
+	for name, cm := range r.sizes {
+		names = append(names, name)
+		sizing, _ := parse(cm)
+		requests[name] = sizing.Router.Requests
+	}
+	slices.SortFunc(names, func(a string, b string) int {
+		if c := compareQuantities(requests[a], requests[b], string(corev1.ResourceCPU)); c != 0 {
+			return c
+		}
+		if c := compareQuantities(requests[a], requests[b], string(corev1.ResourceMemory)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	return names
+}
+
+func compareQuantities(a map[string]string, b map[string]string, key string) int {
+	qa, _ := resource.ParseQuantity(a[key])
+	qb, _ := resource.ParseQuantity(b[key])
+	return qa.Cmp(qb)
+}
+
 func (r *Registry) getSizeConfiguration(name string) *corev1.ConfigMap {
 	if conf, ok := r.sizes[name]; ok {
 		return conf
@@ -85,8 +135,20 @@ func (r *Registry) getSizeConfiguration(name string) *corev1.ConfigMap {
 }
 
 type Sizing struct {
-	Router  ContainerResources
-	Adaptor ContainerResources
+	Router                    ContainerResources
+	Adaptor                   ContainerResources
+	RouterDataConnectionCount string
+}
+
+func (s Sizing) perReplica(replicas int) Sizing {
+	if replicas <= 1 {
+		return s
+	}
+	return Sizing{
+		Router:                    s.Router.divide(replicas),
+		Adaptor:                   s.Adaptor.divide(replicas),
+		RouterDataConnectionCount: s.RouterDataConnectionCount,
+	}
 }
 
 func parse(cm *corev1.ConfigMap) (Sizing, error) {
@@ -102,6 +164,14 @@ func parse(cm *corev1.ConfigMap) (Sizing, error) {
 		},
 	}
 	for key, value := range cm.Data {
+		if key == "router-data-connection-count" {
+			if _, err := strconv.Atoi(value); err != nil {
+				errs = append(errs, fmt.Errorf("Bad value for %s in %s/%s: %s", key, cm.Namespace, cm.Name, err))
+				continue
+			}
+			sizing.RouterDataConnectionCount = value
+			continue
+		}
 		if err := verify(value); err != nil {
 			errs = append(errs, fmt.Errorf("Bad value for %s in %s/%s: %s", key, cm.Namespace, cm.Name, err))
 			continue
@@ -139,6 +209,30 @@ func (r ContainerResources) NotEmpty() bool {
 	return len(r.Requests) > 0 || len(r.Limits) > 0
 }
 
+func (r ContainerResources) divide(n int) ContainerResources {
+	return ContainerResources{
+		Requests: divideQuantities(r.Requests, n),
+		Limits:   divideQuantities(r.Limits, n),
+	}
+}
+
+func divideQuantities(in map[string]string, n int) map[string]string {
+	out := map[string]string{}
+	for key, value := range in {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			out[key] = value
+			continue
+		}
+		if key == string(corev1.ResourceCPU) {
+			out[key] = resource.NewMilliQuantity(q.MilliValue()/int64(n), q.Format).String()
+		} else {
+			out[key] = resource.NewQuantity(q.Value()/int64(n), q.Format).String()
+		}
+	}
+	return out
+}
+
 func (r *ContainerResources) setCpuRequest(value string) {
 	r.Requests[string(corev1.ResourceCPU)] = value
 }
@@ -201,6 +201,112 @@ func TestSizing(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "site scoped config divided between replicas",
+			config: []Update{
+				{
+					key: "foo/bar",
+					config: f.config("mysize", false).annotation(SiteSizingScopeAnnotation, "site").entry(
+						"router-cpu-request", "2",
+					).entry(
+						"router-memory-limit", "1G",
+					).entry(
+						"adaptor-cpu-limit", "0.4",
+					).configmap("bar", "foo"),
+				},
+			},
+			expectations: []Expectation{
+				{
+					site:   f.siteWithReplicas("mysize", 4),
+					sizing: f.sizing().routerRequest("cpu", "500m").routerLimit("memory", "250M").adaptorLimit("cpu", "100m").sizing,
+				},
+				{
+					site:   f.site("mysize"),
+					sizing: f.sizing().routerRequest("cpu", "2").routerLimit("memory", "1G").adaptorLimit("cpu", "0.4").sizing,
+				},
+			},
+		},
+		{
+			name: "router scoped config not divided",
+			config: []Update{
+				{
+					key:    "foo/bar",
+					config: f.config("mysize", false).entry("router-cpu-request", "2").configmap("bar", "foo"),
+				},
+			},
+			expectations: []Expectation{
+				{
+					site:   f.siteWithReplicas("mysize", 4),
+					sizing: f.sizing().routerRequest("cpu", "2").sizing,
+				},
+			},
+		},
+		{
+			name: "data connection count",
+			config: []Update{
+				{
+					key:    "foo/bar",
+					config: f.config("mysize", false).entry("router-cpu-request", "2").entry("router-data-connection-count", "8").configmap("bar", "foo"),
+				},
+			},
+			expectations: []Expectation{
+				{
+					site:   f.site("mysize"),
+					sizing: f.sizing().routerRequest("cpu", "2").dataConnectionCount("8").sizing,
+				},
+			},
+		},
+		{
+			name: "bad data connection count",
+			config: []Update{
+				{
+					key:    "foo/bar",
+					config: f.config("mysize", false).entry("router-data-connection-count", "lots").configmap("bar", "foo"),
+				},
+			},
+			expectations: []Expectation{
+				{
+					site:   f.site("mysize"),
+					sizing: f.sizing().sizing,
+					err:    "Bad value for router-data-connection-count in foo/bar",
+				},
+			},
+		},
+		{
+			name: "autoscaled site",
+			config: []Update{
+				{
+					key:    "foo/large",
+					config: f.config("large", false).entry("router-cpu-request", "4").configmap("large", "foo"),
+				},
+				{
+					key:    "foo/small",
+					config: f.config("small", false).entry("router-cpu-request", "500m").configmap("small", "foo"),
+				},
+				{
+					key:    "foo/medium",
+					config: f.config("medium", true).entry("router-cpu-request", "1").configmap("medium", "foo"),
+				},
+			},
+			expectations: []Expectation{
+				{
+					site:   f.autoscaledSite(""),
+					sizing: f.sizing().routerRequest("cpu", "500m").sizing,
+				},
+				{
+					site:   f.autoscaledSite("large"),
+					sizing: f.sizing().routerRequest("cpu", "4").sizing,
+				},
+				{
+					site:   f.autoscaledSite("large", AutoscaleMaxSizeSetting, "medium"),
+					sizing: f.sizing().routerRequest("cpu", "500m").sizing,
+				},
+				{
+					site:   f.autoscaledSite("unknown"),
+					sizing: f.sizing().routerRequest("cpu", "500m").sizing,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -238,6 +344,25 @@ func (*factory) site(size string) *skupperv2alpha1.Site {
 	}
 }
 
+func (*factory) siteWithReplicas(size string, replicas int) *skupperv2alpha1.Site {
+	site := f.site(size)
+	site.Spec.RouterReplicas = replicas
+	return site
+}
+
+func (*factory) autoscaledSite(current string, settings ...string) *skupperv2alpha1.Site {
+	site := f.site(AutoSize)
+	for i := 0; i+1 < len(settings); i += 2 {
+		site.Spec.Settings[settings[i]] = settings[i+1]
+	}
+	if current != "" {
+		site.Status.Sizing = &skupperv2alpha1.Sizing{
+			Size: current,
+		}
+	}
+	return site
+}
+
 type ConfigBuilder struct {
 	labels      map[string]string
 	annotations map[string]string
@@ -329,4 +454,9 @@ func (s *SizingBuilder) adaptorLimit(key string, value string) *SizingBuilder {
 	return s
 }
 
+func (s *SizingBuilder) dataConnectionCount(value string) *SizingBuilder {
+	s.sizing.RouterDataConnectionCount = value
+	return s
+}
+
 var f factory
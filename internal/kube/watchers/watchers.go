@@ -120,6 +120,18 @@ func (c *EventProcessor) HasTlsRoute() bool {
 	return resource.IsResourceAvailable(c.discoveryClient, resource.TlsRouteResource())
 }
 
+func (c *EventProcessor) HasTcpRoute() bool {
+	return resource.IsResourceAvailable(c.discoveryClient, resource.TcpRouteResource())
+}
+
+func (c *EventProcessor) HasIstioGateway() bool {
+	return resource.IsResourceAvailable(c.discoveryClient, resource.IstioGatewayResource())
+}
+
+func (c *EventProcessor) HasIstioVirtualService() bool {
+	return resource.IsResourceAvailable(c.discoveryClient, resource.IstioVirtualServiceResource())
+}
+
 func (c *EventProcessor) GetRouteInterface() openshiftroute.Interface {
 	return c.routeClient
 }
@@ -582,6 +594,30 @@ func (c *EventProcessor) WatchTlsRoutes(options dynamicinformer.TweakListOptions
 	return c.WatchDynamic(resource.TlsRouteResource(), options, namespace, handler)
 }
 
+func (c *EventProcessor) WatchTcpRoutes(options dynamicinformer.TweakListOptionsFunc, namespace string, handler DynamicHandler) *DynamicWatcher {
+	if !c.HasTcpRoute() {
+		log.Println("Cannot watch TCPRoutes; resource not installed")
+		return nil
+	}
+	return c.WatchDynamic(resource.TcpRouteResource(), options, namespace, handler)
+}
+
+func (c *EventProcessor) WatchIstioGateways(options dynamicinformer.TweakListOptionsFunc, namespace string, handler DynamicHandler) *DynamicWatcher {
+	if !c.HasIstioGateway() {
+		log.Println("Cannot watch Istio Gateways; resource not installed")
+		return nil
+	}
+	return c.WatchDynamic(resource.IstioGatewayResource(), options, namespace, handler)
+}
+
+func (c *EventProcessor) WatchIstioVirtualServices(options dynamicinformer.TweakListOptionsFunc, namespace string, handler DynamicHandler) *DynamicWatcher {
+	if !c.HasIstioVirtualService() {
+		log.Println("Cannot watch Istio VirtualServices; resource not installed")
+		return nil
+	}
+	return c.WatchDynamic(resource.IstioVirtualServiceResource(), options, namespace, handler)
+}
+
 func (c *EventProcessor) WatchDynamic(resource schema.GroupVersionResource, options dynamicinformer.TweakListOptionsFunc, namespace string, handler DynamicHandler) *DynamicWatcher {
 	watcher := &DynamicWatcher{
 		handler: handler,
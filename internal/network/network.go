@@ -269,3 +269,42 @@ func HasMatchingPair(networkStatus NetworkStatusInfo, address string) bool {
 	}
 	return false
 }
+
+// SiteLoad describes the traffic handled by the busiest router of a
+// site, as reported in the vanflow records collected for the network
+// status.
+type SiteLoad struct {
+	// FlowRate is the rate at which new flows are opened through
+	// the router's listeners and connectors.
+	FlowRate uint64
+	// OctetRate is the combined rate, in both directions, of bytes
+	// carried over the router's links.
+	OctetRate uint64
+}
+
+// GetSiteLoad returns the load for the site with the given id. As all
+// routers in a site share the same sizing, each metric is taken from
+// whichever router reports the highest value for it.
+func GetSiteLoad(siteId string, status NetworkStatusInfo) SiteLoad {
+	load := SiteLoad{}
+	for _, site := range status.SiteStatus {
+		if site.Site.Identity != siteId {
+			continue
+		}
+		for _, router := range site.RouterStatus {
+			var flowRate, octetRate uint64
+			for _, listener := range router.Listeners {
+				flowRate += listener.FlowRate
+			}
+			for _, connector := range router.Connectors {
+				flowRate += connector.FlowRate
+			}
+			for _, link := range router.Links {
+				octetRate += link.OctetRate + link.OctetRateReverse
+			}
+			load.FlowRate = max(load.FlowRate, flowRate)
+			load.OctetRate = max(load.OctetRate, octetRate)
+		}
+	}
+	return load
+}
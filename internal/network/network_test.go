@@ -208,3 +208,64 @@ func TestHasMatchingPair(t *testing.T) {
 		assert.Equal(t, scenario.expectedMatch, HasMatchingPair(networkStatus, scenario.address))
 	}
 }
+
+func TestGetSiteLoad(t *testing.T) {
+	networkStatus := NetworkStatusInfo{
+		SiteStatus: []SiteStatusInfo{
+			{
+				Site: SiteInfo{Identity: "site-a"},
+				RouterStatus: []RouterStatusInfo{
+					{
+						Links: []LinkInfo{
+							{Name: "link1", OctetRate: 1000, OctetRateReverse: 500},
+						},
+						Listeners: []ListenerInfo{
+							{Name: "backend", FlowRate: 10},
+						},
+						Connectors: []ConnectorInfo{
+							{Address: "backend", FlowRate: 5},
+						},
+					},
+					{
+						Links: []LinkInfo{
+							{Name: "link2", OctetRate: 4000},
+						},
+						Connectors: []ConnectorInfo{
+							{Address: "backend", FlowRate: 2},
+						},
+					},
+				},
+			},
+			{
+				Site: SiteInfo{Identity: "site-b"},
+				RouterStatus: []RouterStatusInfo{
+					{
+						Listeners: []ListenerInfo{
+							{Name: "backend", FlowRate: 100},
+						},
+					},
+				},
+			},
+		},
+	}
+	scenarios := []struct {
+		siteId   string
+		expected SiteLoad
+	}{
+		{
+			siteId:   "site-a",
+			expected: SiteLoad{FlowRate: 15, OctetRate: 4000},
+		},
+		{
+			siteId:   "site-b",
+			expected: SiteLoad{FlowRate: 100},
+		},
+		{
+			siteId:   "unknown",
+			expected: SiteLoad{},
+		},
+	}
+	for _, scenario := range scenarios {
+		assert.Equal(t, scenario.expected, GetSiteLoad(scenario.siteId, networkStatus))
+	}
+}
@@ -49,11 +49,15 @@ type RouterInfo struct {
 }
 
 type LinkInfo struct {
-	Name     string `json:"name,omitempty"`
-	LinkCost uint64 `json:"linkCost,omitempty"`
-	Status   string `json:"status,omitempty"`
-	Role     string `json:"role,omitempty"`
-	Peer     string `json:"peer,omitempty"`
+	Name             string `json:"name,omitempty"`
+	LinkCost         uint64 `json:"linkCost,omitempty"`
+	Status           string `json:"status,omitempty"`
+	Role             string `json:"role,omitempty"`
+	Peer             string `json:"peer,omitempty"`
+	Octets           uint64 `json:"octets,omitempty"`
+	OctetRate        uint64 `json:"octetRate,omitempty"`
+	OctetsReverse    uint64 `json:"octetsReverse,omitempty"`
+	OctetRateReverse uint64 `json:"octetRateReverse,omitempty"`
 }
 
 type RouterAccessInfo struct {
@@ -61,19 +65,23 @@ type RouterAccessInfo struct {
 }
 
 type ListenerInfo struct {
-	Name     string `json:"name,omitempty"`
-	DestHost string `json:"destHost,omitempty"`
-	DestPort string `json:"destPort,omitempty"`
-	Protocol string `json:"protocol,omitempty"`
-	Address  string `json:"address,omitempty"`
+	Name      string `json:"name,omitempty"`
+	DestHost  string `json:"destHost,omitempty"`
+	DestPort  string `json:"destPort,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	Address   string `json:"address,omitempty"`
+	FlowCount uint64 `json:"flowCount,omitempty"`
+	FlowRate  uint64 `json:"flowRate,omitempty"`
 }
 
 type ConnectorInfo struct {
-	DestHost string `json:"destHost,omitempty"`
-	DestPort string `json:"destPort,omitempty"`
-	Address  string `json:"address,omitempty"`
-	Process  string `json:"process,omitempty"`
-	Target   string `json:"target,omitempty"`
+	DestHost  string `json:"destHost,omitempty"`
+	DestPort  string `json:"destPort,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Process   string `json:"process,omitempty"`
+	Target    string `json:"target,omitempty"`
+	FlowCount uint64 `json:"flowCount,omitempty"`
+	FlowRate  uint64 `json:"flowRate,omitempty"`
 }
 
 type SiteInfoForLinks struct {
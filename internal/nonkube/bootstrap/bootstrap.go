@@ -13,6 +13,7 @@ import (
 	"github.com/skupperproject/skupper/internal/nonkube/linux"
 	"github.com/skupperproject/skupper/internal/utils"
 	internalutils "github.com/skupperproject/skupper/internal/utils"
+	"github.com/skupperproject/skupper/internal/version"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 )
 
@@ -24,6 +25,14 @@ type Config struct {
 	IsBundle       bool
 	Platform       types.Platform
 	Binary         string
+	// ServiceFormat defaults to the one used by an existing site being
+	// reloaded, or else to the one recorded by "skupper system install"
+	ServiceFormat common.ServiceFormat
+	// BundleVersion, BundleSigningKey and BundleTemplate are only used
+	// with bundles
+	BundleVersion    string
+	BundleSigningKey string
+	BundleTemplate   bool
 }
 
 func PreBootstrap(config *Config) error {
@@ -76,6 +85,7 @@ func PreBootstrap(config *Config) error {
 func Bootstrap(config *Config) (*api.SiteState, error) {
 	var siteStateLoader api.SiteStateLoader
 	var reloadExisting bool
+	var existingServiceFormat string
 
 	sourcesPath := api.GetInternalOutputPath(config.Namespace, api.InputSiteStatePath)
 	_, err := os.Stat(sourcesPath)
@@ -99,6 +109,11 @@ func Bootstrap(config *Config) (*api.SiteState, error) {
 		if nsPlatform != currentPlatform {
 			return nil, fmt.Errorf("existing namespace uses %q platform and it cannot change to %q", nsPlatform, currentPlatform)
 		}
+		existingServiceFormat = nsPlatformLoader.ServiceFormat
+	}
+	serviceFormat, err := getServiceFormat(config, reloadExisting, existingServiceFormat)
+	if err != nil {
+		return nil, err
 	}
 	siteStateLoader = &common.FileSystemSiteStateLoader{
 		Path:   config.InputPath,
@@ -123,15 +138,20 @@ func Bootstrap(config *Config) (*api.SiteState, error) {
 	var siteStateRenderer api.StaticSiteStateRenderer
 	if config.IsBundle {
 		siteStateRenderer = &internalbundle.SiteStateRenderer{
-			Strategy: internalbundle.BundleStrategy(config.BundleStrategy),
-			Platform: config.Platform,
-			FileName: config.BundleName,
+			Strategy:      internalbundle.BundleStrategy(config.BundleStrategy),
+			Platform:      config.Platform,
+			FileName:      config.BundleName,
+			ServiceFormat: serviceFormat,
+			BundleVersion: config.BundleVersion,
+			SigningKey:    config.BundleSigningKey,
+			Template:      config.BundleTemplate,
 		}
 	} else if config.Platform == types.PlatformLinux {
 		siteStateRenderer = &linux.SiteStateRenderer{}
 	} else {
 		siteStateRenderer = &compat.SiteStateRenderer{
-			Platform: config.Platform,
+			Platform:      config.Platform,
+			ServiceFormat: serviceFormat,
 		}
 	}
 	err = siteStateRenderer.Render(siteState, reloadExisting)
@@ -141,6 +161,30 @@ func Bootstrap(config *Config) (*api.SiteState, error) {
 	return siteState, nil
 }
 
+func getServiceFormat(config *Config, reloadExisting bool, existingServiceFormat string) (common.ServiceFormat, error) {
+	serviceFormat := config.ServiceFormat
+	if serviceFormat == "" && reloadExisting {
+		serviceFormat = common.ServiceFormat(existingServiceFormat)
+	}
+	if serviceFormat == "" && !config.IsBundle {
+		systemConfig, err := common.LoadSystemConfig()
+		if err != nil {
+			return "", err
+		}
+		serviceFormat = systemConfig.ServiceFormat
+	}
+	if serviceFormat == "" {
+		serviceFormat = common.ServiceFormatSystemd
+	}
+	if !common.IsValidServiceFormat(string(serviceFormat)) {
+		return "", fmt.Errorf("invalid service format %q", serviceFormat)
+	}
+	if !config.IsBundle && serviceFormat.IsQuadlet() && config.Platform != types.PlatformPodman && !config.Platform.IsKubernetes() {
+		return "", fmt.Errorf("service format %q requires the podman platform", serviceFormat)
+	}
+	return serviceFormat, nil
+}
+
 func PostBootstrap(config *Config, siteState *api.SiteState) {
 	var bundleSuffix string
 	if config.IsBundle {
@@ -182,5 +226,12 @@ func PostBootstrap(config *Config, siteState *api.SiteState) {
 		fmt.Println("Installation bundle available at:", installationFile)
 		fmt.Println("Default namespace:", siteState.GetNamespace())
 		fmt.Println("Default platform:", string(config.Platform))
+		fmt.Println("Bundle version:", utils.DefaultStr(config.BundleVersion, version.Version))
+		if config.BundleSigningKey != "" {
+			fmt.Println("Bundle signature available at:", installationFile+internalbundle.BundleSignatureSuffix)
+		}
+		if config.BundleTemplate {
+			fmt.Println("Template bundle: site parameters can be provided on installation (see install.sh -h)")
+		}
 	}
 }
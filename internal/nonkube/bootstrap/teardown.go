@@ -22,12 +22,22 @@ func Teardown(namespace string) error {
 	if err != nil {
 		return err
 	}
+	// the router container of quadlet units is recreated by its service
+	// until the service is stopped
+	serviceFormat := common.ServiceFormat(platformLoader.ServiceFormat)
+	if serviceFormat.IsQuadlet() {
+		if err := removeQuadletService(namespace, serviceFormat); err != nil {
+			return err
+		}
+	}
 	if err := removeRouter(namespace, platform); err != nil {
 		return err
 	}
 
-	if err := removeService(namespace, platform); err != nil {
-		return err
+	if !serviceFormat.IsQuadlet() {
+		if err := removeService(namespace, platform); err != nil {
+			return err
+		}
 	}
 
 	if err := removeDefinition(namespace); err != nil {
@@ -102,3 +112,14 @@ func removeService(namespace string, platform string) error {
 
 	return nil
 }
+
+func removeQuadletService(namespace string, serviceFormat common.ServiceFormat) error {
+	quadletService, err := common.NewQuadletServiceInfo(&common.QuadletUnits{
+		Namespace: namespace,
+		Format:    serviceFormat,
+	})
+	if err != nil {
+		return err
+	}
+	return quadletService.Remove()
+}
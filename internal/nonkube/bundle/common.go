@@ -24,6 +24,7 @@ var (
 
 type BundleGenerator interface {
 	Generate(tarball *utils.Tarball, defaultPlatform string) error
+	InstallFile() string
 }
 
 type BundleStrategy string
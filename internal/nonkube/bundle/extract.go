@@ -18,6 +18,8 @@ type SelfExtractingBundle struct {
 	Namespace  string
 	OutputPath string
 	Filename   string
+	// ServiceFormat is the default service format of the installation
+	ServiceFormat string
 }
 
 func (s *SelfExtractingBundle) InstallFile() string {
@@ -56,6 +58,7 @@ func (s *SelfExtractingBundle) Generate(tarBall *utils.Tarball, defaultPlatform
 		"Platform":        pkgutils.DefaultStr(defaultPlatform, "podman"),
 		"SelfExtractPart": selfExtractPart,
 		"Version":         version.Version,
+		"ServiceFormat":   pkgutils.DefaultStr(s.ServiceFormat, "systemd"),
 	})
 	if err != nil {
 		return err
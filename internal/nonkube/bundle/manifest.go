@@ -0,0 +1,216 @@
+package bundle
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/version"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ManifestFile          = "manifest.yaml"
+	ManifestSignatureFile = "manifest.yaml.sig"
+	// BundleSignatureSuffix is appended to the name of a bundle file to
+	// name its detached signature
+	BundleSignatureSuffix = ".sig"
+)
+
+// Manifest describes the content of a site bundle. It is verified by
+// the installation script before the site files are installed, and it
+// is kept with the installed site to identify the bundle version an
+// existing installation came from.
+type Manifest struct {
+	// Version is the version of the bundle, as provided when it is
+	// generated, or the skupper version otherwise
+	Version        string `json:"version"`
+	SkupperVersion string `json:"skupperVersion"`
+	SiteName       string `json:"siteName"`
+	Namespace      string `json:"namespace"`
+	CreatedAt      string `json:"createdAt"`
+	// Files maps the path of each file in the bundle, relative to the
+	// bundle root, to its sha256 checksum
+	Files map[string]string `json:"files"`
+	// Parameters maps the name of each parameter of a template bundle
+	// to its default value
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Signed is set when the manifest is signed, so that a bundle from
+	// which the signature has been left out by accident is refused by
+	// the installation script. As it is not protected by a signature
+	// when the signature is missing, it does not detect tampering: only
+	// verifying the bundle with the public key of the signer does.
+	Signed bool `json:"signed,omitempty"`
+}
+
+// NewManifest returns the manifest of the site files of the given
+// namespace, found in the bundles home directory.
+func NewManifest(bundlesHomeDir string, namespace string, siteName string, bundleVersion string) (*Manifest, error) {
+	manifest := &Manifest{
+		Version:        bundleVersion,
+		SkupperVersion: version.Version,
+		SiteName:       siteName,
+		Namespace:      namespace,
+		CreatedAt:      time.Now().UTC().Format(time.RFC3339),
+		Files:          map[string]string{},
+	}
+	if manifest.Version == "" {
+		manifest.Version = version.Version
+	}
+	err := filepath.WalkDir(filepath.Join(bundlesHomeDir, namespace), func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(bundlesHomeDir, filePath)
+		if err != nil {
+			return err
+		}
+		checksum := sha256.Sum256(data)
+		manifest.Files[filepath.ToSlash(relPath)] = hex.EncodeToString(checksum[:])
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute bundle checksums: %w", err)
+	}
+	return manifest, nil
+}
+
+func (m *Manifest) Marshal() ([]byte, error) {
+	return yaml.Marshal(m)
+}
+
+// SignManifest signs the manifest data using the PEM encoded RSA or
+// ECDSA private key found at keyFile. The signature is compatible with
+// "openssl dgst -sha256 -verify", used by the installation script.
+func SignManifest(data []byte, keyFile string) ([]byte, error) {
+	signature, err := sign(data, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign bundle manifest: %w", err)
+	}
+	return signature, nil
+}
+
+// SignBundle writes the detached signature of the bundle file, including
+// its installation script, next to it and returns its path. Unlike the
+// signature of the manifest, it can be verified with "openssl dgst
+// -sha256 -verify" before the bundle is run.
+func SignBundle(bundleFile string, keyFile string) (string, error) {
+	data, err := os.ReadFile(bundleFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read bundle: %w", err)
+	}
+	signature, err := sign(data, keyFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign bundle: %w", err)
+	}
+	signatureFile := bundleFile + BundleSignatureSuffix
+	if err = os.WriteFile(signatureFile, signature, 0644); err != nil {
+		return "", fmt.Errorf("failed to write bundle signature: %w", err)
+	}
+	return signatureFile, nil
+}
+
+func sign(data []byte, keyFile string) ([]byte, error) {
+	signer, err := loadSigningKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(data)
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+// VerifyManifest verifies the signature of the manifest data against
+// the PEM encoded public key found at keyFile.
+func VerifyManifest(data []byte, signature []byte, keyFile string) error {
+	publicKey, err := loadVerifyingKey(keyFile)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(data)
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			err = fmt.Errorf("invalid signature")
+		}
+	default:
+		err = fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	if err != nil {
+		return fmt.Errorf("bundle manifest signature verification failed: %w", err)
+	}
+	return nil
+}
+
+// ValidateSigningKey returns an error if keyFile does not contain a
+// private key that can be used to sign bundles.
+func ValidateSigningKey(keyFile string) error {
+	_, err := loadSigningKey(keyFile)
+	return err
+}
+
+func readPemBlock(keyFile string) (*pem.Block, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", keyFile)
+	}
+	return block, nil
+}
+
+func loadSigningKey(keyFile string) (crypto.Signer, error) {
+	block, err := readPemBlock(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	var key interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse signing key: %w", err)
+	}
+	switch signer := key.(type) {
+	case *rsa.PrivateKey:
+		return signer, nil
+	case *ecdsa.PrivateKey:
+		return signer, nil
+	}
+	return nil, fmt.Errorf("signing key must be an RSA or ECDSA key, found %T", key)
+}
+
+func loadVerifyingKey(keyFile string) (crypto.PublicKey, error) {
+	block, err := readPemBlock(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unsupported public key type %q", block.Type)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
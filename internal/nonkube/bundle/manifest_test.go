@@ -0,0 +1,171 @@
+package bundle
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/version"
+	"gotest.tools/v3/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func TestNewManifest(t *testing.T) {
+	bundlesHome := t.TempDir()
+	resourcesPath := path.Join(bundlesHome, "west/input/resources")
+	assert.Assert(t, os.MkdirAll(resourcesPath, 0755))
+	assert.Assert(t, os.WriteFile(path.Join(resourcesPath, "Site-west.yaml"), []byte("site"), 0644))
+	// only the files of the given namespace are described
+	assert.Assert(t, os.WriteFile(path.Join(bundlesHome, "other.yaml"), []byte("other"), 0644))
+
+	manifest, err := NewManifest(bundlesHome, "west", "my-site", "")
+	assert.Assert(t, err)
+	assert.Equal(t, manifest.Version, version.Version)
+	assert.Equal(t, manifest.SiteName, "my-site")
+	assert.DeepEqual(t, manifest.Files, map[string]string{
+		"west/input/resources/Site-west.yaml": "fbae041b02c41ed0fd8a4efb039bc780dd6af4a1f0c420f42561ae705dda43fe",
+	})
+
+	manifest, err = NewManifest(bundlesHome, "west", "my-site", "1.2.0")
+	assert.Assert(t, err)
+	data, err := manifest.Marshal()
+	assert.Assert(t, err)
+	unmarshalled := &Manifest{}
+	assert.Assert(t, yaml.Unmarshal(data, unmarshalled))
+	assert.DeepEqual(t, unmarshalled, manifest)
+	assert.Equal(t, unmarshalled.Version, "1.2.0")
+	assert.Assert(t, !strings.Contains(string(data), "signed"))
+
+	manifest.Signed = true
+	data, err = manifest.Marshal()
+	assert.Assert(t, err)
+	// the installation script looks for this line
+	assert.Assert(t, strings.Contains(string(data), "\nsigned: true\n"), string(data))
+
+	_, err = NewManifest(bundlesHome, "east", "my-site", "")
+	assert.ErrorContains(t, err, "failed to compute bundle checksums")
+}
+
+func writeKeyPair(t *testing.T, dir string, privateKey interface{}, publicKey interface{}, pkcs8 bool) (string, string) {
+	t.Helper()
+	var block *pem.Block
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(key)
+		assert.Assert(t, err)
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	}
+	if pkcs8 {
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		assert.Assert(t, err)
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+	keyFile := path.Join(dir, "key.pem")
+	assert.Assert(t, os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600))
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	assert.Assert(t, err)
+	publicKeyFile := path.Join(dir, "public.pem")
+	assert.Assert(t, os.WriteFile(publicKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+	return keyFile, publicKeyFile
+}
+
+func TestSignManifest(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Assert(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Assert(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Assert(t, err)
+
+	tests := []struct {
+		name       string
+		privateKey interface{}
+		publicKey  interface{}
+		pkcs8      bool
+		verifyErr  string
+	}{
+		{
+			name:       "rsa",
+			privateKey: rsaKey,
+			publicKey:  &rsaKey.PublicKey,
+		},
+		{
+			name:       "ecdsa",
+			privateKey: ecKey,
+			publicKey:  &ecKey.PublicKey,
+		},
+		{
+			name:       "pkcs8",
+			privateKey: ecKey,
+			publicKey:  &ecKey.PublicKey,
+			pkcs8:      true,
+		},
+		{
+			name:       "other-public-key",
+			privateKey: ecKey,
+			publicKey:  &otherKey.PublicKey,
+			verifyErr:  "bundle manifest signature verification failed",
+		},
+	}
+	data := []byte("version: 1.2.0\n")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keyFile, publicKeyFile := writeKeyPair(t, t.TempDir(), test.privateKey, test.publicKey, test.pkcs8)
+			assert.Assert(t, ValidateSigningKey(keyFile))
+			signature, err := SignManifest(data, keyFile)
+			assert.Assert(t, err)
+			err = VerifyManifest(data, signature, publicKeyFile)
+			if test.verifyErr != "" {
+				assert.ErrorContains(t, err, test.verifyErr)
+				return
+			}
+			assert.Assert(t, err)
+			assert.ErrorContains(t, VerifyManifest([]byte("version: 1.3.0\n"), signature, publicKeyFile), "verification failed")
+		})
+	}
+}
+
+func TestSignBundle(t *testing.T) {
+	dir := t.TempDir()
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Assert(t, err)
+	keyFile, publicKeyFile := writeKeyPair(t, dir, ecKey, &ecKey.PublicKey, false)
+	bundleFile := path.Join(dir, "my-bundle.sh")
+	bundleData := []byte("#!/bin/sh\nverify_bundle\n")
+	assert.Assert(t, os.WriteFile(bundleFile, bundleData, 0755))
+
+	signatureFile, err := SignBundle(bundleFile, keyFile)
+	assert.Assert(t, err)
+	assert.Equal(t, signatureFile, bundleFile+".sig")
+	signature, err := os.ReadFile(signatureFile)
+	assert.Assert(t, err)
+	assert.Assert(t, VerifyManifest(bundleData, signature, publicKeyFile))
+	// the installation script is covered by the signature
+	assert.ErrorContains(t, VerifyManifest([]byte("#!/bin/sh\n"), signature, publicKeyFile), "verification failed")
+
+	_, err = SignBundle(path.Join(dir, "missing.sh"), keyFile)
+	assert.ErrorContains(t, err, "failed to read bundle")
+}
+
+func TestValidateSigningKey(t *testing.T) {
+	dir := t.TempDir()
+	assert.ErrorContains(t, ValidateSigningKey(path.Join(dir, "missing.pem")), "unable to read key file")
+
+	notPem := path.Join(dir, "not.pem")
+	assert.Assert(t, os.WriteFile(notPem, []byte("not a key"), 0600))
+	assert.ErrorContains(t, ValidateSigningKey(notPem), "no PEM data found")
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Assert(t, err)
+	_, publicKeyFile := writeKeyPair(t, dir, ecKey, &ecKey.PublicKey, false)
+	assert.ErrorContains(t, ValidateSigningKey(publicKeyFile), `unsupported signing key type "PUBLIC KEY"`)
+}
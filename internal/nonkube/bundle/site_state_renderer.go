@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"path"
+	"time"
 
 	"github.com/skupperproject/skupper/api/types"
 	"github.com/skupperproject/skupper/internal/images"
@@ -29,16 +30,33 @@ type SiteStateRenderer struct {
 	Strategy        BundleStrategy
 	Platform        types.Platform
 	FileName        string
+	ServiceFormat   common.ServiceFormat
+	// BundleVersion is recorded in the bundle manifest
+	BundleVersion string
+	// SigningKey is the private key used to sign the bundle and its manifest
+	SigningKey string
+	// Template bundles have their site name, router access bind host
+	// and ports and link cost provided when they are installed
+	Template   bool
+	parameters map[string]string
+	siteName   string
 }
 
 func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool) error {
 	var err error
-	var validator api.SiteStateValidator = &common.SiteStateValidator{}
+	var validator api.SiteStateValidator = &common.SiteStateValidator{
+		SelectorConnectors: s.Platform.IsContainerEngine(),
+	}
 	err = validator.Validate(loadedSiteState)
 	if err != nil {
 		return err
 	}
 	s.loadedSiteState = loadedSiteState
+	s.siteName = loadedSiteState.Site.Name
+	if s.Template {
+		s.loadedSiteState = common.CopySiteState(loadedSiteState)
+		s.parameters = setTemplateSentinels(s.loadedSiteState)
+	}
 	// active (runtime) SiteState
 	s.siteState = common.CopySiteState(s.loadedSiteState)
 	s.siteState.SiteId = "{{.SiteId}}"
@@ -86,6 +104,15 @@ func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool)
 	if err = CreateStartupScripts(s.siteState, s.Platform); err != nil {
 		return err
 	}
+	if err = CreateQuadletUnits(s.siteState, s.containers[types.RouterComponent]); err != nil {
+		return err
+	}
+	if s.Template {
+		siteHomeDir := api.GetDefaultBundleOutputPath(s.siteState.Site.Namespace)
+		if err = replaceTemplateSentinels(siteHomeDir, s.parameters); err != nil {
+			return err
+		}
+	}
 	if err = s.createBundle(); err != nil {
 		return err
 	}
@@ -163,27 +190,71 @@ func (s *SiteStateRenderer) createBundle() error {
 	if err != nil {
 		return fmt.Errorf("failed to add files to tarball (%q): %v", siteHomeDir, err)
 	}
+	if err = s.addManifest(tarball, bundlesHomeDir); err != nil {
+		return err
+	}
 	var generator BundleGenerator
 	switch s.Strategy {
 	case BundleStrategyTarball:
 		generator = &TarballBundle{
-			SiteName:   s.siteState.Site.Name,
-			Namespace:  s.siteState.GetNamespace(),
-			OutputPath: bundlesHomeDir,
-			Filename:   s.FileName,
+			SiteName:      s.siteName,
+			Namespace:     s.siteState.GetNamespace(),
+			OutputPath:    bundlesHomeDir,
+			Filename:      s.FileName,
+			ServiceFormat: string(s.ServiceFormat),
 		}
 	default:
 		generator = &SelfExtractingBundle{
-			SiteName:   s.siteState.Site.Name,
-			Namespace:  s.siteState.GetNamespace(),
-			OutputPath: bundlesHomeDir,
-			Filename:   s.FileName,
+			SiteName:      s.siteName,
+			Namespace:     s.siteState.GetNamespace(),
+			OutputPath:    bundlesHomeDir,
+			Filename:      s.FileName,
+			ServiceFormat: string(s.ServiceFormat),
 		}
 	}
-	logger.Debug("generating bundle:", slog.String("path", bundlesHomeDir), slog.String("site", s.siteState.Site.Name))
+	logger.Debug("generating bundle:", slog.String("path", bundlesHomeDir), slog.String("site", s.siteName))
 	err = generator.Generate(tarball, string(s.Platform))
 	if err != nil {
-		return fmt.Errorf("failed to generate site bundle (%q): %v", s.siteState.Site.Name, err)
+		return fmt.Errorf("failed to generate site bundle (%q): %v", s.siteName, err)
+	}
+	if s.SigningKey != "" {
+		signatureFile, err := SignBundle(generator.InstallFile(), s.SigningKey)
+		if err != nil {
+			return err
+		}
+		logger.Debug("bundle signed", slog.String("signature", signatureFile))
+	}
+	return nil
+}
+
+// addManifest adds the manifest of the site files, and its signature if
+// a signing key has been provided, to the root of the bundle.
+func (s *SiteStateRenderer) addManifest(tarball *utils.Tarball, bundlesHomeDir string) error {
+	var logger = common.NewLogger()
+	manifest, err := NewManifest(bundlesHomeDir, s.siteState.GetNamespace(), s.siteName, s.BundleVersion)
+	if err != nil {
+		return err
+	}
+	manifest.Parameters = s.parameters
+	manifest.Signed = s.SigningKey != ""
+	manifestData, err := manifest.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal bundle manifest: %v", err)
+	}
+	now := time.Now()
+	if err = tarball.AddFileData(ManifestFile, 0644, now, manifestData); err != nil {
+		return fmt.Errorf("failed to add bundle manifest: %v", err)
+	}
+	if s.SigningKey == "" {
+		return nil
+	}
+	logger.Debug("signing bundle manifest", slog.String("key", s.SigningKey))
+	signature, err := SignManifest(manifestData, s.SigningKey)
+	if err != nil {
+		return err
+	}
+	if err = tarball.AddFileData(ManifestSignatureFile, 0644, now, signature); err != nil {
+		return fmt.Errorf("failed to add bundle manifest signature: %v", err)
 	}
 	return nil
 }
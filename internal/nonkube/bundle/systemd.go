@@ -11,6 +11,7 @@ import (
 
 	"github.com/skupperproject/skupper/api/types"
 	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/container"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 )
 
@@ -65,3 +66,41 @@ func CreateStartupScripts(siteState *api.SiteState, platform types.Platform) err
 	}
 	return nil
 }
+
+// CreateQuadletUnits writes the Quadlet units and the Pod YAML through
+// which the router can be run, if the bundle is installed with one of
+// the quadlet based service formats.
+func CreateQuadletUnits(siteState *api.SiteState, router container.Container) error {
+	var logger = common.NewLogger()
+	scriptsPath := api.GetInternalBundleOutputPath(siteState.Site.Namespace, api.ScriptsPath)
+	units := &common.QuadletUnits{
+		Namespace:    "{{.Namespace}}",
+		Router:       router,
+		RunAs:        "{{.RunAs}}",
+		UserNS:       "{{.UserNamespace}}",
+		LocalPort:    "{{.SkupperLocalPort}}",
+		SiteHomePath: path.Join("{{.NamespacesPath}}", "{{.Namespace}}"),
+	}
+	unitFiles := map[common.ServiceFormat]string{
+		common.ServiceFormatQuadlet: "skupper.container",
+		common.ServiceFormatKube:    "skupper.kube",
+	}
+	for format, unitFileName := range unitFiles {
+		units.Format = format
+		unitFile := path.Join(scriptsPath, unitFileName)
+		logger.Debug("writing quadlet unit", slog.String("path", unitFile))
+		if err := os.WriteFile(unitFile, units.Unit(), 0644); err != nil {
+			return fmt.Errorf("failed to write %s quadlet unit: %w", format, err)
+		}
+	}
+	pod, err := units.Pod()
+	if err != nil {
+		return fmt.Errorf("failed to render pod: %w", err)
+	}
+	podFile := path.Join(scriptsPath, common.QuadletPodFile)
+	logger.Debug("writing pod file", slog.String("path", podFile))
+	if err = os.WriteFile(podFile, pod, 0644); err != nil {
+		return fmt.Errorf("failed to write pod file: %w", err)
+	}
+	return nil
+}
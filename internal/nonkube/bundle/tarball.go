@@ -18,6 +18,8 @@ type TarballBundle struct {
 	OutputPath string
 	Namespace  string
 	Filename   string
+	// ServiceFormat is the default service format of the installation
+	ServiceFormat string
 }
 
 func (s *TarballBundle) InstallFile() string {
@@ -38,6 +40,7 @@ func (s *TarballBundle) Generate(tarBall *utils.Tarball, defaultPlatform string)
 		"Namespace":       s.Namespace,
 		"Platform":        pkgutils.DefaultStr(defaultPlatform, "podman"),
 		"Version":         version.Version,
+		"ServiceFormat":   pkgutils.DefaultStr(s.ServiceFormat, "systemd"),
 		"SelfExtractPart": "",
 	})
 	if err != nil {
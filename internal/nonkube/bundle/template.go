@@ -0,0 +1,185 @@
+package bundle
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// Parameters of template bundles, provided when the bundle is installed
+const (
+	ParameterSiteName        = "SITE_NAME"
+	ParameterBindHost        = "BIND_HOST"
+	ParameterInterRouterPort = "INTER_ROUTER_PORT"
+	ParameterEdgePort        = "EDGE_PORT"
+	ParameterLinkCost        = "LINK_COST"
+)
+
+// templateParameter describes how a parameter is set into the rendered
+// site files. The site state is rendered using the sentinel value of each
+// parameter, which is then replaced by the placeholder that install.sh
+// renders at installation time.
+type templateParameter struct {
+	name        string
+	placeholder string
+	sentinel    string
+	// numeric sentinels are only replaced when used as the value of one
+	// of the given fields, as they could be found in any other value
+	fields []string
+}
+
+var templateParameters = []templateParameter{
+	{
+		name:        ParameterSiteName,
+		placeholder: "{{.SiteName}}",
+		sentinel:    "skupper-template-site-name",
+	},
+	{
+		name:        ParameterBindHost,
+		placeholder: "{{.BindHost}}",
+		sentinel:    "skupper-template-bind-host",
+	},
+	{
+		name:        ParameterInterRouterPort,
+		placeholder: "{{.InterRouterPort}}",
+		sentinel:    "70001",
+		fields:      []string{"port"},
+	},
+	{
+		name:        ParameterEdgePort,
+		placeholder: "{{.EdgePort}}",
+		sentinel:    "70002",
+		fields:      []string{"port"},
+	},
+	{
+		name:        ParameterLinkCost,
+		placeholder: "{{.LinkCost}}",
+		sentinel:    "70003",
+		fields:      []string{"cost"},
+	},
+}
+
+func getTemplateParameter(name string) templateParameter {
+	for _, parameter := range templateParameters {
+		if parameter.name == name {
+			return parameter
+		}
+	}
+	panic("unknown template parameter: " + name)
+}
+
+func (p templateParameter) sentinelInt() int {
+	value, _ := strconv.Atoi(p.sentinel)
+	return value
+}
+
+// replace returns data with all the occurrences of the sentinel value
+// replaced by the placeholder
+func (p templateParameter) replace(data string) string {
+	if len(p.fields) == 0 {
+		return strings.ReplaceAll(data, p.sentinel, p.placeholder)
+	}
+	// matches yaml and json fields, quoted or not:
+	// port: 70001, port: "70001" and "port": 70001
+	fieldRegex := regexp.MustCompile(`((?:` + strings.Join(p.fields, "|") + `)"?:\s*"?)` + p.sentinel + `\b`)
+	return fieldRegex.ReplaceAllString(data, "${1}"+p.placeholder)
+}
+
+// setTemplateSentinels replaces the values of the given site state that
+// are provided when a template bundle is installed, with their sentinel
+// values. It returns the values found, which are used as the default
+// values of each parameter.
+func setTemplateSentinels(siteState *api.SiteState) map[string]string {
+	defaults := map[string]string{}
+	siteNameParameter := getTemplateParameter(ParameterSiteName)
+	defaults[siteNameParameter.name] = siteState.Site.Name
+	siteState.Site.Name = siteNameParameter.sentinel
+
+	rolePorts := map[string]templateParameter{
+		"inter-router": getTemplateParameter(ParameterInterRouterPort),
+		"edge":         getTemplateParameter(ParameterEdgePort),
+	}
+	for _, routerAccess := range siteState.RouterAccesses {
+		if routerAccess.Spec.BindHost != "" {
+			bindHostParameter := getTemplateParameter(ParameterBindHost)
+			defaults[bindHostParameter.name] = routerAccess.Spec.BindHost
+			routerAccess.Spec.BindHost = bindHostParameter.sentinel
+		}
+		for i, role := range routerAccess.Spec.Roles {
+			portParameter, ok := rolePorts[role.Name]
+			if !ok {
+				continue
+			}
+			defaults[portParameter.name] = strconv.Itoa(int(role.GetPort()))
+			routerAccess.Spec.Roles[i].Port = portParameter.sentinelInt()
+		}
+	}
+	for _, link := range siteState.Links {
+		costParameter := getTemplateParameter(ParameterLinkCost)
+		cost := link.Spec.Cost
+		if cost == 0 {
+			cost = 1
+		}
+		defaults[costParameter.name] = strconv.Itoa(cost)
+		link.Spec.Cost = costParameter.sentinelInt()
+	}
+	return defaults
+}
+
+// replaceTemplateSentinels replaces the sentinel values of the given
+// parameters with their placeholders, in the content and in the names of
+// all files found in siteHomeDir.
+func replaceTemplateSentinels(siteHomeDir string, parameters map[string]string) error {
+	var usedParameters []templateParameter
+	for _, parameter := range templateParameters {
+		if _, ok := parameters[parameter.name]; ok {
+			usedParameters = append(usedParameters, parameter)
+		}
+	}
+	var renamed []string
+	err := filepath.WalkDir(siteHomeDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		content := string(data)
+		fileName := entry.Name()
+		for _, parameter := range usedParameters {
+			content = parameter.replace(content)
+			if len(parameter.fields) == 0 {
+				fileName = strings.ReplaceAll(fileName, parameter.sentinel, parameter.placeholder)
+			}
+		}
+		if content != string(data) {
+			if err = os.WriteFile(filePath, []byte(content), info.Mode()); err != nil {
+				return err
+			}
+		}
+		if fileName != entry.Name() {
+			renamed = append(renamed, filePath, filepath.Join(filepath.Dir(filePath), fileName))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render template bundle: %w", err)
+	}
+	for i := 0; i < len(renamed); i += 2 {
+		if err = os.Rename(renamed[i], renamed[i+1]); err != nil {
+			return fmt.Errorf("failed to render template bundle: %w", err)
+		}
+	}
+	return nil
+}
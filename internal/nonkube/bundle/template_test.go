@@ -0,0 +1,113 @@
+package bundle
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetTemplateSentinels(t *testing.T) {
+	tests := []struct {
+		name             string
+		siteState        *api.SiteState
+		expectedDefaults map[string]string
+	}{
+		{
+			name: "site-only",
+			siteState: &api.SiteState{
+				Site: &v2alpha1.Site{ObjectMeta: metav1.ObjectMeta{Name: "west"}},
+			},
+			expectedDefaults: map[string]string{
+				ParameterSiteName: "west",
+			},
+		},
+		{
+			name: "router-access-and-links",
+			siteState: &api.SiteState{
+				Site: &v2alpha1.Site{ObjectMeta: metav1.ObjectMeta{Name: "west"}},
+				RouterAccesses: map[string]*v2alpha1.RouterAccess{
+					"go-west": {
+						ObjectMeta: metav1.ObjectMeta{Name: "go-west"},
+						Spec: v2alpha1.RouterAccessSpec{
+							BindHost: "10.0.0.1",
+							Roles: []v2alpha1.RouterAccessRole{
+								{Name: "inter-router", Port: 55000},
+								{Name: "edge"},
+							},
+						},
+					},
+				},
+				Links: map[string]*v2alpha1.Link{
+					"east": {
+						ObjectMeta: metav1.ObjectMeta{Name: "east"},
+					},
+				},
+			},
+			expectedDefaults: map[string]string{
+				ParameterSiteName:        "west",
+				ParameterBindHost:        "10.0.0.1",
+				ParameterInterRouterPort: "55000",
+				ParameterEdgePort:        "45671",
+				ParameterLinkCost:        "1",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defaults := setTemplateSentinels(test.siteState)
+			assert.DeepEqual(t, defaults, test.expectedDefaults)
+			assert.Equal(t, test.siteState.Site.Name, "skupper-template-site-name")
+			for _, routerAccess := range test.siteState.RouterAccesses {
+				assert.Equal(t, routerAccess.Spec.BindHost, "skupper-template-bind-host")
+				assert.Equal(t, routerAccess.Spec.Roles[0].Port, 70001)
+				assert.Equal(t, routerAccess.Spec.Roles[1].Port, 70002)
+			}
+			for _, link := range test.siteState.Links {
+				assert.Equal(t, link.Spec.Cost, 70003)
+			}
+		})
+	}
+}
+
+func TestReplaceTemplateSentinels(t *testing.T) {
+	siteHome := t.TempDir()
+	resourcesPath := path.Join(siteHome, "runtime/resources")
+	assert.Assert(t, os.MkdirAll(resourcesPath, 0755))
+	files := map[string]string{
+		"Site-skupper-template-site-name.yaml": "name: skupper-template-site-name\n" +
+			"- host: skupper-template-bind-host\n  port: \"70001\"\n",
+		"skrouterd.json": "\"port\": 70002,\n\"cost\": 70003,\n\"other\": 70001,\n",
+		"Link-east.yaml": "cost: 70003\ndata: a70003b\n",
+	}
+	for name, content := range files {
+		assert.Assert(t, os.WriteFile(path.Join(resourcesPath, name), []byte(content), 0644))
+	}
+	parameters := map[string]string{
+		ParameterSiteName:        "west",
+		ParameterBindHost:        "10.0.0.1",
+		ParameterInterRouterPort: "55671",
+		ParameterEdgePort:        "45671",
+		ParameterLinkCost:        "1",
+	}
+	assert.Assert(t, replaceTemplateSentinels(siteHome, parameters))
+
+	expectedFiles := map[string]string{
+		"Site-{{.SiteName}}.yaml": "name: {{.SiteName}}\n" +
+			"- host: {{.BindHost}}\n  port: \"{{.InterRouterPort}}\"\n",
+		"skrouterd.json": "\"port\": {{.EdgePort}},\n\"cost\": {{.LinkCost}},\n\"other\": 70001,\n",
+		"Link-east.yaml": "cost: {{.LinkCost}}\ndata: a70003b\n",
+	}
+	entries, err := os.ReadDir(resourcesPath)
+	assert.Assert(t, err)
+	assert.Equal(t, len(entries), len(expectedFiles))
+	for name, expectedContent := range expectedFiles {
+		content, err := os.ReadFile(path.Join(resourcesPath, name))
+		assert.Assert(t, err)
+		assert.Equal(t, string(content), expectedContent)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	runtimeclient "github.com/go-openapi/runtime/client"
 	"github.com/skupperproject/skupper-libpod/v4/client/containers_compat"
 	"github.com/skupperproject/skupper-libpod/v4/client/exec_compat"
+	"github.com/skupperproject/skupper-libpod/v4/client/system_compat"
 	"github.com/skupperproject/skupper-libpod/v4/models"
 	"github.com/skupperproject/skupper/pkg/container"
 )
@@ -629,3 +630,37 @@ func (c *CompatClient) ContainerRestart(name string) error {
 	}
 	return nil
 }
+
+// ContainerEvents streams the start, die and destroy events of all
+// containers that happened since the given time to handler. It only
+// returns when ctx is cancelled or the stream is closed by the container
+// engine.
+func (c *CompatClient) ContainerEvents(ctx context.Context, since time.Time, handler func(event container.ContainerEvent)) error {
+	params := system_compat.NewSystemEventsParamsWithContext(ctx)
+	filters, err := json.Marshal(map[string][]string{
+		"type":  {"container"},
+		"event": {container.ContainerEventStart, container.ContainerEventDie, container.ContainerEventDestroy},
+	})
+	if err != nil {
+		return err
+	}
+	params.Filters = stringP(string(filters))
+	params.Since = stringP(strconv.FormatInt(since.Unix(), 10))
+	op := &runtime.ClientOperation{
+		ID:                 "SystemEvents",
+		Method:             "GET",
+		PathPattern:        "/events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/x-tar"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &responseReaderContainerEvents{handler: handler},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	_, err = c.RestClient.Submit(op)
+	if err != nil {
+		return fmt.Errorf("error watching container events: %v", err)
+	}
+	return nil
+}
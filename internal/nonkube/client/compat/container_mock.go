@@ -1,8 +1,10 @@
 package compat
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"strconv"
@@ -45,8 +47,11 @@ type RestClientMock struct {
 	networks     map[string]*container.Network
 	Volumes      map[string]*container.Volume
 	VolumesFiles map[string]map[string]string
-	volumesDir   string
-	ErrorHook    func(operation *runtime.ClientOperation) error
+	// Events are streamed to the clients watching container events,
+	// after which the stream is closed
+	Events     []container.ContainerEvent
+	volumesDir string
+	ErrorHook  func(operation *runtime.ClientOperation) error
 }
 
 func (r *RestClientMock) MockVolumeFiles(volumes map[string]*container.Volume, volumesFiles map[string]map[string]string) error {
@@ -139,6 +144,8 @@ func (r *RestClientMock) Submit(operation *runtime.ClientOperation) (interface{}
 		res, err = r.HandleNetworkCreate(operation, r.ErrorHook)
 	case "NetworkDelete":
 		res, err = r.HandleNetworkDelete(operation, r.ErrorHook)
+	case "SystemEvents":
+		res, err = r.HandleSystemEvents(operation, r.ErrorHook)
 	default:
 		return nil, fmt.Errorf("unsupported operation: %s", operation.ID)
 	}
@@ -305,11 +312,11 @@ func (r *RestClientMock) HandleContainerInspect(operation *runtime.ClientOperati
 			}
 			for k, v := range c.Networks {
 				settings.Networks[k] = models.EndpointSettings{
-					NetworkID:   "",
-					IPAddress:   "",
-					IPPrefixLen: 0,
-					MacAddress:  "",
-					Gateway:     "",
+					NetworkID:   v.ID,
+					IPAddress:   v.IPAddress,
+					IPPrefixLen: int64(v.IPPrefixLen),
+					MacAddress:  v.MacAddress,
+					Gateway:     v.Gateway,
 					Aliases:     v.Aliases,
 				}
 			}
@@ -677,6 +684,60 @@ func (r *RestClientMock) HandleSystemInfo(operation *runtime.ClientOperation, ho
 	return res, nil
 }
 
+func (r *RestClientMock) HandleSystemEvents(operation *runtime.ClientOperation, hook func(operation *runtime.ClientOperation) error) (interface{}, error) {
+	if hook != nil {
+		if err := hook(operation); err != nil {
+			return nil, err
+		}
+	}
+	body := &bytes.Buffer{}
+	encoder := json.NewEncoder(body)
+	for _, event := range r.Events {
+		err := encoder.Encode(map[string]interface{}{
+			"Type":   "container",
+			"Action": event.Action,
+			"Actor": map[string]interface{}{
+				"ID": event.ID,
+				"Attributes": map[string]string{
+					"name": event.Name,
+				},
+			},
+			"timeNano": event.Time.UnixNano(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return operation.Reader.ReadResponse(&mockResponse{code: 200, body: io.NopCloser(body)}, nil)
+}
+
+// mockResponse is a successful response whose body is read by the
+// reader of the operation
+type mockResponse struct {
+	code int
+	body io.ReadCloser
+}
+
+func (m *mockResponse) Code() int {
+	return m.code
+}
+
+func (m *mockResponse) Message() string {
+	return ""
+}
+
+func (m *mockResponse) GetHeader(string) string {
+	return ""
+}
+
+func (m *mockResponse) GetHeaders(string) []string {
+	return nil
+}
+
+func (m *mockResponse) Body() io.ReadCloser {
+	return m.body
+}
+
 func (r *RestClientMock) HandleNetworkCreate(operation *runtime.ClientOperation, hook func(operation *runtime.ClientOperation) error) (interface{}, error) {
 	var res = &networkCreateOK{}
 	if hook != nil {
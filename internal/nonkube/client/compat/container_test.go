@@ -314,6 +314,34 @@ func TestContainerCreateMock(t *testing.T) {
 	assert.Equal(t, int64(1024*1024*1024), ci.MaxMemoryBytes)
 }
 
+func TestContainerEventsMock(t *testing.T) {
+	now := time.Now()
+	cli := NewCompatClientMock(nil)
+	mock := cli.RestClient.(*RestClientMock)
+	mock.Events = []container.ContainerEvent{
+		{ID: "1234", Name: "backend-1", Action: container.ContainerEventStart, Time: now},
+		{ID: "1234", Name: "backend-1", Action: container.ContainerEventDie, Time: now.Add(time.Second)},
+	}
+	var events []container.ContainerEvent
+	err := cli.ContainerEvents(context.Background(), now, func(event container.ContainerEvent) {
+		events = append(events, event)
+	})
+	assert.Assert(t, err)
+	assert.Equal(t, len(events), 2)
+	for i, event := range events {
+		assert.Equal(t, event.ID, mock.Events[i].ID)
+		assert.Equal(t, event.Name, mock.Events[i].Name)
+		assert.Equal(t, event.Action, mock.Events[i].Action)
+		assert.Assert(t, event.Time.Equal(mock.Events[i].Time))
+	}
+
+	mock.ErrorHook = func(operation *runtime.ClientOperation) error {
+		return fmt.Errorf("connection refused")
+	}
+	err = cli.ContainerEvents(context.Background(), now, func(event container.ContainerEvent) {})
+	assert.ErrorContains(t, err, "connection refused")
+}
+
 func TestContainerUpdateMock(t *testing.T) {
 	image := images.GetRouterImageName()
 	cli := NewCompatClientMock(mockContainers(image))
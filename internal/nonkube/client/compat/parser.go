@@ -2,12 +2,16 @@ package compat
 
 import (
 	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/skupperproject/skupper-libpod/v4/models"
+	"github.com/skupperproject/skupper/pkg/container"
 )
 
 // boolTrue returns a true bool pointer (for false, just use new(bool))
@@ -203,6 +207,47 @@ func (r *responseReaderJSONErrorBody) Consume(reader io.Reader, i interface{}) e
 	return nil
 }
 
+// responseReaderContainerEvents decodes the events streamed by the
+// container engine as they arrive, handing each one to handler
+type responseReaderContainerEvents struct {
+	handler func(event container.ContainerEvent)
+}
+
+type systemEvent struct {
+	Type   string `json:"Type"`
+	Action string `json:"Action"`
+	Actor  struct {
+		ID         string            `json:"ID"`
+		Attributes map[string]string `json:"Attributes"`
+	} `json:"Actor"`
+	TimeNano int64 `json:"timeNano"`
+}
+
+func (r *responseReaderContainerEvents) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if response.Code() != 200 {
+		return ReadResponse(response, consumer)
+	}
+	decoder := json.NewDecoder(response.Body())
+	for {
+		event := &systemEvent{}
+		if err := decoder.Decode(event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error reading container events: %v", err)
+		}
+		if event.Type != "container" {
+			continue
+		}
+		r.handler(container.ContainerEvent{
+			ID:     event.Actor.ID,
+			Name:   event.Actor.Attributes["name"],
+			Action: event.Action,
+			Time:   time.Unix(0, event.TimeNano),
+		})
+	}
+}
+
 func ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 	case 200, 201:
@@ -0,0 +1,80 @@
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// AccessGrantHandler manages the AccessGrants served by the system
+// controller. Grants are written directly to the runtime directory of
+// the namespace, as they are not part of the site definition.
+type AccessGrantHandler struct {
+	BaseCustomResourceHandler
+	pathProvider PathProvider
+}
+
+func NewAccessGrantHandler(namespace string) *AccessGrantHandler {
+	return &AccessGrantHandler{
+		pathProvider: PathProvider{
+			Namespace: namespace,
+		},
+	}
+}
+
+func (a *AccessGrantHandler) path() string {
+	return api.GetInternalOutputPath(a.pathProvider.Namespace, api.RuntimeGrantsPath)
+}
+
+func (a *AccessGrantHandler) Add(resource v2alpha1.AccessGrant) error {
+	content, err := a.EncodeToYaml(resource)
+	if err != nil {
+		return err
+	}
+	dir := a.path()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directories: %s", err)
+	}
+	// the system controller watches this directory, so the file is
+	// written under a hidden name and then moved into place to avoid
+	// it being read before it is complete
+	fileName := fmt.Sprintf("%s-%s.yaml", common.AccessGrants, resource.Name)
+	tmp, err := os.CreateTemp(dir, "."+fileName+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %s", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, fileName))
+}
+
+func (a *AccessGrantHandler) Get(name string, opts GetOptions) (*v2alpha1.AccessGrant, error) {
+	var grant v2alpha1.AccessGrant
+	err, file := a.ReadFile(a.path(), name+".yaml", common.AccessGrants)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.DecodeYaml(file, &grant); err != nil {
+		return nil, err
+	}
+	return &grant, nil
+}
+
+func (a *AccessGrantHandler) Delete(name string) error {
+	return a.DeleteFile(a.path(), name+".yaml", common.AccessGrants)
+}
+
+func (a *AccessGrantHandler) List() ([]*v2alpha1.AccessGrant, error) { return nil, nil }
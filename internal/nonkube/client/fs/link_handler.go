@@ -41,13 +41,27 @@ func (s *LinkHandler) Get(name string, opts GetOptions) (*v2alpha1.Link, error)
 	var context v2alpha1.Link
 	fileName := name + ".yaml"
 
-	// Read from runtime directory
-	err, link := s.ReadFile(s.pathProvider.GetRuntimeNamespace(), fileName, common.Links)
-	if err != nil {
-		if opts.LogWarning {
-			os.Stderr.WriteString("Site not initialized yet\n")
+	var link []byte
+	var err error
+	if opts.RuntimeFirst == true {
+		// First read from runtime directory, where output is found after bootstrap
+		// has run.  If no runtime links try and display configured links
+		err, link = s.ReadFile(s.pathProvider.GetRuntimeNamespace(), fileName, common.Links)
+		if err != nil {
+			if opts.LogWarning {
+				os.Stderr.WriteString("Site not initialized yet\n")
+			}
+			err, link = s.ReadFile(s.pathProvider.GetNamespace(), fileName, common.Links)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// read from input directory to get latest config
+		err, link = s.ReadFile(s.pathProvider.GetNamespace(), fileName, common.Links)
+		if err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
 
 	// remove the secret and parse link
@@ -34,7 +34,21 @@ func (s *SecretHandler) Add(resource v1.Secret) error {
 	return nil
 }
 
-func (s *SecretHandler) Get(name string, opt GetOptions) (*v1.Secret, error) { return nil, nil }
+func (s *SecretHandler) Get(name string, opt GetOptions) (*v1.Secret, error) {
+	var secret v1.Secret
+	fileName := name + ".yaml"
+
+	// read from input directory to get latest config
+	err, file := s.ReadFile(s.pathProvider.GetNamespace(), fileName, common.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DecodeYaml(file, &secret); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
 
 func (s *SecretHandler) Delete(name string) error {
 	fileName := name + ".yaml"
@@ -30,6 +30,7 @@ var (
 		api.LoadedSiteStatePath,
 		api.RuntimeSiteStatePath,
 		api.RuntimeTokenPath,
+		api.RuntimeGrantsPath,
 		api.ScriptsPath,
 	}
 	reloadDirectories = []api.InternalPath{
@@ -59,6 +60,7 @@ type FileSystemConfigurationRenderer struct {
 	SslProfileBasePath string
 	RouterConfig       qdr.RouterConfig
 	Platform           string
+	ServiceFormat      ServiceFormat
 	Bundle             bool
 	customOutputPath   string
 }
@@ -121,6 +123,9 @@ func (c *FileSystemConfigurationRenderer) Render(siteState *api.SiteState) error
 	// Saving runtime platform
 	if !c.Bundle {
 		content := fmt.Sprintf("platform: %s\n", c.Platform)
+		if c.ServiceFormat != "" && c.ServiceFormat != ServiceFormatSystemd {
+			content += fmt.Sprintf("serviceFormat: %s\n", c.ServiceFormat)
+		}
 		platformPath := path.Join(outputPath, string(api.InternalBasePath), "platform.yaml")
 		logger.Debug("writing platform", slog.String("platform", c.Platform), slog.String("path", platformPath))
 		err = os.WriteFile(platformPath, []byte(content), 0644)
@@ -132,6 +137,7 @@ func (c *FileSystemConfigurationRenderer) Render(siteState *api.SiteState) error
 	siteState.Site.SetRunning(v2alpha1.ReadyCondition())
 	endpoints := make([]v2alpha1.Endpoint, 0)
 	for raName, ra := range siteState.RouterAccesses {
+		var raEndpoints []v2alpha1.Endpoint
 		for _, role := range ra.Spec.Roles {
 			if role.Name != "normal" {
 				logger.Debug("site endpoint configured:",
@@ -139,15 +145,18 @@ func (c *FileSystemConfigurationRenderer) Render(siteState *api.SiteState) error
 					slog.String("role", role.Name),
 					slog.Int("port", role.Port),
 				)
-				endpoints = append(endpoints, v2alpha1.Endpoint{
+				raEndpoints = append(raEndpoints, v2alpha1.Endpoint{
 					Name: fmt.Sprintf("%s-%s", raName, role.Name),
 					Host: ra.Spec.BindHost,
 					Port: strconv.Itoa(role.Port),
 				})
 			}
 		}
+		ra.SetConfigured(nil)
+		ra.Resolve(raEndpoints, "")
+		endpoints = append(endpoints, raEndpoints...)
 	}
-	siteState.Site.Status.Endpoints = endpoints
+	siteState.Site.SetEndpoints(endpoints)
 	return nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,6 +41,12 @@ func testFileSystemConfigurationRendererRender(t *testing.T, addInputCertificate
 	fsConfigRenderer := new(FileSystemConfigurationRenderer)
 	fsConfigRenderer.customOutputPath = customOutputPath
 	assert.Assert(t, fsConfigRenderer.Render(ss))
+	assert.Assert(t, ss.Site.IsConfigured())
+	assert.Assert(t, len(ss.Site.Status.Endpoints) > 0)
+	assert.Assert(t, meta.IsStatusConditionTrue(ss.Site.Status.Conditions, v2alpha1.CONDITION_TYPE_RESOLVED))
+	for _, routerAccess := range ss.RouterAccesses {
+		assert.Assert(t, routerAccess.IsConfigured())
+	}
 	customOutputPath = fsConfigRenderer.GetOutputPath(ss)
 	for _, dirName := range []string{"input", "runtime", "internal"} {
 		file, err := os.Stat(path.Join(customOutputPath, dirName))
@@ -12,6 +12,8 @@ import (
 type NamespacePlatformLoader struct {
 	PathProvider api.InternalPathProvider
 	Platform     string `yaml:"platform"`
+	// ServiceFormat is only recorded when it is not the default one
+	ServiceFormat string `yaml:"serviceFormat"`
 }
 
 func (s *NamespacePlatformLoader) GetPathProvider() api.InternalPathProvider {
@@ -0,0 +1,356 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/skupperproject/skupper/pkg/container"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	rootQuadletBasePath = "/etc/containers/systemd"
+	QuadletPodFile      = "skupper-router-pod.yaml"
+	routerCertsPath     = "/etc/skupper-router/runtime/certs"
+	// bindMountOptionsAnnotation holds the options, such as the SELinux
+	// relabeling requested by z, that podman kube play applies to the
+	// hostPath volumes of a pod, as source:option pairs
+	bindMountOptionsAnnotation = "bind-mount-options"
+)
+
+// QuadletUnits renders the router of a namespace as Quadlet units, as
+// an alternative to the classic systemd unit and scripts. The same
+// rendering is used for bundles, in which case the values given may be
+// template variables to be replaced at installation time.
+type QuadletUnits struct {
+	Namespace string
+	Format    ServiceFormat
+	Router    container.Container
+	// RunAs is the user:group the router runs as
+	RunAs string
+	// UserNS is the user namespace mode of the router, if any
+	UserNS string
+	// LocalPort is the port of the skupper-local RouterAccess, used to
+	// check the health of the router
+	LocalPort string
+	// SiteHomePath is the host path of the namespace
+	SiteHomePath string
+}
+
+// UnitName returns the name of the Quadlet unit, from which the
+// generator produces the skupper-<namespace>.service unit.
+func (q *QuadletUnits) UnitName() string {
+	suffix := "container"
+	if q.Format == ServiceFormatKube {
+		suffix = "kube"
+	}
+	return fmt.Sprintf("skupper-%s.%s", q.Namespace, suffix)
+}
+
+// PodFile returns the host path of the Pod YAML used by the kube unit.
+func (q *QuadletUnits) PodFile() string {
+	return path.Join(q.SiteHomePath, string(api.ScriptsPath), QuadletPodFile)
+}
+
+// HealthCommand returns the command through which the health of the
+// router is checked, using the credentials of the local router access.
+func (q *QuadletUnits) HealthCommand() []string {
+	certs := path.Join(routerCertsPath, "skupper-local-client")
+	return []string{
+		"skstat", "-g",
+		"-b", fmt.Sprintf("amqps://127.0.0.1:%s", q.LocalPort),
+		"--ssl-certificate", path.Join(certs, "tls.crt"),
+		"--ssl-key", path.Join(certs, "tls.key"),
+		"--ssl-trustfile", path.Join(certs, "ca.crt"),
+		"--ssl-disable-peer-name-verify",
+	}
+}
+
+// Unit returns the content of the Quadlet unit.
+func (q *QuadletUnits) Unit() []byte {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "[Unit]\n")
+	fmt.Fprintf(buf, "Description=Skupper router for namespace %s\n", q.Namespace)
+	fmt.Fprintf(buf, "Wants=network-online.target\n")
+	fmt.Fprintf(buf, "After=network-online.target\n")
+	fmt.Fprintf(buf, "RequiresMountsFor=%s\n", q.SiteHomePath)
+	fmt.Fprintf(buf, "\n")
+	if q.Format == ServiceFormatKube {
+		fmt.Fprintf(buf, "[Kube]\n")
+		fmt.Fprintf(buf, "Yaml=%s\n", q.PodFile())
+		fmt.Fprintf(buf, "Network=host\n")
+		if q.UserNS != "" {
+			fmt.Fprintf(buf, "UserNS=%s\n", q.UserNS)
+		}
+		fmt.Fprintf(buf, "AutoUpdate=registry\n")
+	} else {
+		fmt.Fprintf(buf, "[Container]\n")
+		fmt.Fprintf(buf, "ContainerName=%s\n", q.Router.Name)
+		fmt.Fprintf(buf, "Image=%s\n", q.Router.Image)
+		fmt.Fprintf(buf, "AutoUpdate=registry\n")
+		fmt.Fprintf(buf, "Network=host\n")
+		if q.RunAs != "" {
+			fmt.Fprintf(buf, "User=%s\n", q.RunAs)
+		}
+		if q.UserNS != "" {
+			fmt.Fprintf(buf, "UserNS=%s\n", q.UserNS)
+		}
+		for _, name := range sortedKeys(q.Router.Env) {
+			fmt.Fprintf(buf, "Environment=%s=%s\n", name, q.Router.Env[name])
+		}
+		fmt.Fprintf(buf, "Label=application=skupper-v2\n")
+		for _, name := range sortedKeys(q.Router.Labels) {
+			fmt.Fprintf(buf, "Label=%s=%s\n", name, q.Router.Labels[name])
+		}
+		for _, mount := range q.Router.FileMounts {
+			options := ""
+			if len(mount.Options) > 0 {
+				options = ":" + strings.Join(mount.Options, ",")
+			}
+			fmt.Fprintf(buf, "Volume=%s:%s%s\n", mount.Source, mount.Destination, options)
+		}
+		fmt.Fprintf(buf, "HealthCmd=%s\n", strings.Join(q.HealthCommand(), " "))
+		fmt.Fprintf(buf, "HealthInterval=30s\n")
+		fmt.Fprintf(buf, "HealthRetries=3\n")
+		fmt.Fprintf(buf, "HealthStartPeriod=10s\n")
+		fmt.Fprintf(buf, "HealthOnFailure=kill\n")
+	}
+	fmt.Fprintf(buf, "\n")
+	fmt.Fprintf(buf, "[Service]\n")
+	fmt.Fprintf(buf, "Restart=always\n")
+	fmt.Fprintf(buf, "TimeoutStartSec=900\n")
+	fmt.Fprintf(buf, "\n")
+	fmt.Fprintf(buf, "[Install]\n")
+	fmt.Fprintf(buf, "WantedBy=default.target\n")
+	return buf.Bytes()
+}
+
+// Pod returns the Pod YAML through which the router is run by podman
+// kube play. The router container ends up named after the pod and the
+// container, matching the name of the container created by skupper.
+func (q *QuadletUnits) Pod() ([]byte, error) {
+	podName := strings.TrimSuffix(q.Router.Name, "-router")
+	labels := map[string]string{
+		"application": "skupper-v2",
+	}
+	for name, value := range q.Router.Labels {
+		labels[name] = value
+	}
+	router := corev1.Container{
+		Name:  "router",
+		Image: q.Router.Image,
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				Exec: &corev1.ExecAction{
+					Command: q.HealthCommand(),
+				},
+			},
+			InitialDelaySeconds: 10,
+			PeriodSeconds:       30,
+			FailureThreshold:    3,
+		},
+	}
+	for _, name := range sortedKeys(q.Router.Env) {
+		router.Env = append(router.Env, corev1.EnvVar{Name: name, Value: q.Router.Env[name]})
+	}
+	var volumes []corev1.Volume
+	var bindMountOptions []string
+	for i, mount := range q.Router.FileMounts {
+		name := fmt.Sprintf("router-volume-%d", i)
+		volumeMount := corev1.VolumeMount{
+			Name:      name,
+			MountPath: mount.Destination,
+		}
+		for _, option := range mount.Options {
+			if option == "ro" {
+				volumeMount.ReadOnly = true
+				continue
+			}
+			bindMountOptions = append(bindMountOptions, mount.Source+":"+option)
+		}
+		router.VolumeMounts = append(router.VolumeMounts, volumeMount)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: mount.Source,
+				},
+			},
+		})
+	}
+	if user, group, ok := strings.Cut(q.RunAs, ":"); ok {
+		uid, uidErr := strconv.ParseInt(user, 10, 64)
+		gid, gidErr := strconv.ParseInt(group, 10, 64)
+		if uidErr == nil && gidErr == nil {
+			router.SecurityContext = &corev1.SecurityContext{
+				RunAsUser:  &uid,
+				RunAsGroup: &gid,
+			}
+		}
+	}
+	annotations := map[string]string{
+		"io.containers.autoupdate": "registry",
+	}
+	if len(bindMountOptions) > 0 {
+		annotations[bindMountOptionsAnnotation] = strings.Join(bindMountOptions, ",")
+	}
+	pod := corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        podName,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: corev1.PodSpec{
+			HostNetwork:   true,
+			RestartPolicy: corev1.RestartPolicyAlways,
+			Containers:    []corev1.Container{router},
+			Volumes:       volumes,
+		},
+	}
+	return yaml.Marshal(pod)
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// QuadletService is a SystemdService that can also be started and
+// stopped on its own, as the router container only exists while its
+// service is running.
+type QuadletService interface {
+	SystemdService
+	Start() error
+	Stop() error
+}
+
+// quadletServiceInfo installs the Quadlet units of a namespace, so that
+// the router is run by the skupper-<namespace>.service unit generated
+// from them.
+type quadletServiceInfo struct {
+	units               *QuadletUnits
+	getUid              api.IdGetter
+	command             CommandExecutor
+	rootQuadletBasePath string
+}
+
+// NewQuadletServiceInfo returns the service through which the router of
+// a namespace is run from the given Quadlet units.
+func NewQuadletServiceInfo(units *QuadletUnits) (QuadletService, error) {
+	if !units.Format.IsQuadlet() {
+		return nil, fmt.Errorf("service format %q is not based on quadlet", units.Format)
+	}
+	return &quadletServiceInfo{
+		units:               units,
+		getUid:              os.Getuid,
+		command:             exec.Command,
+		rootQuadletBasePath: rootQuadletBasePath,
+	}, nil
+}
+
+func (s *quadletServiceInfo) GetServiceName() string {
+	return fmt.Sprintf("skupper-%s.service", s.units.Namespace)
+}
+
+func (s *quadletServiceInfo) GetServiceFile() string {
+	if api.IsRunningInContainer() {
+		return path.Join(api.GetInternalOutputPath(s.units.Namespace, api.ScriptsPath), s.units.UnitName())
+	}
+	if s.getUid() == 0 {
+		return path.Join(s.rootQuadletBasePath, s.units.UnitName())
+	}
+	return path.Join(api.GetConfigHome(), "containers/systemd", s.units.UnitName())
+}
+
+func (s *quadletServiceInfo) Create() error {
+	logger := NewLogger()
+	if s.units.Format == ServiceFormatKube {
+		pod, err := s.units.Pod()
+		if err != nil {
+			return err
+		}
+		podFile := path.Join(api.GetInternalOutputPath(s.units.Namespace, api.ScriptsPath), QuadletPodFile)
+		logger.Debug("writing pod file", slog.String("path", podFile))
+		if err = os.WriteFile(podFile, pod, 0644); err != nil {
+			return fmt.Errorf("unable to write pod file (%s): %w", podFile, err)
+		}
+	}
+	baseDir := filepath.Dir(s.GetServiceFile())
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return fmt.Errorf("unable to create base directory %s - %q", baseDir, err)
+	}
+	logger.Debug("writing quadlet unit", slog.String("path", s.GetServiceFile()))
+	if err := os.WriteFile(s.GetServiceFile(), s.units.Unit(), 0644); err != nil {
+		return fmt.Errorf("unable to write quadlet unit (%s): %w", s.GetServiceFile(), err)
+	}
+	if api.IsRunningInContainer() {
+		return nil
+	}
+	// units generated by quadlet cannot be enabled, they are started
+	// on boot through their [Install] section instead
+	if err := s.systemctl("daemon-reload").Run(); err != nil {
+		return fmt.Errorf("Unable to user service daemon-reload: %w", err)
+	}
+	if err := s.systemctl("restart", s.GetServiceName()).Run(); err != nil {
+		return fmt.Errorf("Unable to start user service: %w", err)
+	}
+	return nil
+}
+
+func (s *quadletServiceInfo) Start() error {
+	if api.IsRunningInContainer() {
+		return nil
+	}
+	return s.systemctl("start", s.GetServiceName()).Run()
+}
+
+// Stop stops the service, which removes the router container.
+func (s *quadletServiceInfo) Stop() error {
+	if api.IsRunningInContainer() {
+		return nil
+	}
+	return s.systemctl("stop", s.GetServiceName()).Run()
+}
+
+func (s *quadletServiceInfo) Remove() error {
+	logger := NewLogger()
+	if !api.IsRunningInContainer() {
+		logger.Debug("stopping service", slog.String("name", s.GetServiceName()))
+		_ = s.Stop()
+	}
+	logger.Debug("removing quadlet unit", slog.String("path", s.GetServiceFile()))
+	_ = os.Remove(s.GetServiceFile())
+	if !api.IsRunningInContainer() {
+		logger.Debug("reloading systemd daemon")
+		_ = s.systemctl("daemon-reload").Run()
+		_ = s.systemctl("reset-failed", s.GetServiceName()).Run()
+	}
+	return nil
+}
+
+func (s *quadletServiceInfo) systemctl(args ...string) *exec.Cmd {
+	if s.getUid() != 0 {
+		args = append([]string{"--user"}, args...)
+	}
+	return s.command("systemctl", args...)
+}
@@ -0,0 +1,215 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/utils"
+	"github.com/skupperproject/skupper/pkg/container"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func fakeQuadletUnits(format ServiceFormat) *QuadletUnits {
+	return &QuadletUnits{
+		Namespace: "default",
+		Format:    format,
+		Router: container.Container{
+			Name:  "default-skupper-router",
+			Image: "quay.io/skupper/skupper-router:main",
+			Env: map[string]string{
+				"QDROUTERD_CONF": "/etc/skupper-router/config/skrouterd.json",
+			},
+			FileMounts: []container.FileMount{
+				{
+					Source:      "/skupper/namespaces/default/runtime/router",
+					Destination: "/etc/skupper-router/config",
+					Options:     []string{"z"},
+				},
+			},
+		},
+		RunAs:        "1000:1000",
+		UserNS:       "keep-id",
+		LocalPort:    "5671",
+		SiteHomePath: "/skupper/namespaces/default",
+	}
+}
+
+func TestQuadletUnits(t *testing.T) {
+	tests := []struct {
+		name             string
+		format           ServiceFormat
+		expectedUnitName string
+		expectedLines    []string
+	}{
+		{
+			name:             "quadlet",
+			format:           ServiceFormatQuadlet,
+			expectedUnitName: "skupper-default.container",
+			expectedLines: []string{
+				"RequiresMountsFor=/skupper/namespaces/default",
+				"[Container]",
+				"ContainerName=default-skupper-router",
+				"Image=quay.io/skupper/skupper-router:main",
+				"AutoUpdate=registry",
+				"User=1000:1000",
+				"UserNS=keep-id",
+				"Environment=QDROUTERD_CONF=/etc/skupper-router/config/skrouterd.json",
+				"Volume=/skupper/namespaces/default/runtime/router:/etc/skupper-router/config:z",
+				"HealthCmd=skstat -g -b amqps://127.0.0.1:5671",
+				"HealthOnFailure=kill",
+				"Restart=always",
+				"WantedBy=default.target",
+			},
+		},
+		{
+			name:             "kube",
+			format:           ServiceFormatKube,
+			expectedUnitName: "skupper-default.kube",
+			expectedLines: []string{
+				"RequiresMountsFor=/skupper/namespaces/default",
+				"[Kube]",
+				"Yaml=/skupper/namespaces/default/internal/scripts/skupper-router-pod.yaml",
+				"UserNS=keep-id",
+				"AutoUpdate=registry",
+				"Restart=always",
+				"WantedBy=default.target",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			units := fakeQuadletUnits(test.format)
+			assert.Equal(t, units.UnitName(), test.expectedUnitName)
+			unit := string(units.Unit())
+			for _, line := range test.expectedLines {
+				assert.Assert(t, strings.Contains(unit, line), "%q not found in:\n%s", line, unit)
+			}
+		})
+	}
+}
+
+func TestQuadletUnitsPod(t *testing.T) {
+	units := fakeQuadletUnits(ServiceFormatKube)
+	data, err := units.Pod()
+	assert.Assert(t, err)
+	pod := corev1.Pod{}
+	assert.Assert(t, yaml.Unmarshal(data, &pod))
+	assert.Equal(t, pod.Name, "default-skupper")
+	assert.Equal(t, pod.Annotations["io.containers.autoupdate"], "registry")
+	assert.Assert(t, pod.Spec.HostNetwork)
+	assert.Equal(t, len(pod.Spec.Containers), 1)
+	router := pod.Spec.Containers[0]
+	assert.Equal(t, router.Name, "router")
+	assert.Equal(t, router.Image, "quay.io/skupper/skupper-router:main")
+	assert.DeepEqual(t, router.LivenessProbe.Exec.Command, units.HealthCommand())
+	assert.Equal(t, *router.SecurityContext.RunAsUser, int64(1000))
+	assert.Equal(t, len(pod.Spec.Volumes), 1)
+	assert.Equal(t, pod.Spec.Volumes[0].HostPath.Path, "/skupper/namespaces/default/runtime/router")
+	assert.Equal(t, router.VolumeMounts[0].MountPath, "/etc/skupper-router/config")
+	assert.Equal(t, router.VolumeMounts[0].ReadOnly, false)
+	assert.Equal(t, pod.Annotations["bind-mount-options"], "/skupper/namespaces/default/runtime/router:z")
+
+	// read only mounts are marked as such on the volume mount
+	units.Router.FileMounts = append(units.Router.FileMounts, container.FileMount{
+		Source:      "/skupper/namespaces/default/runtime/certs",
+		Destination: "/etc/skupper-router/runtime/certs",
+		Options:     []string{"ro", "Z"},
+	})
+	data, err = units.Pod()
+	assert.Assert(t, err)
+	pod = corev1.Pod{}
+	assert.Assert(t, yaml.Unmarshal(data, &pod))
+	assert.Equal(t, pod.Spec.Containers[0].VolumeMounts[1].ReadOnly, true)
+	assert.Equal(t, pod.Annotations["bind-mount-options"],
+		"/skupper/namespaces/default/runtime/router:z,/skupper/namespaces/default/runtime/certs:Z")
+
+	// template variables used by bundles are not numeric
+	units.RunAs = "{{.RunAs}}"
+	data, err = units.Pod()
+	assert.Assert(t, err)
+	bundlePod := corev1.Pod{}
+	assert.Assert(t, yaml.Unmarshal(data, &bundlePod))
+	assert.Assert(t, bundlePod.Spec.Containers[0].SecurityContext == nil)
+}
+
+func TestQuadletService(t *testing.T) {
+	if api.IsRunningInContainer() {
+		t.Skip("quadlet units are only installed on the host")
+	}
+	outputPath := t.TempDir()
+	t.Setenv("SKUPPER_OUTPUT_PATH", outputPath)
+	t.Setenv("XDG_CONFIG_HOME", outputPath)
+
+	_, err := NewQuadletServiceInfo(fakeQuadletUnits(ServiceFormatSystemd))
+	assert.ErrorContains(t, err, `service format "systemd" is not based on quadlet`)
+
+	for _, format := range []ServiceFormat{ServiceFormatQuadlet, ServiceFormatKube} {
+		for _, uid := range []int{0, 1000} {
+			t.Run(fmt.Sprintf("%s-as-uid-%d", format, uid), func(t *testing.T) {
+				units := fakeQuadletUnits(format)
+				assert.Assert(t, os.MkdirAll(path.Join(outputPath, "namespaces/default/internal/scripts"), 0755))
+				service, err := NewQuadletServiceInfo(units)
+				assert.Assert(t, err)
+				assert.Equal(t, service.GetServiceName(), "skupper-default.service")
+				serviceImpl := service.(*quadletServiceInfo)
+				var commands []string
+				serviceImpl.command = func(name string, arg ...string) *exec.Cmd {
+					assert.Assert(t, utils.StringSliceContains(arg, "--user") == (uid != 0))
+					commands = append(commands, strings.Join(arg, " "))
+					return exec.Command("echo", "mock")
+				}
+				serviceImpl.getUid = func() int {
+					return uid
+				}
+				serviceImpl.rootQuadletBasePath = path.Join(outputPath, "etc")
+				expectedFile := path.Join(outputPath, "containers/systemd", units.UnitName())
+				if uid == 0 {
+					expectedFile = path.Join(outputPath, "etc", units.UnitName())
+				}
+				assert.Equal(t, service.GetServiceFile(), expectedFile)
+
+				assert.Assert(t, service.Create())
+				unit, err := os.ReadFile(service.GetServiceFile())
+				assert.Assert(t, err)
+				assert.DeepEqual(t, unit, units.Unit())
+				_, err = os.Stat(path.Join(outputPath, "namespaces/default/internal/scripts", QuadletPodFile))
+				assert.Equal(t, err == nil, format == ServiceFormatKube)
+
+				assert.Assert(t, service.Remove())
+				_, err = os.Stat(service.GetServiceFile())
+				assert.Assert(t, os.IsNotExist(err))
+				assert.Assert(t, utils.StringSliceContains(commands, "restart skupper-default.service") ||
+					utils.StringSliceContains(commands, "--user restart skupper-default.service"))
+				assert.Assert(t, os.RemoveAll(path.Join(outputPath, "namespaces")))
+			})
+		}
+	}
+}
+
+func TestSystemConfig(t *testing.T) {
+	if os.Getuid() == 0 {
+		rootDataHomeOrig := api.DefaultRootDataHome
+		defer func() {
+			api.DefaultRootDataHome = rootDataHomeOrig
+		}()
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+	systemConfig, err := LoadSystemConfig()
+	assert.Assert(t, err)
+	assert.Equal(t, systemConfig.ServiceFormat, ServiceFormatSystemd)
+
+	systemConfig.ServiceFormat = ServiceFormatKube
+	assert.Assert(t, systemConfig.Save())
+	systemConfig, err = LoadSystemConfig()
+	assert.Assert(t, err)
+	assert.Equal(t, systemConfig.ServiceFormat, ServiceFormatKube)
+}
@@ -0,0 +1,81 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"sigs.k8s.io/yaml"
+)
+
+type ServiceFormat string
+
+const (
+	// ServiceFormatSystemd runs the router container created by skupper
+	// through a classic systemd unit and start/stop scripts.
+	ServiceFormatSystemd ServiceFormat = "systemd"
+	// ServiceFormatQuadlet describes the router container as a Quadlet
+	// .container unit.
+	ServiceFormatQuadlet ServiceFormat = "quadlet"
+	// ServiceFormatKube describes the router as a Pod to be run through
+	// podman kube play, from a Quadlet .kube unit.
+	ServiceFormatKube ServiceFormat = "kube"
+)
+
+var ServiceFormats = []string{
+	string(ServiceFormatSystemd),
+	string(ServiceFormatQuadlet),
+	string(ServiceFormatKube),
+}
+
+func IsValidServiceFormat(format string) bool {
+	return slices.Contains(ServiceFormats, format)
+}
+
+// IsQuadlet returns true for the formats in which the router container
+// is created by podman's Quadlet generator, instead of by skupper.
+func (f ServiceFormat) IsQuadlet() bool {
+	return f == ServiceFormatQuadlet || f == ServiceFormatKube
+}
+
+// SystemConfig holds the settings recorded by "skupper system install",
+// which apply to all namespaces of the current user.
+type SystemConfig struct {
+	ServiceFormat ServiceFormat `json:"serviceFormat,omitempty"`
+}
+
+func getSystemConfigFile() string {
+	return path.Join(path.Dir(api.GetDefaultOutputNamespacesPath()), "system.yaml")
+}
+
+// LoadSystemConfig returns the recorded system configuration, or the
+// default one if nothing has been recorded.
+func LoadSystemConfig() (*SystemConfig, error) {
+	config := &SystemConfig{}
+	data, err := os.ReadFile(getSystemConfigFile())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read system configuration: %w", err)
+	} else if err == nil {
+		if err = yaml.Unmarshal(data, config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal system configuration: %w", err)
+		}
+	}
+	if config.ServiceFormat == "" {
+		config.ServiceFormat = ServiceFormatSystemd
+	}
+	return config, nil
+}
+
+func (c *SystemConfig) Save() error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	configFile := getSystemConfigFile()
+	if err = os.MkdirAll(path.Dir(configFile), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(configFile, data, 0644)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var (
@@ -21,7 +22,12 @@ const (
 	rfc1123Error = `a lowercase RFC 1123 name must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`
 )
 
-type SiteStateValidator struct{}
+type SiteStateValidator struct {
+	// SelectorConnectors allows connectors to select the containers
+	// they target by label, instead of having a fixed host. It is only
+	// supported when the site runs on a container engine.
+	SelectorConnectors bool
+}
 
 // Validate provides a common validation for non-kubernetes sites
 // which do not benefit from the Kubernetes API. The goal is not
@@ -158,6 +164,15 @@ func (s *SiteStateValidator) validateConnectors(connectors map[string]*v2alpha1.
 		if err := ValidateName(connector.Name); err != nil {
 			return fmt.Errorf("invalid connector name: %w", err)
 		}
+		if connector.Spec.Selector != "" {
+			if err := s.validateConnectorSelector(connector); err != nil {
+				return err
+			}
+			if connector.Spec.RoutingKey == "" {
+				return fmt.Errorf("routingKey is missing for connector: %s", connector.Name)
+			}
+			continue
+		}
 		if connector.Spec.Host == "" || connector.Spec.Port == 0 {
 			return fmt.Errorf("connector host and port are required (connector: %q)", connector.Name)
 		}
@@ -173,6 +188,22 @@ func (s *SiteStateValidator) validateConnectors(connectors map[string]*v2alpha1.
 	return nil
 }
 
+func (s *SiteStateValidator) validateConnectorSelector(connector *v2alpha1.Connector) error {
+	if !s.SelectorConnectors {
+		return fmt.Errorf("connector selector is only supported on podman and docker sites (connector: %q)", connector.Name)
+	}
+	if connector.Spec.Host != "" {
+		return fmt.Errorf("connector host and selector are mutually exclusive (connector: %q)", connector.Name)
+	}
+	if connector.Spec.Port == 0 {
+		return fmt.Errorf("connector port is required (connector: %q)", connector.Name)
+	}
+	if _, err := labels.Parse(connector.Spec.Selector); err != nil {
+		return fmt.Errorf("invalid connector selector: %w (connector: %q)", err, connector.Name)
+	}
+	return nil
+}
+
 func ValidateName(name string) error {
 	if !rfc1123Regex.MatchString(name) {
 		return fmt.Errorf("invalid name %q: %s", name, rfc1123Error)
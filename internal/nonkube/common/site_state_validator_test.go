@@ -12,10 +12,11 @@ import (
 
 func TestSiteStateValidator_Validate(t *testing.T) {
 	tests := []struct {
-		info          string
-		siteState     *api.SiteState
-		valid         bool
-		errorContains string
+		info               string
+		siteState          *api.SiteState
+		selectorConnectors bool
+		valid              bool
+		errorContains      string
 	}{
 		{
 			info: "invalid-site-name",
@@ -201,6 +202,64 @@ func TestSiteStateValidator_Validate(t *testing.T) {
 			valid:         false,
 			errorContains: "invalid connector host: ",
 		},
+		{
+			info: "invalid-connector-selector-unsupported",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Selector = "app=backend"
+				}
+			}),
+			valid:         false,
+			errorContains: "connector selector is only supported on podman and docker sites",
+		},
+		{
+			info: "invalid-connector-selector-and-host",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Selector = "app=backend"
+				}
+			}),
+			selectorConnectors: true,
+			valid:              false,
+			errorContains:      "connector host and selector are mutually exclusive",
+		},
+		{
+			info: "invalid-connector-selector-port",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Port = 0
+					connector.Spec.Selector = "app=backend"
+				}
+			}),
+			selectorConnectors: true,
+			valid:              false,
+			errorContains:      "connector port is required",
+		},
+		{
+			info: "invalid-connector-selector",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Selector = "app==backend=="
+				}
+			}),
+			selectorConnectors: true,
+			valid:              false,
+			errorContains:      "invalid connector selector: ",
+		},
+		{
+			info: "valid-connector-selector",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Selector = "app=backend,tier in (web)"
+				}
+			}),
+			selectorConnectors: true,
+			valid:              true,
+		},
 		{
 			info: "invalid-claim-name",
 			siteState: customize(func(siteState *api.SiteState) {
@@ -239,9 +298,11 @@ func TestSiteStateValidator_Validate(t *testing.T) {
 			valid:     true,
 		},
 	}
-	validator := &SiteStateValidator{}
 	for _, test := range tests {
 		t.Run(test.info, func(t *testing.T) {
+			validator := &SiteStateValidator{
+				SelectorConnectors: test.selectorConnectors,
+			}
 			err := validator.Validate(test.siteState)
 			assert.Equal(t, err == nil, test.valid, err)
 			if !test.valid {
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/skupperproject/skupper/api/types"
@@ -25,13 +26,16 @@ type SiteStateRenderer struct {
 	containers        map[string]container.Container
 	stoppedContainers map[string]string
 	Platform          types.Platform
+	ServiceFormat     common.ServiceFormat
 	cli               *internalclient.CompatClient
 }
 
 func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool) error {
 	var err error
 	var logger = common.NewLogger()
-	var validator api.SiteStateValidator = &common.SiteStateValidator{}
+	var validator api.SiteStateValidator = &common.SiteStateValidator{
+		SelectorConnectors: true,
+	}
 	err = validator.Validate(loadedSiteState)
 	if err != nil {
 		return err
@@ -96,6 +100,15 @@ func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool)
 				)
 			}
 		}
+		if s.ServiceFormat.IsQuadlet() {
+			// the service removed the router container when stopped
+			if err := s.startQuadletService(loadedSiteState); err != nil {
+				logger.Error("Error starting service",
+					slog.String("namespace", loadedSiteState.GetNamespace()),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
 	}()
 
 	if reload {
@@ -107,6 +120,11 @@ func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool)
 		if err != nil {
 			return err
 		}
+		if s.ServiceFormat.IsQuadlet() {
+			if err = s.stopQuadletService(loadedSiteState); err != nil {
+				return fmt.Errorf("failed to stop service: %v", err)
+			}
+		}
 		err = s.cleanupExistingNamespace(loadedSiteState)
 		if err != nil {
 			return err
@@ -132,7 +150,8 @@ func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool)
 		platform = types.PlatformDocker
 	}
 	s.configRenderer = &common.FileSystemConfigurationRenderer{
-		Platform: string(platform),
+		Platform:      string(platform),
+		ServiceFormat: s.ServiceFormat,
 	}
 	err = s.configRenderer.Render(s.siteState)
 	if err != nil {
@@ -152,11 +171,14 @@ func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool)
 	if err = s.pullImages(ctx); err != nil {
 		return err
 	}
-	if err = s.createContainers(); err != nil {
-		return err
-	}
-	if err = s.startContainers(); err != nil {
-		return err
+	// with quadlet, the router container is created by the service
+	if !s.ServiceFormat.IsQuadlet() {
+		if err = s.createContainers(); err != nil {
+			return err
+		}
+		if err = s.startContainers(); err != nil {
+			return err
+		}
 	}
 
 	// Create systemd service and scripts
@@ -323,6 +345,9 @@ func (s *SiteStateRenderer) startContainers() error {
 }
 
 func (s *SiteStateRenderer) createSystemdService() error {
+	if s.ServiceFormat.IsQuadlet() {
+		return s.createQuadletService()
+	}
 	// Creating startup scripts first
 	platform := types.PlatformPodman
 	if s.Platform == types.PlatformDocker {
@@ -362,6 +387,60 @@ func (s *SiteStateRenderer) createSystemdService() error {
 	return nil
 }
 
+func (s *SiteStateRenderer) quadletUnits(siteState *api.SiteState) *common.QuadletUnits {
+	units := &common.QuadletUnits{
+		Namespace:    siteState.GetNamespace(),
+		Format:       s.ServiceFormat,
+		RunAs:        fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()),
+		SiteHomePath: api.GetHostSiteHome(siteState.Site),
+	}
+	if os.Getuid() != 0 {
+		units.UserNS = "keep-id"
+	}
+	if router, ok := s.containers[types.RouterComponent]; ok {
+		units.Router = router
+	}
+	if ra, ok := siteState.RouterAccesses["skupper-local"]; ok {
+		if role := ra.FindRole("normal"); role != nil {
+			units.LocalPort = strconv.Itoa(role.Port)
+		}
+	}
+	return units
+}
+
+func (s *SiteStateRenderer) createQuadletService() error {
+	service, err := common.NewQuadletServiceInfo(s.quadletUnits(s.siteState))
+	if err != nil {
+		return err
+	}
+	if err = service.Create(); err != nil {
+		return fmt.Errorf("unable to create startup service %q - %v\n", service.GetServiceName(), err)
+	}
+	if !api.IsRunningInContainer() {
+		username := utils.ReadUsername()
+		if os.Getuid() != 0 && !common.IsLingeringEnabled(username) {
+			fmt.Printf("It is recommended to enable lingering for %s, otherwise Skupper may not start on boot.\n", username)
+		}
+	}
+	return nil
+}
+
+func (s *SiteStateRenderer) stopQuadletService(siteState *api.SiteState) error {
+	service, err := common.NewQuadletServiceInfo(s.quadletUnits(siteState))
+	if err != nil {
+		return err
+	}
+	return service.Stop()
+}
+
+func (s *SiteStateRenderer) startQuadletService(siteState *api.SiteState) error {
+	service, err := common.NewQuadletServiceInfo(s.quadletUnits(siteState))
+	if err != nil {
+		return err
+	}
+	return service.Start()
+}
+
 func (s *SiteStateRenderer) preventContainersConflict() error {
 	runtimeStatePath := api.GetInternalOutputPath(s.loadedSiteState.GetNamespace(), api.RuntimeSiteStatePath)
 	_, err := os.Stat(runtimeStatePath)
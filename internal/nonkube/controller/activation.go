@@ -1,7 +1,32 @@
 package controller
 
+import "strings"
+
 type ActivationCallback interface {
 	Start(stopCh <-chan struct{})
 	Stop()
 	Id() string
 }
+
+// ActivationCallbacks notifies each of the given callbacks in turn.
+type ActivationCallbacks []ActivationCallback
+
+func (c ActivationCallbacks) Start(stopCh <-chan struct{}) {
+	for _, callback := range c {
+		callback.Start(stopCh)
+	}
+}
+
+func (c ActivationCallbacks) Stop() {
+	for _, callback := range c {
+		callback.Stop()
+	}
+}
+
+func (c ActivationCallbacks) Id() string {
+	var ids []string
+	for _, callback := range c {
+		ids = append(ids, callback.Id())
+	}
+	return strings.Join(ids, ",")
+}
@@ -0,0 +1,605 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/config"
+	iflag "github.com/skupperproject/skupper/internal/flag"
+	"github.com/skupperproject/skupper/internal/nonkube/bootstrap"
+	internalclient "github.com/skupperproject/skupper/internal/nonkube/client/compat"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// apiResourceKinds are the kinds of resources that can be managed
+// through the local API, along with the api version they belong to.
+var apiResourceKinds = map[string]string{
+	"Site":          v2alpha1.SchemeGroupVersion.String(),
+	"Listener":      v2alpha1.SchemeGroupVersion.String(),
+	"Connector":     v2alpha1.SchemeGroupVersion.String(),
+	"RouterAccess":  v2alpha1.SchemeGroupVersion.String(),
+	"Link":          v2alpha1.SchemeGroupVersion.String(),
+	"AccessGrant":   v2alpha1.SchemeGroupVersion.String(),
+	"AccessToken":   v2alpha1.SchemeGroupVersion.String(),
+	"Certificate":   v2alpha1.SchemeGroupVersion.String(),
+	"SecuredAccess": v2alpha1.SchemeGroupVersion.String(),
+	"Secret":        corev1.SchemeGroupVersion.String(),
+	"ConfigMap":     corev1.SchemeGroupVersion.String(),
+}
+
+type ApiConfig struct {
+	Enabled bool
+	Socket  string
+}
+
+func BoundApiConfig(flags *flag.FlagSet) (*ApiConfig, error) {
+	c := &ApiConfig{}
+	var errs []error
+	if err := iflag.BoolVar(flags, &c.Enabled, "enable-api", "SKUPPER_ENABLE_API", false, "Enable the local REST API through which namespaces can be managed."); err != nil {
+		errs = append(errs, err)
+	}
+	iflag.StringVar(flags, &c.Socket, "api-socket", "SKUPPER_API_SOCKET", api.GetDefaultOutputApiSocketPath(), "The path of the Unix socket on which the local REST API should listen.")
+	if len(errs) > 0 {
+		return c, fmt.Errorf("Invalid environment variable(s): %w", errors.Join(errs...))
+	}
+	return c, nil
+}
+
+// SiteOperations are the actions on the site of a namespace that the
+// local API can trigger.
+type SiteOperations interface {
+	Start(namespace string, platform types.Platform) error
+	Stop(namespace string) error
+	Reload(namespace string) error
+	RouterLogs(namespace string) (string, error)
+}
+
+// ApiServer serves the local REST API of the system controller on a
+// Unix socket. Resources are applied to and deleted from the input
+// directory of a namespace, as the CLI does, and are read back from the
+// runtime directory when available, so that their status is included.
+type ApiServer struct {
+	config     *ApiConfig
+	namespaces func() []string
+	operations SiteOperations
+	logs       *LogBuffer
+	server     *http.Server
+	mutex      sync.Mutex
+	logger     *slog.Logger
+}
+
+func NewApiServer(config *ApiConfig, namespaces func() []string) *ApiServer {
+	s := &ApiServer{
+		config:     config,
+		namespaces: namespaces,
+		operations: &bootstrapOperations{},
+		logs:       NewLogBuffer(defaultLogBufferLines),
+		logger:     slog.Default().With("component", "api.server"),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/logs", s.controllerLogs)
+	mux.HandleFunc("GET /v1/namespaces", s.listNamespaces)
+	mux.HandleFunc("GET /v1/namespaces/{namespace}/sites", s.listSites)
+	mux.HandleFunc("POST /v1/namespaces/{namespace}/resources", s.applyResources)
+	mux.HandleFunc("GET /v1/namespaces/{namespace}/resources/{kind}", s.listResources)
+	mux.HandleFunc("GET /v1/namespaces/{namespace}/resources/{kind}/{name}", s.getResource)
+	mux.HandleFunc("DELETE /v1/namespaces/{namespace}/resources/{kind}/{name}", s.deleteResource)
+	mux.HandleFunc("GET /v1/namespaces/{namespace}/logs", s.routerLogs)
+	mux.HandleFunc("POST /v1/namespaces/{namespace}/start", s.start)
+	mux.HandleFunc("POST /v1/namespaces/{namespace}/stop", s.stop)
+	mux.HandleFunc("POST /v1/namespaces/{namespace}/reload", s.reload)
+	s.server = &http.Server{
+		Handler: mux,
+	}
+	return s
+}
+
+// Start listens on the configured socket, replacing any stale socket
+// left behind by a previous instance, and starts retaining the logs of
+// the controller so that they can be served.
+func (s *ApiServer) Start() error {
+	if err := os.MkdirAll(path.Dir(s.config.Socket), 0755); err != nil {
+		return err
+	}
+	if err := os.Remove(s.config.Socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.Listen("unix", s.config.Socket)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(s.config.Socket, 0600); err != nil {
+		listener.Close()
+		return err
+	}
+	log.SetOutput(io.MultiWriter(log.Writer(), s.logs))
+	s.logger.Info("Starting api server", slog.String("socket", s.config.Socket))
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Api server failed", slog.Any("error", err))
+		}
+	}()
+	return nil
+}
+
+func (s *ApiServer) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.server.Close()
+	}
+	os.Remove(s.config.Socket)
+}
+
+func (s *ApiServer) listNamespaces(w http.ResponseWriter, r *http.Request) {
+	names := s.namespaces()
+	slices.Sort(names)
+	namespaces := []api.NamespaceInfo{}
+	for _, name := range names {
+		namespace := api.NamespaceInfo{
+			Name: name,
+		}
+		platformLoader := &common.NamespacePlatformLoader{}
+		if platform, err := platformLoader.Load(name); err == nil {
+			namespace.Platform = platform
+		}
+		if _, err := os.Stat(api.GetInternalOutputPath(name, api.RuntimeSiteStatePath)); err == nil {
+			namespace.Active = true
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	writeJson(w, http.StatusOK, namespaces)
+}
+
+func (s *ApiServer) listSites(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	s.writeResources(w, namespace, "Site")
+}
+
+func (s *ApiServer) listResources(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	kind, ok := resourceKind(w, r)
+	if !ok {
+		return
+	}
+	s.writeResources(w, namespace, kind)
+}
+
+func (s *ApiServer) writeResources(w http.ResponseWriter, namespace string, kind string) {
+	resources, err := readResources(namespace, kind)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJson(w, http.StatusOK, resources)
+}
+
+func (s *ApiServer) getResource(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	kind, ok := resourceKind(w, r)
+	if !ok {
+		return
+	}
+	name, ok := resourceName(w, r)
+	if !ok {
+		return
+	}
+	resource, err := readResource(namespace, kind, name)
+	if os.IsNotExist(err) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%s %s does not exist in namespace %s", kind, name, namespace))
+		return
+	} else if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJson(w, http.StatusOK, resource)
+}
+
+// applyResources writes the resources in the request body, given as
+// one or more YAML or JSON documents, to the input directory of the
+// namespace. All resources are validated before any is written.
+func (s *ApiServer) applyResources(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := validNamespace(w, r)
+	if !ok {
+		return
+	}
+	var resources []*unstructured.Unstructured
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bufio.NewReader(r.Body), 4096)
+	for {
+		resource := &unstructured.Unstructured{}
+		if err := decoder.Decode(&resource.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			writeError(w, http.StatusBadRequest, fmt.Errorf("error decoding resources: %w", err))
+			return
+		}
+		if len(resource.Object) == 0 {
+			continue
+		}
+		if err := validateResource(namespace, resource); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		resource.SetNamespace(namespace)
+		resources = append(resources, resource)
+	}
+	if len(resources) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("no resources found in request"))
+		return
+	}
+	inputPath := api.GetInternalOutputPath(namespace, api.InputSiteStatePath)
+	applied := []api.ResourceRef{}
+	for _, resource := range resources {
+		if err := api.MarshalResource(inputPath, resource.GetKind(), resource.GetName(), resource); err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		applied = append(applied, api.ResourceRef{Kind: resource.GetKind(), Name: resource.GetName()})
+	}
+	s.logger.Info("Resources applied", slog.String("namespace", namespace), slog.Int("count", len(applied)))
+	writeJson(w, http.StatusOK, applied)
+}
+
+func (s *ApiServer) deleteResource(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	kind, ok := resourceKind(w, r)
+	if !ok {
+		return
+	}
+	name, ok := resourceName(w, r)
+	if !ok {
+		return
+	}
+	fileName := path.Join(api.GetInternalOutputPath(namespace, api.InputSiteStatePath), api.ResourceFileName(kind, name))
+	if err := os.Remove(fileName); os.IsNotExist(err) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%s %s does not exist in namespace %s", kind, name, namespace))
+		return
+	} else if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	s.logger.Info("Resource deleted", slog.String("namespace", namespace), slog.String("kind", kind), slog.String("name", name))
+	writeJson(w, http.StatusOK, api.ResourceRef{Kind: kind, Name: name})
+}
+
+func (s *ApiServer) start(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	platform := config.GetPlatform()
+	if value := r.URL.Query().Get("platform"); value != "" {
+		platform = types.Platform(value)
+		if !slices.Contains([]types.Platform{types.PlatformPodman, types.PlatformDocker, types.PlatformLinux}, platform) {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("platform %q is not supported", value))
+			return
+		}
+	}
+	if platform.IsKubernetes() {
+		platform = types.PlatformPodman
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, err := os.Stat(api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)); err == nil {
+		writeError(w, http.StatusConflict, fmt.Errorf("namespace already exists: %s", namespace))
+		return
+	}
+	if !hasInputResources(namespace) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("no resources defined in namespace %s", namespace))
+		return
+	}
+	s.operate(w, "start", namespace, func() error {
+		return s.operations.Start(namespace, platform)
+	})
+}
+
+func (s *ApiServer) stop(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.operate(w, "stop", namespace, func() error {
+		return s.operations.Stop(namespace)
+	})
+}
+
+func (s *ApiServer) reload(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !hasInputResources(namespace) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("no resources defined in namespace %s", namespace))
+		return
+	}
+	s.operate(w, "reload", namespace, func() error {
+		return s.operations.Reload(namespace)
+	})
+}
+
+func (s *ApiServer) operate(w http.ResponseWriter, operation string, namespace string, fn func() error) {
+	s.logger.Info("Running operation", slog.String("operation", operation), slog.String("namespace", namespace))
+	if err := fn(); err != nil {
+		s.logger.Error("Operation failed",
+			slog.String("operation", operation),
+			slog.String("namespace", namespace),
+			slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *ApiServer) routerLogs(w http.ResponseWriter, r *http.Request) {
+	namespace, ok := existingNamespace(w, r)
+	if !ok {
+		return
+	}
+	logs, err := s.operations.RouterLogs(namespace)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	io.WriteString(w, logs)
+}
+
+// controllerLogs writes the retained logs of the controller and, when
+// follow is requested, keeps streaming them until the client goes away
+// or the server is stopped.
+func (s *ApiServer) controllerLogs(w http.ResponseWriter, r *http.Request) {
+	tail := 0
+	if value := r.URL.Query().Get("tail"); value != "" {
+		var err error
+		if tail, err = strconv.Atoi(value); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid tail %q: %w", value, err))
+			return
+		}
+	}
+	follow, _ := strconv.ParseBool(r.URL.Query().Get("follow"))
+	w.Header().Set("Content-Type", "text/plain")
+	if !follow {
+		for _, line := range s.logs.Lines(tail) {
+			fmt.Fprintln(w, line)
+		}
+		return
+	}
+	lines, updates, cancel := s.logs.Follow(tail)
+	defer cancel()
+	flusher, _ := w.(http.Flusher)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	for {
+		if flusher != nil {
+			flusher.Flush()
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case line := <-updates:
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readResources returns the resources of the given kind, as found in
+// the runtime directory of the namespace or, for those not rendered
+// yet, in its input directory.
+func readResources(namespace string, kind string) ([]json.RawMessage, error) {
+	names := map[string]bool{}
+	for _, dir := range []api.InternalPath{api.RuntimeSiteStatePath, api.InputSiteStatePath} {
+		files, err := filepath.Glob(path.Join(api.GetInternalOutputPath(namespace, dir), api.ResourceFileName(kind, "*")))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			name := strings.TrimSuffix(strings.TrimPrefix(path.Base(file), kind+"-"), ".yaml")
+			names[name] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	slices.Sort(sorted)
+	resources := []json.RawMessage{}
+	for _, name := range sorted {
+		resource, err := readResource(namespace, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func readResource(namespace string, kind string, name string) (json.RawMessage, error) {
+	fileName := api.ResourceFileName(kind, name)
+	data, err := os.ReadFile(path.Join(api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath), fileName))
+	if os.IsNotExist(err) {
+		data, err = os.ReadFile(path.Join(api.GetInternalOutputPath(namespace, api.InputSiteStatePath), fileName))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return yaml.YAMLToJSON(data)
+}
+
+func validateResource(namespace string, resource *unstructured.Unstructured) error {
+	kind := resource.GetKind()
+	apiVersion, ok := apiResourceKinds[kind]
+	if !ok {
+		return fmt.Errorf("unsupported resource kind %q", kind)
+	}
+	if resource.GetAPIVersion() != apiVersion {
+		return fmt.Errorf("%s must have apiVersion %s", kind, apiVersion)
+	}
+	if ok, err := validator.NewResourceStringValidator().Evaluate(resource.GetName()); !ok {
+		return fmt.Errorf("%s name %q is not valid: %s", kind, resource.GetName(), err)
+	}
+	if resource.GetNamespace() != "" && resource.GetNamespace() != namespace {
+		return fmt.Errorf("%s %s belongs to namespace %q, not %q", kind, resource.GetName(), resource.GetNamespace(), namespace)
+	}
+	return nil
+}
+
+func hasInputResources(namespace string) bool {
+	entries, err := os.ReadDir(api.GetInternalOutputPath(namespace, api.InputSiteStatePath))
+	return err == nil && len(entries) > 0
+}
+
+func validNamespace(w http.ResponseWriter, r *http.Request) (string, bool) {
+	namespace := r.PathValue("namespace")
+	if ok, err := validator.NewResourceStringValidator().Evaluate(namespace); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("namespace %q is not valid: %s", namespace, err))
+		return "", false
+	}
+	return namespace, true
+}
+
+func existingNamespace(w http.ResponseWriter, r *http.Request) (string, bool) {
+	namespace, ok := validNamespace(w, r)
+	if !ok {
+		return "", false
+	}
+	if _, err := os.Stat(api.GetDefaultOutputPath(namespace)); err != nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("namespace %s does not exist", namespace))
+		return "", false
+	}
+	return namespace, true
+}
+
+func resourceKind(w http.ResponseWriter, r *http.Request) (string, bool) {
+	kind := r.PathValue("kind")
+	if _, ok := apiResourceKinds[kind]; !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unsupported resource kind %q", kind))
+		return "", false
+	}
+	return kind, true
+}
+
+func resourceName(w http.ResponseWriter, r *http.Request) (string, bool) {
+	name := r.PathValue("name")
+	if ok, err := validator.NewResourceStringValidator().Evaluate(name); !ok {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("name %q is not valid: %s", name, err))
+		return "", false
+	}
+	return name, true
+}
+
+func writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJson(w, status, api.ApiError{Message: err.Error()})
+}
+
+// bootstrapOperations acts on sites the same way as the start, stop and
+// reload system commands of the CLI.
+type bootstrapOperations struct{}
+
+func (o *bootstrapOperations) Start(namespace string, platform types.Platform) error {
+	return o.bootstrap(namespace, platform)
+}
+
+func (o *bootstrapOperations) Stop(namespace string) error {
+	return bootstrap.Teardown(namespace)
+}
+
+func (o *bootstrapOperations) Reload(namespace string) error {
+	platformLoader := &common.NamespacePlatformLoader{}
+	platform, err := platformLoader.Load(namespace)
+	if err != nil {
+		return err
+	}
+	return o.bootstrap(namespace, types.Platform(platform))
+}
+
+func (o *bootstrapOperations) bootstrap(namespace string, platform types.Platform) error {
+	binary := "podman"
+	switch platform {
+	case types.PlatformLinux:
+		binary = "skrouterd"
+	case types.PlatformDocker:
+		binary = "docker"
+	}
+	_, err := bootstrap.Bootstrap(&bootstrap.Config{
+		InputPath: api.GetInternalOutputPath(namespace, api.InputSiteStatePath),
+		Namespace: namespace,
+		Platform:  platform,
+		Binary:    binary,
+	})
+	return err
+}
+
+func (o *bootstrapOperations) RouterLogs(namespace string) (string, error) {
+	platformLoader := &common.NamespacePlatformLoader{}
+	platform, err := platformLoader.Load(namespace)
+	if err != nil {
+		return "", err
+	}
+	if platform == string(types.PlatformLinux) {
+		return "", fmt.Errorf("router logs for namespace %s are kept by systemd", namespace)
+	}
+	endpoint := os.Getenv("CONTAINER_ENDPOINT")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("unix://%s/podman/podman.sock", api.GetRuntimeDir())
+		if platform == "docker" {
+			endpoint = "unix:///run/docker.sock"
+		}
+	}
+	cli, err := internalclient.NewCompatClient(endpoint, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to create container client: %v", err)
+	}
+	return cli.ContainerLogs(namespace + "-skupper-router")
+}
@@ -0,0 +1,284 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+type fakeSiteOperations struct {
+	operations []string
+}
+
+func (o *fakeSiteOperations) Start(namespace string, platform types.Platform) error {
+	o.operations = append(o.operations, "start "+namespace+" "+string(platform))
+	return nil
+}
+
+func (o *fakeSiteOperations) Stop(namespace string) error {
+	o.operations = append(o.operations, "stop "+namespace)
+	return nil
+}
+
+func (o *fakeSiteOperations) Reload(namespace string) error {
+	o.operations = append(o.operations, "reload "+namespace)
+	return nil
+}
+
+func (o *fakeSiteOperations) RouterLogs(namespace string) (string, error) {
+	return "router logs for " + namespace, nil
+}
+
+// apiTestClient sends requests to the api server over its socket
+type apiTestClient struct {
+	http *http.Client
+}
+
+func newApiTestClient(socket string) *apiTestClient {
+	return &apiTestClient{
+		http: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socket)
+				},
+			},
+		},
+	}
+}
+
+// do sends a request, decoding the response into result if given, or
+// returning the message of an error response as an error
+func (c *apiTestClient) do(method string, p string, body io.Reader, result interface{}) error {
+	return c.stream(context.Background(), method, p, body, func(response io.Reader) error {
+		if result == nil {
+			return nil
+		}
+		return json.NewDecoder(response).Decode(result)
+	})
+}
+
+func (c *apiTestClient) stream(ctx context.Context, method string, p string, body io.Reader, read func(io.Reader) error) error {
+	request, err := http.NewRequestWithContext(ctx, method, "http://localhost"+p, body)
+	if err != nil {
+		return err
+	}
+	response, err := c.http.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusBadRequest {
+		var apiError api.ApiError
+		if err := json.NewDecoder(response.Body).Decode(&apiError); err != nil {
+			return fmt.Errorf("request failed: %s", response.Status)
+		}
+		return fmt.Errorf("%s", apiError.Message)
+	}
+	if response.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return read(response.Body)
+}
+
+func (c *apiTestClient) apply(namespace string, content string) ([]api.ResourceRef, error) {
+	var applied []api.ResourceRef
+	err := c.do(http.MethodPost, "/v1/namespaces/"+namespace+"/resources", strings.NewReader(content), &applied)
+	return applied, err
+}
+
+func startApiServer(t *testing.T, namespaces ...string) (*ApiServer, *fakeSiteOperations, *apiTestClient) {
+	t.Helper()
+	writer := log.Writer()
+	t.Cleanup(func() { log.SetOutput(writer) })
+	socket := path.Join(t.TempDir(), "api.sock")
+	server := NewApiServer(&ApiConfig{Enabled: true, Socket: socket}, func() []string {
+		return append([]string(nil), namespaces...)
+	})
+	operations := &fakeSiteOperations{}
+	server.operations = operations
+	assert.Assert(t, server.Start())
+	t.Cleanup(server.Stop)
+	return server, operations, newApiTestClient(socket)
+}
+
+const apiTestListener = `apiVersion: skupper.io/v2alpha1
+kind: Listener
+metadata:
+  name: backend
+spec:
+  routingKey: backend
+  host: backend
+  port: 8080
+`
+
+func TestApiServerResources(t *testing.T) {
+	setDataHome(t)
+	_, _, client := startApiServer(t, "test")
+
+	applied, err := client.apply("test", apiTestListener)
+	assert.Assert(t, err)
+	assert.DeepEqual(t, applied, []api.ResourceRef{{Kind: "Listener", Name: "backend"}})
+
+	var namespaces []api.NamespaceInfo
+	assert.Assert(t, client.do(http.MethodGet, "/v1/namespaces", nil, &namespaces))
+	assert.DeepEqual(t, namespaces, []api.NamespaceInfo{{Name: "test"}})
+
+	var listener v2alpha1.Listener
+	assert.Assert(t, client.do(http.MethodGet, "/v1/namespaces/test/resources/Listener/backend", nil, &listener))
+	assert.Equal(t, listener.Namespace, "test")
+	assert.Equal(t, listener.Spec.Port, 8080)
+	assert.Equal(t, listener.Status.StatusType, v2alpha1.StatusType(""))
+
+	// once rendered, resources are read from the runtime directory
+	listener.SetConfigured(nil)
+	assert.Assert(t, api.MarshalResource(api.GetInternalOutputPath("test", api.RuntimeSiteStatePath), "Listener", "backend", &listener))
+	var listeners []v2alpha1.Listener
+	assert.Assert(t, client.do(http.MethodGet, "/v1/namespaces/test/resources/Listener", nil, &listeners))
+	assert.Equal(t, len(listeners), 1)
+	assert.Equal(t, listeners[0].Status.StatusType, v2alpha1.StatusPending)
+
+	assert.Assert(t, client.do(http.MethodDelete, "/v1/namespaces/test/resources/Listener/backend", nil, nil))
+	_, err = os.Stat(path.Join(api.GetInternalOutputPath("test", api.InputSiteStatePath), "Listener-backend.yaml"))
+	assert.Assert(t, os.IsNotExist(err))
+	assert.ErrorContains(t, client.do(http.MethodDelete, "/v1/namespaces/test/resources/Listener/backend", nil, nil), "Listener backend does not exist in namespace test")
+}
+
+func TestApiServerApplyErrors(t *testing.T) {
+	setDataHome(t)
+	_, _, client := startApiServer(t)
+	testTable := []struct {
+		name          string
+		namespace     string
+		content       string
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			namespace:     "test",
+			expectedError: "no resources found in request",
+		},
+		{
+			name:          "unsupported kind",
+			namespace:     "test",
+			content:       "apiVersion: v1\nkind: Pod\nmetadata:\n  name: pod\n",
+			expectedError: "unsupported resource kind \"Pod\"",
+		},
+		{
+			name:          "wrong api version",
+			namespace:     "test",
+			content:       "apiVersion: v1\nkind: Listener\nmetadata:\n  name: backend\n",
+			expectedError: "Listener must have apiVersion skupper.io/v2alpha1",
+		},
+		{
+			name:          "other namespace",
+			namespace:     "test",
+			content:       strings.Replace(apiTestListener, "name: backend", "name: backend\n  namespace: other", 1),
+			expectedError: "Listener backend belongs to namespace \"other\", not \"test\"",
+		},
+		{
+			name:          "invalid name",
+			namespace:     "test",
+			content:       strings.Replace(apiTestListener, "name: backend", "name: ../backend", 1),
+			expectedError: "Listener name \"../backend\" is not valid",
+		},
+		{
+			name:          "invalid namespace",
+			namespace:     "Test",
+			content:       apiTestListener,
+			expectedError: "namespace \"Test\" is not valid",
+		},
+	}
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := client.apply(test.namespace, test.content)
+			assert.ErrorContains(t, err, test.expectedError)
+		})
+	}
+	_, err := os.Stat(api.GetDefaultOutputPath("test"))
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func TestApiServerOperations(t *testing.T) {
+	setDataHome(t)
+	_, operations, client := startApiServer(t, "test")
+
+	assert.ErrorContains(t, client.do(http.MethodPost, "/v1/namespaces/test/start", nil, nil), "namespace test does not exist")
+	_, err := client.apply("test", apiTestListener)
+	assert.Assert(t, err)
+	assert.ErrorContains(t, client.do(http.MethodPost, "/v1/namespaces/test/start?platform=kubernetes-ish", nil, nil), "platform \"kubernetes-ish\" is not supported")
+	assert.Assert(t, client.do(http.MethodPost, "/v1/namespaces/test/start?platform=docker", nil, nil))
+	assert.Assert(t, client.do(http.MethodPost, "/v1/namespaces/test/reload", nil, nil))
+
+	siteState := fakeBridgeConfigSiteState()
+	assert.Assert(t, api.MarshalSiteState(*siteState, api.GetInternalOutputPath("test", api.RuntimeSiteStatePath)))
+	assert.ErrorContains(t, client.do(http.MethodPost, "/v1/namespaces/test/start?platform=docker", nil, nil), "namespace already exists: test")
+	var sites []v2alpha1.Site
+	assert.Assert(t, client.do(http.MethodGet, "/v1/namespaces/test/sites", nil, &sites))
+	assert.Equal(t, len(sites), 1)
+	assert.Equal(t, sites[0].Name, siteState.Site.Name)
+
+	assert.Assert(t, client.do(http.MethodPost, "/v1/namespaces/test/stop", nil, nil))
+	assert.DeepEqual(t, operations.operations, []string{"start test docker", "reload test", "stop test"})
+
+	logs := &bytes.Buffer{}
+	assert.Assert(t, client.stream(context.Background(), http.MethodGet, "/v1/namespaces/test/logs", nil, copyTo(logs)))
+	assert.Equal(t, logs.String(), "router logs for test")
+}
+
+func TestApiServerControllerLogs(t *testing.T) {
+	setDataHome(t)
+	_, _, client := startApiServer(t)
+	log.Print("first")
+	log.Print("second")
+
+	out := &bytes.Buffer{}
+	assert.Assert(t, client.stream(context.Background(), http.MethodGet, "/v1/logs?tail=1", nil, copyTo(out)))
+	assert.Assert(t, strings.HasSuffix(out.String(), "second\n"))
+	assert.Assert(t, !strings.Contains(out.String(), "first"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reader, writer, err := os.Pipe()
+	assert.Assert(t, err)
+	done := make(chan error)
+	go func() {
+		err := client.stream(ctx, http.MethodGet, "/v1/logs?tail=1&follow=true", nil, copyTo(writer))
+		if ctx.Err() != nil {
+			err = nil
+		}
+		done <- err
+		writer.Close()
+	}()
+	buffer := make([]byte, 1024)
+	n, err := reader.Read(buffer)
+	assert.Assert(t, err)
+	assert.Assert(t, strings.HasSuffix(string(buffer[:n]), "second\n"))
+	log.Print("third")
+	n, err = reader.Read(buffer)
+	assert.Assert(t, err)
+	assert.Assert(t, strings.HasSuffix(string(buffer[:n]), "third\n"))
+	cancel()
+	assert.Assert(t, <-done)
+}
+
+func copyTo(out io.Writer) func(io.Reader) error {
+	return func(response io.Reader) error {
+		_, err := io.Copy(out, response)
+		return err
+	}
+}
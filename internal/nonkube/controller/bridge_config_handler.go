@@ -0,0 +1,417 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/skupperproject/skupper/internal/nonkube/client/runtime"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	// changes to the input resources usually arrive as a burst of
+	// file events, so they are only acted on once things settle
+	bridgeConfigSyncDelay  = 2 * time.Second
+	bridgeConfigRetryDelay = 5 * time.Second
+)
+
+type BridgeConfigAgent interface {
+	GetLocalBridgeConfig() (*qdr.BridgeConfig, error)
+	UpdateLocalBridgeConfig(changes *qdr.BridgeConfigDifference) error
+	GetLocalConnectors() (map[string]qdr.Connector, error)
+	UpdateLocalConnectors(changes *qdr.ConnectorDifference) error
+	Close() error
+}
+
+// BridgeConfigHandler watches the input resources of a namespace and
+// applies changes to Listeners, Connectors and Links to the running
+// router through its management agent, so that they take effect without
+// the router being restarted. Any other change still requires the site
+// to be reloaded. Connectors with a selector are bound to the running
+// containers carrying matching labels, which are followed as they are
+// started and stopped.
+type BridgeConfigHandler struct {
+	namespace  string
+	stopCh     <-chan struct{}
+	logger     *slog.Logger
+	mutex      sync.Mutex
+	syncMutex  sync.Mutex
+	timer      *time.Timer
+	delay      time.Duration
+	retryDelay time.Duration
+	pending    []string
+	connect    func() (BridgeConfigAgent, error)
+	targets    *containerTargets
+}
+
+func NewBridgeConfigHandler(stopCh <-chan struct{}, namespace string) *BridgeConfigHandler {
+	handler := &BridgeConfigHandler{
+		namespace:  namespace,
+		stopCh:     stopCh,
+		delay:      bridgeConfigSyncDelay,
+		retryDelay: bridgeConfigRetryDelay,
+		logger: slog.Default().
+			With("component", "bridge.config.handler").
+			With("namespace", namespace),
+	}
+	handler.connect = handler.connectAgent
+	handler.targets = newContainerTargets(stopCh, namespace, func() {
+		handler.schedule(handler.delay)
+	})
+	return handler
+}
+
+func (h *BridgeConfigHandler) OnBasePathAdded(basePath string) {
+	// connectors with a selector need their targets to be resolved
+	// as soon as the controller starts
+	h.schedule(h.delay)
+}
+
+func (h *BridgeConfigHandler) OnCreate(name string) {
+	h.schedule(h.delay)
+}
+
+func (h *BridgeConfigHandler) OnUpdate(name string) {
+	h.schedule(h.delay)
+}
+
+func (h *BridgeConfigHandler) OnRemove(name string) {
+	h.schedule(h.delay)
+}
+
+func (h *BridgeConfigHandler) Filter(name string) bool {
+	return strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml")
+}
+
+func (h *BridgeConfigHandler) schedule(delay time.Duration) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.timer == nil {
+		h.timer = time.AfterFunc(delay, h.reconcile)
+	} else {
+		h.timer.Reset(delay)
+	}
+}
+
+func (h *BridgeConfigHandler) stopped() bool {
+	select {
+	case <-h.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *BridgeConfigHandler) reconcile() {
+	h.syncMutex.Lock()
+	defer h.syncMutex.Unlock()
+	if h.stopped() {
+		return
+	}
+	if err := h.sync(); err != nil {
+		h.logger.Error("Unable to apply changes to listeners, connectors and links, will retry",
+			slog.Any("error", err))
+		h.schedule(h.retryDelay)
+	}
+}
+
+// sync compares the input resources with those the site was last
+// rendered from and applies any changes that can be made to the router
+// while it is running. Only errors worth retrying are returned.
+func (h *BridgeConfigHandler) sync() error {
+	input, err := h.loadSiteState(api.InputSiteStatePath)
+	if err != nil {
+		h.logger.Debug("Ignoring input resources", slog.Any("error", err))
+		return nil
+	}
+	loaded, err := h.loadSiteState(api.LoadedSiteStatePath)
+	if err != nil {
+		// site has not been set up yet
+		return nil
+	}
+	h.targets.setConnectors(input.Connectors)
+	generation, targetsChanged := h.targets.pending()
+	reasons := changesRequiringReload(loaded, input)
+	if len(reasons) == 0 && !bindingsChanged(loaded, input) && !linksChanged(loaded, input) && !targetsChanged {
+		h.pending = nil
+		return nil
+	}
+	routerConfig, err := common.LoadRouterConfig(h.namespace)
+	if err != nil {
+		h.logger.Debug("Ignoring input resources", slog.Any("error", err))
+		return nil
+	}
+	active := common.CopySiteState(input)
+	desired := active.BridgeConfig()
+	h.targets.updateBridgeConfig(active.SiteId, active.Connectors, &desired)
+	desiredLinks := active.LinkConnectors(routerConfig.IsEdge())
+	for _, profile := range missingSslProfiles(desired, desiredLinks, routerConfig) {
+		reasons = append(reasons, "SslProfile "+profile)
+	}
+	if len(reasons) > 0 {
+		if !slices.Equal(reasons, h.pending) {
+			h.logger.Warn("Changes to input resources require the site to be reloaded with 'skupper system reload'",
+				slog.Any("changes", reasons))
+			h.pending = reasons
+		}
+		return nil
+	}
+	h.pending = nil
+
+	agent, err := h.connect()
+	if err != nil {
+		return err
+	}
+	defer agent.Close()
+	actual, err := agent.GetLocalBridgeConfig()
+	if err != nil {
+		return fmt.Errorf("error retrieving bridges: %w", err)
+	}
+	if differences := actual.Difference(&desired); !differences.Empty() {
+		if err := agent.UpdateLocalBridgeConfig(differences); err != nil {
+			return fmt.Errorf("error syncing bridges: %w", err)
+		}
+	}
+	actualConnectors, err := agent.GetLocalConnectors()
+	if err != nil {
+		return fmt.Errorf("error retrieving connectors: %w", err)
+	}
+	if differences := linksDifference(actualConnectors, routerConfig.Connectors, desiredLinks); !differences.Empty() {
+		if err := agent.UpdateLocalConnectors(differences); err != nil {
+			return fmt.Errorf("error syncing links: %w", err)
+		}
+	}
+	h.targets.setApplied(generation)
+
+	// the router configuration and site state must reflect what the
+	// router is now running, so that a restart does not undo the
+	// changes and they are not applied again
+	routerConfig.UpdateBridgeConfig(desired)
+	updateLinkConnectors(routerConfig, desiredLinks)
+	if err := h.writeRouterConfig(routerConfig); err != nil {
+		h.logger.Error("Unable to update router configuration", slog.Any("error", err))
+	}
+	if err := updateBindings(api.GetInternalOutputPath(h.namespace, api.LoadedSiteStatePath), loaded, input); err != nil {
+		h.logger.Error("Unable to update loaded site state", slog.Any("error", err))
+	}
+	err = withRuntimeSiteState(h.namespace, func(path string, runtimeState *api.SiteState) error {
+		return updateBindings(path, runtimeState, active)
+	})
+	if err != nil {
+		h.logger.Error("Unable to update runtime site state", slog.Any("error", err))
+	}
+	h.logger.Info("Applied changes to listeners, connectors and links",
+		slog.Int("listeners", len(input.Listeners)),
+		slog.Int("connectors", len(input.Connectors)),
+		slog.Int("links", len(input.Links)))
+	return nil
+}
+
+func (h *BridgeConfigHandler) loadSiteState(internalPath api.InternalPath) (*api.SiteState, error) {
+	siteStateLoader := &common.FileSystemSiteStateLoader{
+		Path: api.GetInternalOutputPath(h.namespace, internalPath),
+	}
+	return siteStateLoader.Load()
+}
+
+func (h *BridgeConfigHandler) writeRouterConfig(routerConfig *qdr.RouterConfig) error {
+	routerConfigJson, err := qdr.MarshalRouterConfig(*routerConfig)
+	if err != nil {
+		return err
+	}
+	fileName := path.Join(api.GetInternalOutputPath(h.namespace, api.RouterConfigPath), "skrouterd.json")
+	return os.WriteFile(fileName, []byte(routerConfigJson), 0644)
+}
+
+func (h *BridgeConfigHandler) connectAgent() (BridgeConfigAgent, error) {
+	port, err := runtime.GetLocalRouterPort(h.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine local router url: %w", err)
+	}
+	url := fmt.Sprintf("amqps://127.0.0.1:%d", port)
+	agent, err := qdr.Connect(url, runtime.GetRuntimeTlsCert(h.namespace, "skupper-local-client"))
+	if err != nil {
+		return nil, fmt.Errorf("could not get management agent: %w", err)
+	}
+	return agent, nil
+}
+
+// changesRequiringReload returns the kinds of resources that have been
+// changed in a way that cannot be applied to a running router.
+func changesRequiringReload(loaded, input *api.SiteState) []string {
+	var kinds []string
+	if !equality.Semantic.DeepEqual(loaded.Site.Spec, input.Site.Spec) {
+		kinds = append(kinds, "Site")
+	}
+	if specsChanged(loaded.RouterAccesses, input.RouterAccesses, func(r *v2alpha1.RouterAccess) any { return r.Spec }) {
+		kinds = append(kinds, "RouterAccess")
+	}
+	if specsChanged(loaded.Claims, input.Claims, func(c *v2alpha1.AccessToken) any { return c.Spec }) {
+		kinds = append(kinds, "AccessToken")
+	}
+	if specsChanged(loaded.Grants, input.Grants, func(g *v2alpha1.AccessGrant) any { return g.Spec }) {
+		kinds = append(kinds, "AccessGrant")
+	}
+	if specsChanged(loaded.Certificates, input.Certificates, func(c *v2alpha1.Certificate) any { return c.Spec }) {
+		kinds = append(kinds, "Certificate")
+	}
+	if specsChanged(loaded.SecuredAccesses, input.SecuredAccesses, func(s *v2alpha1.SecuredAccess) any { return s.Spec }) {
+		kinds = append(kinds, "SecuredAccess")
+	}
+	if specsChanged(loaded.Secrets, input.Secrets, func(s *corev1.Secret) any { return s.Data }) {
+		kinds = append(kinds, "Secret")
+	}
+	if specsChanged(loaded.ConfigMaps, input.ConfigMaps, func(c *corev1.ConfigMap) any { return c.Data }) {
+		kinds = append(kinds, "ConfigMap")
+	}
+	return kinds
+}
+
+func bindingsChanged(loaded, input *api.SiteState) bool {
+	return specsChanged(loaded.Listeners, input.Listeners, listenerSpec) ||
+		specsChanged(loaded.Connectors, input.Connectors, connectorSpec)
+}
+
+func linksChanged(loaded, input *api.SiteState) bool {
+	return specsChanged(loaded.Links, input.Links, linkSpec)
+}
+
+func listenerSpec(l *v2alpha1.Listener) any {
+	return l.Spec
+}
+
+func connectorSpec(c *v2alpha1.Connector) any {
+	return c.Spec
+}
+
+func linkSpec(l *v2alpha1.Link) any {
+	return l.Spec
+}
+
+func specsChanged[T any](current, desired map[string]T, spec func(T) any) bool {
+	if len(current) != len(desired) {
+		return true
+	}
+	for name, resource := range current {
+		other, ok := desired[name]
+		if !ok || !equality.Semantic.DeepEqual(spec(resource), spec(other)) {
+			return true
+		}
+	}
+	return false
+}
+
+// missingSslProfiles returns the profiles referenced by the bridge
+// configuration or by the link connectors that the router has not
+// loaded. Defining those requires certificates to be issued and the
+// router to be restarted.
+func missingSslProfiles(desired qdr.BridgeConfig, links map[string]qdr.Connector, routerConfig *qdr.RouterConfig) []string {
+	var missing []string
+	check := func(profile string) {
+		if profile == "" || slices.Contains(missing, profile) {
+			return
+		}
+		if _, ok := routerConfig.SslProfiles[profile]; !ok {
+			missing = append(missing, profile)
+		}
+	}
+	for _, endpoint := range desired.TcpListeners {
+		check(endpoint.SslProfile)
+	}
+	for _, endpoint := range desired.TcpConnectors {
+		check(endpoint.SslProfile)
+	}
+	for _, connector := range links {
+		check(connector.SslProfile)
+	}
+	slices.Sort(missing)
+	return missing
+}
+
+// linksDifference returns the changes needed to bring the link
+// connectors the router is running in line with the desired ones. As the
+// router does not report back every attribute of a connector, they are
+// compared with the router configuration they were last defined from.
+// Changed connectors are deleted and added again.
+func linksDifference(actual, current, desired map[string]qdr.Connector) *qdr.ConnectorDifference {
+	result := &qdr.ConnectorDifference{}
+	for name, connector := range actual {
+		if isAutoMeshConnector(name) {
+			continue
+		}
+		if wanted, ok := desired[name]; !ok || current[name] != wanted {
+			result.Deleted = append(result.Deleted, connector)
+		}
+	}
+	for name, connector := range desired {
+		if _, ok := actual[name]; !ok || current[name] != connector {
+			result.Added = append(result.Added, connector)
+		}
+	}
+	byName := func(a, b qdr.Connector) int {
+		return strings.Compare(a.Name, b.Name)
+	}
+	slices.SortFunc(result.Deleted, byName)
+	slices.SortFunc(result.Added, byName)
+	return result
+}
+
+// updateLinkConnectors replaces the link connectors in the router
+// configuration with the desired ones.
+func updateLinkConnectors(routerConfig *qdr.RouterConfig, desired map[string]qdr.Connector) {
+	for name := range routerConfig.Connectors {
+		if _, ok := desired[name]; !ok && !isAutoMeshConnector(name) {
+			routerConfig.RemoveConnector(name)
+		}
+	}
+	for _, connector := range desired {
+		routerConfig.AddConnector(connector)
+	}
+}
+
+func isAutoMeshConnector(name string) bool {
+	return strings.HasPrefix(name, "auto-mesh")
+}
+
+// updateBindings brings the Listener, Connector and Link files in the
+// given directory in line with the desired site state.
+func updateBindings(dir string, current, desired *api.SiteState) error {
+	if err := updateResources(dir, "Listener", current.Listeners, desired.Listeners, listenerSpec); err != nil {
+		return err
+	}
+	if err := updateResources(dir, "Connector", current.Connectors, desired.Connectors, connectorSpec); err != nil {
+		return err
+	}
+	return updateResources(dir, "Link", current.Links, desired.Links, linkSpec)
+}
+
+func updateResources[T any](dir string, kind string, current, desired map[string]T, spec func(T) any) error {
+	for name := range current {
+		if _, ok := desired[name]; !ok {
+			if err := os.Remove(path.Join(dir, api.ResourceFileName(kind, name))); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	for name, resource := range desired {
+		if existing, ok := current[name]; ok && equality.Semantic.DeepEqual(spec(existing), spec(resource)) {
+			continue
+		}
+		if err := api.MarshalResource(dir, kind, name, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
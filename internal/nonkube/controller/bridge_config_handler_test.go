@@ -0,0 +1,336 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+type fakeBridgeConfigAgent struct {
+	config      qdr.BridgeConfig
+	connectors  map[string]qdr.Connector
+	updates     int
+	linkUpdates int
+}
+
+func (a *fakeBridgeConfigAgent) GetLocalBridgeConfig() (*qdr.BridgeConfig, error) {
+	config := qdr.NewBridgeConfigCopy(a.config)
+	return &config, nil
+}
+
+func (a *fakeBridgeConfigAgent) UpdateLocalBridgeConfig(changes *qdr.BridgeConfigDifference) error {
+	a.updates++
+	for _, name := range changes.TcpListeners.Deleted {
+		a.config.RemoveTcpListener(name)
+	}
+	for _, endpoint := range changes.TcpListeners.Added {
+		a.config.AddTcpListener(endpoint)
+	}
+	for _, name := range changes.TcpConnectors.Deleted {
+		a.config.RemoveTcpConnector(name)
+	}
+	for _, endpoint := range changes.TcpConnectors.Added {
+		a.config.AddTcpConnector(endpoint)
+	}
+	return nil
+}
+
+func (a *fakeBridgeConfigAgent) GetLocalConnectors() (map[string]qdr.Connector, error) {
+	connectors := map[string]qdr.Connector{}
+	for name, connector := range a.connectors {
+		connectors[name] = connector
+	}
+	return connectors, nil
+}
+
+func (a *fakeBridgeConfigAgent) UpdateLocalConnectors(changes *qdr.ConnectorDifference) error {
+	a.linkUpdates++
+	for _, connector := range changes.Deleted {
+		delete(a.connectors, connector.Name)
+	}
+	for _, connector := range changes.Added {
+		a.connectors[connector.Name] = connector
+	}
+	return nil
+}
+
+func (a *fakeBridgeConfigAgent) Close() error {
+	return nil
+}
+
+func TestBridgeConfigHandler(t *testing.T) {
+	listener := func(name string, port int, tlsCredentials string) *v2alpha1.Listener {
+		return &v2alpha1.Listener{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "Listener",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+			},
+			Spec: v2alpha1.ListenerSpec{
+				RoutingKey:     name,
+				Host:           "0.0.0.0",
+				Port:           port,
+				TlsCredentials: tlsCredentials,
+			},
+		}
+	}
+	link := func(name string, cost int, tlsCredentials string) *v2alpha1.Link {
+		return &v2alpha1.Link{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "Link",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+			},
+			Spec: v2alpha1.LinkSpec{
+				Endpoints: []v2alpha1.Endpoint{
+					{
+						Name: "inter-router",
+						Host: "10.0.0.1",
+						Port: "55671",
+					},
+				},
+				TlsCredentials: tlsCredentials,
+				Cost:           cost,
+			},
+		}
+	}
+	tests := []struct {
+		name                string
+		update              func(siteState *api.SiteState)
+		connectError        error
+		expectedError       string
+		expectedUpdates     int
+		expectedLinkUpdates int
+		expectedPending     []string
+		expectedListener    map[string]int
+		expectedLinkCost    map[string]int
+	}{
+		{
+			name:   "no changes",
+			update: func(siteState *api.SiteState) {},
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+		},
+		{
+			name: "listener added",
+			update: func(siteState *api.SiteState) {
+				siteState.Listeners["listener-two"] = listener("listener-two", 9090, "")
+			},
+			expectedUpdates: 1,
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+				"listener-two": 9090,
+			},
+		},
+		{
+			name: "listener changed",
+			update: func(siteState *api.SiteState) {
+				siteState.Listeners["listener-one"].Spec.Port = 8181
+			},
+			expectedUpdates: 1,
+			expectedListener: map[string]int{
+				"listener-one": 8181,
+			},
+		},
+		{
+			name: "listener removed",
+			update: func(siteState *api.SiteState) {
+				delete(siteState.Listeners, "listener-one")
+			},
+			expectedUpdates:  1,
+			expectedListener: map[string]int{},
+		},
+		{
+			name: "listener with tls credentials that are already loaded",
+			update: func(siteState *api.SiteState) {
+				siteState.Listeners["listener-two"] = listener("listener-two", 9090, "existing-tls")
+			},
+			expectedUpdates: 1,
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+				"listener-two": 9090,
+			},
+		},
+		{
+			name: "listener with new tls credentials",
+			update: func(siteState *api.SiteState) {
+				siteState.Listeners["listener-two"] = listener("listener-two", 9090, "new-tls")
+			},
+			expectedPending: []string{"SslProfile new-tls"},
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+		},
+		{
+			name: "site changed",
+			update: func(siteState *api.SiteState) {
+				siteState.Site.Spec.LinkAccess = "default"
+				siteState.Listeners["listener-two"] = listener("listener-two", 9090, "")
+			},
+			expectedPending: []string{"Site"},
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+		},
+		{
+			name: "link cost changed",
+			update: func(siteState *api.SiteState) {
+				siteState.Links["link-one"].Spec.Cost = 5
+			},
+			expectedLinkUpdates: 1,
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+			expectedLinkCost: map[string]int{
+				"link-one": 5,
+			},
+		},
+		{
+			name: "link removed",
+			update: func(siteState *api.SiteState) {
+				delete(siteState.Links, "link-one")
+			},
+			expectedLinkUpdates: 1,
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+			expectedLinkCost: map[string]int{},
+		},
+		{
+			name: "link added with tls credentials that are already loaded",
+			update: func(siteState *api.SiteState) {
+				siteState.Links["link-two"] = link("link-two", 2, "link-one")
+			},
+			expectedLinkUpdates: 1,
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+			expectedLinkCost: map[string]int{
+				"link-one": 1,
+				"link-two": 2,
+			},
+		},
+		{
+			name: "link with new tls credentials",
+			update: func(siteState *api.SiteState) {
+				siteState.Links["link-one"].Spec.TlsCredentials = "new-link-tls"
+			},
+			expectedPending: []string{"SslProfile new-link-tls-profile"},
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+		},
+		{
+			name: "router not available",
+			update: func(siteState *api.SiteState) {
+				siteState.Listeners["listener-two"] = listener("listener-two", 9090, "")
+			},
+			connectError:  fmt.Errorf("connection refused"),
+			expectedError: "connection refused",
+			expectedListener: map[string]int{
+				"listener-one": 8080,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setDataHome(t)
+			loaded := fakeBridgeConfigSiteState()
+			loaded.Listeners["listener-one"] = listener("listener-one", 8080, "")
+			loaded.Links["link-one"] = link("link-one", 1, "link-one")
+			for _, internalPath := range []api.InternalPath{api.LoadedSiteStatePath, api.RuntimeSiteStatePath} {
+				assert.Assert(t, api.MarshalSiteState(*loaded, api.GetInternalOutputPath("test", internalPath)))
+			}
+			routerConfig := loaded.ToRouterConfig("", "podman")
+			routerConfig.AddSslProfile(qdr.ConfigureSslProfile("existing-tls", "", true))
+			assert.Assert(t, os.MkdirAll(api.GetInternalOutputPath("test", api.RouterConfigPath), 0755))
+			handler := NewBridgeConfigHandler(make(chan struct{}), "test")
+			assert.Assert(t, handler.writeRouterConfig(&routerConfig))
+
+			input := common.CopySiteState(loaded)
+			tt.update(input)
+			assert.Assert(t, api.MarshalSiteState(*input, api.GetInternalOutputPath("test", api.InputSiteStatePath)))
+
+			agent := &fakeBridgeConfigAgent{config: routerConfig.Bridges, connectors: map[string]qdr.Connector{}}
+			for name, connector := range routerConfig.Connectors {
+				agent.connectors[name] = connector
+			}
+			expectedLinkCost := tt.expectedLinkCost
+			if expectedLinkCost == nil {
+				expectedLinkCost = map[string]int{"link-one": 1}
+			}
+			handler.connect = func() (BridgeConfigAgent, error) {
+				if tt.connectError != nil {
+					return nil, tt.connectError
+				}
+				return agent, nil
+			}
+			err := handler.sync()
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.Assert(t, err)
+			}
+			assert.Equal(t, agent.updates, tt.expectedUpdates)
+			assert.Equal(t, agent.linkUpdates, tt.expectedLinkUpdates)
+			assert.DeepEqual(t, handler.pending, tt.expectedPending)
+
+			// what the router is running is reflected in its
+			// configuration file and in the site state
+			assert.Equal(t, len(agent.config.TcpListeners), len(tt.expectedListener))
+			updatedRouterConfig, err := common.LoadRouterConfig("test")
+			assert.Assert(t, err)
+			assert.DeepEqual(t, updatedRouterConfig.Bridges, agent.config)
+			assert.DeepEqual(t, updatedRouterConfig.Connectors, agent.connectors)
+			assert.Equal(t, len(agent.connectors), len(expectedLinkCost))
+			for name, cost := range expectedLinkCost {
+				assert.Equal(t, agent.connectors[name].Cost, int32(cost))
+			}
+			for _, internalPath := range []api.InternalPath{api.LoadedSiteStatePath, api.RuntimeSiteStatePath} {
+				siteState, err := handler.loadSiteState(internalPath)
+				assert.Assert(t, err)
+				assert.Equal(t, len(siteState.Listeners), len(tt.expectedListener), internalPath)
+				for name, port := range tt.expectedListener {
+					assert.Assert(t, siteState.Listeners[name] != nil, "%s: missing listener %s", internalPath, name)
+					assert.Equal(t, siteState.Listeners[name].Spec.Port, port)
+				}
+				assert.Equal(t, len(siteState.Links), len(expectedLinkCost), internalPath)
+				for name, cost := range expectedLinkCost {
+					assert.Assert(t, siteState.Links[name] != nil, "%s: missing link %s", internalPath, name)
+					assert.Equal(t, siteState.Links[name].Spec.Cost, cost)
+				}
+			}
+		})
+	}
+}
+
+func fakeBridgeConfigSiteState() *api.SiteState {
+	siteState := api.NewSiteState(false)
+	siteState.SiteId = "site-id"
+	siteState.Site = &v2alpha1.Site{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "skupper.io/v2alpha1",
+			Kind:       "Site",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "test",
+		},
+	}
+	return siteState
+}
@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/nonkube/client/compat"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/internal/site"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/container"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// containerTargets keeps track of the running containers selected by the
+// connectors of a namespace, so that the router can be configured to
+// reach them as they come and go. Containers are only watched once a
+// connector with a selector has been defined.
+type containerTargets struct {
+	namespace  string
+	stopCh     <-chan struct{}
+	logger     *slog.Logger
+	mutex      sync.Mutex
+	selectors  map[string]labels.Selector
+	containers map[string]*container.Container
+	watching   bool
+	generation int
+	applied    int
+	onChange   func()
+	watch      func(informer container.Informer[*container.Container]) error
+}
+
+func newContainerTargets(stopCh <-chan struct{}, namespace string, onChange func()) *containerTargets {
+	targets := &containerTargets{
+		namespace:  namespace,
+		stopCh:     stopCh,
+		selectors:  map[string]labels.Selector{},
+		containers: map[string]*container.Container{},
+		onChange:   onChange,
+		logger: slog.Default().
+			With("component", "container.targets").
+			With("namespace", namespace),
+	}
+	targets.watch = targets.watchContainers
+	return targets
+}
+
+// setConnectors updates the selectors in use, starting to watch
+// containers if any is defined.
+func (t *containerTargets) setConnectors(connectors map[string]*v2alpha1.Connector) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.selectors = map[string]labels.Selector{}
+	for name, connector := range connectors {
+		if connector.Spec.Selector == "" {
+			continue
+		}
+		selector, err := labels.Parse(connector.Spec.Selector)
+		if err != nil {
+			t.logger.Error("Ignoring connector with invalid selector",
+				slog.String("connector", name),
+				slog.Any("error", err))
+			continue
+		}
+		t.selectors[name] = selector
+	}
+	if len(t.selectors) == 0 || t.watching {
+		return
+	}
+	if err := t.watch(t); err != nil {
+		t.logger.Error("Unable to watch containers selected by connectors", slog.Any("error", err))
+		return
+	}
+	t.watching = true
+}
+
+func (t *containerTargets) watchContainers(informer container.Informer[*container.Container]) error {
+	platformLoader := &common.NamespacePlatformLoader{}
+	platform, err := platformLoader.Load(t.namespace)
+	if err != nil {
+		return err
+	}
+	if !types.Platform(platform).IsContainerEngine() {
+		return fmt.Errorf("connector selectors are not supported on platform %q", platform)
+	}
+	cli, err := newContainerClient(types.Platform(platform))
+	if err != nil {
+		return err
+	}
+	container.NewContainerWatcher(cli, informer).Start(t.stopCh)
+	return nil
+}
+
+// newContainerClient returns a client for the container engine of the
+// given platform, reached through CONTAINER_ENDPOINT when it is set.
+func newContainerClient(platform types.Platform) (*compat.CompatClient, error) {
+	endpoint := os.Getenv(compat.EnvContainerEndpoint)
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("unix://%s/podman/podman.sock", api.GetRuntimeDir())
+		if platform == types.PlatformDocker {
+			endpoint = "unix:///run/docker.sock"
+		}
+	}
+	cli, err := compat.NewCompatClient(endpoint, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container client: %w", err)
+	}
+	return cli, nil
+}
+
+func (t *containerTargets) OnAdd(c *container.Container) {
+	t.mutex.Lock()
+	t.containers[c.ID] = c
+	// targets are lost when the router is restarted
+	changed := t.selected(c) || c.Name == t.namespace+"-skupper-router"
+	t.mutex.Unlock()
+	t.changed(changed)
+}
+
+func (t *containerTargets) OnUpdate(oldObj, newObj *container.Container) {
+	t.mutex.Lock()
+	t.containers[newObj.ID] = newObj
+	changed := t.selected(oldObj) || t.selected(newObj)
+	t.mutex.Unlock()
+	t.changed(changed)
+}
+
+func (t *containerTargets) OnDelete(c *container.Container) {
+	t.mutex.Lock()
+	delete(t.containers, c.ID)
+	changed := t.selected(c)
+	t.mutex.Unlock()
+	t.changed(changed)
+}
+
+func (t *containerTargets) selected(c *container.Container) bool {
+	for _, selector := range t.selectors {
+		if selector.Matches(labels.Set(c.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *containerTargets) changed(changed bool) {
+	if !changed {
+		return
+	}
+	t.mutex.Lock()
+	t.generation++
+	t.mutex.Unlock()
+	t.onChange()
+}
+
+// pending returns the current generation of targets and whether it
+// differs from the one last applied to the router.
+func (t *containerTargets) pending() (int, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.generation, t.generation != t.applied
+}
+
+func (t *containerTargets) setApplied(generation int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.applied = generation
+}
+
+// updateBridgeConfig adds a tcpConnector to config for each running
+// container selected by a connector. The address of a container is the
+// one it has on the network named by the connector's "network" setting,
+// or on the first network it has an address on.
+func (t *containerTargets) updateBridgeConfig(siteId string, connectors map[string]*v2alpha1.Connector, config *qdr.BridgeConfig) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for name, connector := range connectors {
+		selector, ok := t.selectors[name]
+		if !ok {
+			continue
+		}
+		for _, c := range t.containers {
+			if !selector.Matches(labels.Set(c.Labels)) {
+				continue
+			}
+			ip := containerIP(c, connector.Spec.Settings["network"])
+			if ip == "" {
+				continue
+			}
+			pod := v2alpha1.PodDetails{
+				UID:  c.ID,
+				Name: c.Name,
+				IP:   ip,
+			}
+			site.UpdateBridgeConfigForConnectorToPod(siteId, connector, pod, connector.Spec.ExposePodsByName, config)
+		}
+	}
+}
+
+func containerIP(c *container.Container, network string) string {
+	if network != "" {
+		return c.Networks[network].IPAddress
+	}
+	networks := c.NetworkNames()
+	slices.Sort(networks)
+	for _, name := range networks {
+		if ip := c.Networks[name].IPAddress; ip != "" {
+			return ip
+		}
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/container"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+func TestContainerTargets(t *testing.T) {
+	setDataHome(t)
+	loaded := fakeBridgeConfigSiteState()
+	loaded.Connectors["backend"] = &v2alpha1.Connector{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "skupper.io/v2alpha1",
+			Kind:       "Connector",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend",
+			Namespace: "test",
+		},
+		Spec: v2alpha1.ConnectorSpec{
+			RoutingKey: "backend",
+			Selector:   "app=backend",
+			Port:       8080,
+		},
+	}
+	for _, internalPath := range []api.InternalPath{api.LoadedSiteStatePath, api.RuntimeSiteStatePath, api.InputSiteStatePath} {
+		assert.Assert(t, api.MarshalSiteState(*loaded, api.GetInternalOutputPath("test", internalPath)))
+	}
+	routerConfig := loaded.ToRouterConfig("", "podman")
+	assert.Assert(t, os.MkdirAll(api.GetInternalOutputPath("test", api.RouterConfigPath), 0755))
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	handler := NewBridgeConfigHandler(stopCh, "test")
+	assert.Assert(t, handler.writeRouterConfig(&routerConfig))
+	agent := &fakeBridgeConfigAgent{config: qdr.NewBridgeConfigCopy(routerConfig.Bridges), connectors: map[string]qdr.Connector{}}
+	handler.connect = func() (BridgeConfigAgent, error) {
+		return agent, nil
+	}
+	var informer container.Informer[*container.Container]
+	handler.targets.watch = func(i container.Informer[*container.Container]) error {
+		informer = i
+		return nil
+	}
+	changes := 0
+	handler.targets.onChange = func() {
+		changes++
+	}
+	expectConnectors := func(names ...string) {
+		t.Helper()
+		var actual []string
+		for name, connector := range agent.config.TcpConnectors {
+			assert.Equal(t, connector.Port, "8080")
+			assert.Equal(t, connector.Address, "backend")
+			actual = append(actual, name)
+		}
+		slices.Sort(actual)
+		assert.DeepEqual(t, actual, names)
+	}
+
+	// containers are watched once a connector with a selector is found
+	assert.Assert(t, handler.sync())
+	assert.Assert(t, informer != nil)
+	assert.Equal(t, agent.updates, 0)
+
+	backend1 := &container.Container{
+		ID:     "1",
+		Name:   "backend-1",
+		Labels: map[string]string{"app": "backend"},
+		Networks: map[string]container.ContainerNetworkInfo{
+			"skupper": {IPAddress: "10.88.0.5"},
+		},
+		Running: true,
+	}
+	backend2 := &container.Container{
+		ID:     "2",
+		Name:   "backend-2",
+		Labels: map[string]string{"app": "backend"},
+		Networks: map[string]container.ContainerNetworkInfo{
+			"a-network": {},
+			"b-network": {IPAddress: "10.89.0.7"},
+		},
+		Running: true,
+	}
+	database := &container.Container{
+		ID:     "3",
+		Name:   "database",
+		Labels: map[string]string{"app": "database"},
+		Networks: map[string]container.ContainerNetworkInfo{
+			"skupper": {IPAddress: "10.88.0.6"},
+		},
+		Running: true,
+	}
+	informer.OnAdd(backend1)
+	informer.OnAdd(database)
+	assert.Equal(t, changes, 1)
+	assert.Assert(t, handler.sync())
+	assert.Equal(t, agent.updates, 1)
+	expectConnectors("backend@10.88.0.5")
+	assert.Equal(t, agent.config.TcpConnectors["backend@10.88.0.5"].ProcessID, "1")
+
+	// nothing left to apply
+	assert.Assert(t, handler.sync())
+	assert.Equal(t, agent.updates, 1)
+
+	informer.OnAdd(backend2)
+	assert.Assert(t, handler.sync())
+	expectConnectors("backend@10.88.0.5", "backend@10.89.0.7")
+
+	informer.OnDelete(backend1)
+	informer.OnDelete(database)
+	assert.Equal(t, changes, 3)
+	assert.Assert(t, handler.sync())
+	expectConnectors("backend@10.89.0.7")
+	loadedSiteState, err := handler.loadSiteState(api.LoadedSiteStatePath)
+	assert.Assert(t, err)
+	assert.Equal(t, loadedSiteState.Connectors["backend"].Spec.Selector, "app=backend")
+
+	// the targets are applied again once the router is restarted
+	agent.config = qdr.NewBridgeConfigCopy(routerConfig.Bridges)
+	expectConnectors()
+	informer.OnAdd(&container.Container{ID: "4", Name: "test-skupper-router", Running: true})
+	assert.Equal(t, changes, 4)
+	assert.Assert(t, handler.sync())
+	expectConnectors("backend@10.89.0.7")
+}
+
+func TestContainerIP(t *testing.T) {
+	c := &container.Container{
+		Networks: map[string]container.ContainerNetworkInfo{
+			"b-network": {IPAddress: "10.89.0.7"},
+			"c-network": {IPAddress: "10.90.0.7"},
+			"a-network": {},
+		},
+	}
+	tests := []struct {
+		network    string
+		expectedIP string
+	}{
+		{network: "", expectedIP: "10.89.0.7"},
+		{network: "c-network", expectedIP: "10.90.0.7"},
+		{network: "a-network", expectedIP: ""},
+		{network: "unknown", expectedIP: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.network, func(t *testing.T) {
+			assert.Equal(t, containerIP(c, test.network), test.expectedIP)
+		})
+	}
+}
@@ -15,14 +15,29 @@ const (
 )
 
 type Controller struct {
-	nsHandler *NamespacesHandler
+	nsHandler   *NamespacesHandler
+	grantServer *GrantServer
+	apiServer   *ApiServer
 }
 
-func NewController() (*Controller, error) {
+func NewController(grantConfig *GrantConfig, apiConfig *ApiConfig) (*Controller, error) {
 	var err error
 	c := &Controller{}
 	c.nsHandler, err = NewNamespacesHandler()
-	return c, err
+	if err != nil {
+		return c, err
+	}
+	if grantConfig != nil && grantConfig.Enabled {
+		c.grantServer, err = NewGrantServer(grantConfig)
+		if err != nil {
+			return c, err
+		}
+		c.nsHandler.grants = c.grantServer
+	}
+	if apiConfig != nil && apiConfig.Enabled {
+		c.apiServer = NewApiServer(apiConfig, c.nsHandler.Namespaces)
+	}
+	return c, nil
 }
 
 func (c *Controller) Start() (chan struct{}, *sync.WaitGroup) {
@@ -33,6 +48,23 @@ func (c *Controller) Start() (chan struct{}, *sync.WaitGroup) {
 	if err := c.nsHandler.Start(stop, wg); err != nil {
 		log.Fatalf("error starting controller: %v", err)
 	}
+	if c.grantServer != nil {
+		c.grantServer.Start()
+		go func() {
+			<-stop
+			c.grantServer.Stop()
+		}()
+	}
+	if c.apiServer != nil {
+		if err := c.apiServer.Start(); err != nil {
+			log.Printf("Unable to start api server, namespaces can still be managed through their files: %v", err)
+		} else {
+			go func() {
+				<-stop
+				c.apiServer.Stop()
+			}()
+		}
+	}
 	log.Println("Controller started")
 	return stop, wg
 }
@@ -0,0 +1,264 @@
+package controller
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/certs"
+	iflag "github.com/skupperproject/skupper/internal/flag"
+	"github.com/skupperproject/skupper/internal/kube/grants"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	grantServerCaName = "skupper-grant-server-ca"
+	grantServerName   = "skupper-grant-server"
+)
+
+type GrantConfig struct {
+	Enabled bool
+	BaseUrl string
+	Port    int
+}
+
+func BoundGrantConfig(flags *flag.FlagSet) (*GrantConfig, error) {
+	c := &GrantConfig{}
+	var errs []error
+	if err := iflag.BoolVar(flags, &c.Enabled, "enable-grants", "SKUPPER_ENABLE_GRANTS", false, "Enable redemption of AccessGrants issued by sites in any namespace."); err != nil {
+		errs = append(errs, err)
+	}
+	iflag.StringVar(flags, &c.BaseUrl, "grant-server-base-url", "SKUPPER_GRANT_SERVER_BASE_URL", "", "The host and port through which the AccessGrant server can be reached by remote sites (defaults to the hostname and grant server port).")
+	if err := iflag.IntVar(flags, &c.Port, "grant-server-port", "SKUPPER_GRANT_SERVER_PORT", 8443, "The port on which the AccessGrant server should listen."); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return c, fmt.Errorf("Invalid environment variable(s): %w", errors.Join(errs...))
+	}
+	return c, nil
+}
+
+func (c *GrantConfig) addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func (c *GrantConfig) url() string {
+	if c.BaseUrl != "" {
+		return c.BaseUrl
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "localhost"
+	}
+	return net.JoinHostPort(hostname, fmt.Sprint(c.Port))
+}
+
+// GrantServer serves redemption of the AccessGrants issued by sites in
+// all namespaces, using the same protocol as the kubernetes controller
+// so that tokens issued here can be redeemed by any site.
+type GrantServer struct {
+	config *GrantConfig
+	grants *grants.Grants
+	server *grants.Server
+	logger *slog.Logger
+}
+
+func NewGrantServer(config *GrantConfig) (*GrantServer, error) {
+	s := &GrantServer{
+		config: config,
+		logger: slog.Default().With("component", "grant.server"),
+	}
+	s.grants = grants.NewGrants(writeGrant, generateToken, "https", config.url())
+	s.server = grants.NewServer(config.addr(), true, s.grants)
+	credentials, err := ensureGrantServerCredentials(api.GetDefaultOutputGrantServerPath(), config.url())
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(credentials.Data["tls.crt"], credentials.Data["tls.key"])
+	if err != nil {
+		return nil, err
+	}
+	s.server.SetCertificate(&cert)
+	s.grants.SetCA(string(credentials.Data["ca.crt"]))
+	return s, nil
+}
+
+func (s *GrantServer) Start() {
+	s.logger.Info("Starting grant server", slog.String("url", s.config.url()))
+	s.server.Start()
+}
+
+func (s *GrantServer) Stop() {
+	s.server.Stop()
+}
+
+// Handler returns a handler for the grants in the given namespace.
+func (s *GrantServer) Handler(namespace string) *GrantsHandler {
+	return NewGrantsHandler(namespace, s.grants)
+}
+
+// ensureGrantServerCredentials returns the TLS credentials for the
+// grant server, generating them if necessary. The CA is kept across
+// restarts so that tokens already issued remain valid; the server
+// certificate is regenerated to match the configured url.
+func ensureGrantServerCredentials(basePath string, url string) (*corev1.Secret, error) {
+	caPath := path.Join(basePath, grantServerCaName)
+	ca, err := readSecret(caPath, grantServerCaName)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		secret := certs.GenerateSecret(grantServerCaName, grantServerCaName, "", 0, nil)
+		if err := writeSecret(caPath, &secret); err != nil {
+			return nil, err
+		}
+		ca = &secret
+	}
+	host, _, err := net.SplitHostPort(url)
+	if err != nil {
+		host = url
+	}
+	hosts := []string{host}
+	for _, h := range []string{"localhost", "127.0.0.1"} {
+		if !slices.Contains(hosts, h) {
+			hosts = append(hosts, h)
+		}
+	}
+	secret := certs.GenerateSecret(grantServerName, host, strings.Join(hosts, ","), 0, ca)
+	if err := writeSecret(path.Join(basePath, grantServerName), &secret); err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+func readSecret(dir string, name string) (*corev1.Secret, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Data: map[string][]byte{},
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		secret.Data[entry.Name()] = data
+	}
+	return secret, nil
+}
+
+func writeSecret(dir string, secret *corev1.Secret) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	for name, data := range secret.Data {
+		if err := os.WriteFile(path.Join(dir, name), data, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateToken writes out the Secret and Link through which the
+// redeeming site can link to the site in the given namespace. A new
+// client certificate is issued for each redemption.
+func generateToken(namespace string, name string, subject string, writer io.Writer) error {
+	siteStateLoader := &common.FileSystemSiteStateLoader{
+		Path: api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath),
+	}
+	siteState, err := siteStateLoader.Load()
+	if err != nil {
+		return fmt.Errorf("No active site in namespace %s", namespace)
+	}
+	routerAccess := linkRouterAccess(siteState)
+	if routerAccess == nil {
+		return fmt.Errorf("Site in namespace %s is not enabled for link access", namespace)
+	}
+	certName := routerAccess.Name
+	if routerAccess.Spec.TlsCredentials != "" {
+		certName = routerAccess.Spec.TlsCredentials
+	}
+	issuer := "skupper-site-ca"
+	if routerAccess.Spec.Issuer != "" {
+		issuer = routerAccess.Spec.Issuer
+	}
+	ca, err := readSecret(path.Join(api.GetInternalOutputPath(namespace, api.IssuersPath), issuer), issuer)
+	if err != nil {
+		return fmt.Errorf("Could not get issuer for requested certificate: %w", err)
+	}
+	server, err := readSecret(path.Join(api.GetInternalOutputPath(namespace, api.CertificatesPath), certName), certName)
+	if err != nil {
+		return fmt.Errorf("Could not get server certificate for link access: %w", err)
+	}
+	client := certs.GenerateSecret(name, subject, "", 0, ca)
+	token := preferredToken(api.CreateTokens(*routerAccess, *server, client))
+	if token == nil {
+		return fmt.Errorf("Could not resolve any endpoints for requested link")
+	}
+	token.Secret.Name = name
+	token.Links[0].Name = name
+	token.Links[0].Spec.TlsCredentials = name
+	data, err := token.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
+
+// linkRouterAccess returns the RouterAccess through which remote sites
+// can link to this one, if any.
+func linkRouterAccess(siteState *api.SiteState) *v2alpha1.RouterAccess {
+	var names []string
+	for name, routerAccess := range siteState.RouterAccesses {
+		if routerAccess.FindRole("inter-router") != nil || routerAccess.FindRole("edge") != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	return siteState.RouterAccesses[names[0]]
+}
+
+// preferredToken picks the token whose endpoints are most likely to be
+// reachable by a remote site, i.e. the first that does not use a
+// loopback address.
+func preferredToken(tokens []*api.Token) *api.Token {
+	for _, token := range tokens {
+		host := token.Links[0].Spec.Endpoints[0].Host
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return token
+		}
+	}
+	if len(tokens) > 0 {
+		return tokens[0]
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/scheme"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const grantFilePrefix = "AccessGrant-"
+
+type GrantRegistry interface {
+	CheckGrant(key string, grant *v2alpha1.AccessGrant) error
+}
+
+// GrantsHandler watches the AccessGrants issued in a namespace, making
+// them available for redemption through the grant server.
+type GrantsHandler struct {
+	namespace string
+	grants    GrantRegistry
+	logger    *slog.Logger
+}
+
+func NewGrantsHandler(namespace string, grants GrantRegistry) *GrantsHandler {
+	return &GrantsHandler{
+		namespace: namespace,
+		grants:    grants,
+		logger: slog.Default().
+			With("component", "grants.handler").
+			With("namespace", namespace),
+	}
+}
+
+func (h *GrantsHandler) OnBasePathAdded(basePath string) {
+}
+
+func (h *GrantsHandler) OnCreate(name string) {
+	h.grantUpdated(name)
+}
+
+func (h *GrantsHandler) OnUpdate(name string) {
+	h.grantUpdated(name)
+}
+
+func (h *GrantsHandler) OnRemove(name string) {
+	key := h.key(grantName(name))
+	if err := h.grants.CheckGrant(key, nil); err != nil {
+		h.logger.Error("Error removing grant", slog.String("key", key), slog.Any("error", err))
+	}
+}
+
+func (h *GrantsHandler) Filter(name string) bool {
+	base := path.Base(name)
+	return strings.HasPrefix(base, grantFilePrefix) && strings.HasSuffix(base, ".yaml")
+}
+
+func (h *GrantsHandler) key(name string) string {
+	return fmt.Sprintf("%s/%s", h.namespace, name)
+}
+
+func (h *GrantsHandler) grantUpdated(name string) {
+	grant, err := readGrant(name)
+	if err != nil {
+		h.logger.Error("Error reading grant", slog.String("file", name), slog.Any("error", err))
+		return
+	}
+	grant.Namespace = h.namespace
+	if grant.Name == "" {
+		grant.Name = grantName(name)
+	}
+	if grant.ObjectMeta.UID == "" {
+		// the uid identifies the grant in the redemption url, so
+		// must be assigned before anything else; the update to the
+		// file will then be processed as a separate event
+		grant.ObjectMeta.UID = types.UID(uuid.New().String())
+		if _, err := writeGrant(grant); err != nil {
+			h.logger.Error("Error assigning uid to grant", slog.String("name", grant.Name), slog.Any("error", err))
+		}
+		return
+	}
+	key := h.key(grant.Name)
+	if err := h.grants.CheckGrant(key, grant); err != nil {
+		h.logger.Error("Error processing grant", slog.String("key", key), slog.Any("error", err))
+	}
+}
+
+func grantName(fileName string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(path.Base(fileName), grantFilePrefix), ".yaml")
+}
+
+func grantFileName(namespace string, name string) string {
+	return path.Join(api.GetInternalOutputPath(namespace, api.RuntimeGrantsPath), grantFilePrefix+name+".yaml")
+}
+
+func readGrant(fileName string) (*v2alpha1.AccessGrant, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	grant := &v2alpha1.AccessGrant{}
+	if err := yaml.Unmarshal(data, grant); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+// writeGrant persists the grant to the runtime grants directory of its
+// namespace. The file is replaced atomically so that readers never see
+// a partially written grant.
+func writeGrant(grant *v2alpha1.AccessGrant) (*v2alpha1.AccessGrant, error) {
+	if grant.Kind == "" {
+		grant.APIVersion = "skupper.io/v2alpha1"
+		grant.Kind = "AccessGrant"
+	}
+	fileName := grantFileName(grant.Namespace, grant.Name)
+	if err := os.MkdirAll(path.Dir(fileName), 0755); err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(path.Dir(fileName), "."+path.Base(fileName)+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	encoder := json.NewYAMLSerializer(json.DefaultMetaFactory, scheme.Scheme, scheme.Scheme)
+	if err := encoder.Encode(grant, tmp); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), fileName); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/certs"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+type fakeGrantRegistry struct {
+	checked map[string]*v2alpha1.AccessGrant
+}
+
+func (r *fakeGrantRegistry) CheckGrant(key string, grant *v2alpha1.AccessGrant) error {
+	r.checked[key] = grant
+	return nil
+}
+
+func setDataHome(t *testing.T) {
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+}
+
+func TestGrantsHandler(t *testing.T) {
+	setDataHome(t)
+	registry := &fakeGrantRegistry{checked: map[string]*v2alpha1.AccessGrant{}}
+	handler := NewGrantsHandler("test", registry)
+	fileName := grantFileName("test", "my-grant")
+
+	assert.Assert(t, handler.Filter(fileName))
+	assert.Assert(t, !handler.Filter(path.Join(path.Dir(fileName), ".AccessGrant-my-grant.yaml.1234")))
+	assert.Assert(t, !handler.Filter(path.Join(path.Dir(fileName), "Link-my-link.yaml")))
+
+	_, err := writeGrant(&v2alpha1.AccessGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-grant",
+			Namespace: "test",
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			RedemptionsAllowed: 2,
+		},
+	})
+	assert.Assert(t, err)
+
+	// a uid is assigned before the grant is registered
+	handler.OnCreate(fileName)
+	assert.Equal(t, len(registry.checked), 0)
+	grant, err := readGrant(fileName)
+	assert.Assert(t, err)
+	assert.Assert(t, grant.UID != "")
+	assert.Equal(t, grant.Spec.RedemptionsAllowed, 2)
+	info, err := os.Stat(fileName)
+	assert.Assert(t, err)
+	assert.Equal(t, info.Mode().Perm(), os.FileMode(0600))
+
+	handler.OnUpdate(fileName)
+	registered, ok := registry.checked["test/my-grant"]
+	assert.Assert(t, ok)
+	assert.Equal(t, registered.UID, grant.UID)
+	assert.Equal(t, registered.Namespace, "test")
+
+	handler.OnRemove(fileName)
+	registered, ok = registry.checked["test/my-grant"]
+	assert.Assert(t, ok)
+	assert.Assert(t, registered == nil)
+}
+
+func TestEnsureGrantServerCredentials(t *testing.T) {
+	basePath := t.TempDir()
+	first, err := ensureGrantServerCredentials(basePath, "myhost:8443")
+	assert.Assert(t, err)
+	second, err := ensureGrantServerCredentials(basePath, "otherhost:8443")
+	assert.Assert(t, err)
+	// the ca is retained, the server certificate is not
+	assert.DeepEqual(t, first.Data["ca.crt"], second.Data["ca.crt"])
+	assert.Assert(t, !bytes.Equal(first.Data["tls.crt"], second.Data["tls.crt"]))
+}
+
+func TestGenerateToken(t *testing.T) {
+	setDataHome(t)
+	siteState := fakeSiteState()
+	assert.Assert(t, api.MarshalSiteState(*siteState, api.GetInternalOutputPath("test", api.RuntimeSiteStatePath)))
+	ca := certs.GenerateSecret("skupper-site-ca", "skupper-site-ca", "", 0, nil)
+	assert.Assert(t, writeSecret(path.Join(api.GetInternalOutputPath("test", api.IssuersPath), "skupper-site-ca"), &ca))
+	server := certs.GenerateSecret("link-access-one", "link-access-one", "localhost", 0, &ca)
+	assert.Assert(t, writeSecret(path.Join(api.GetInternalOutputPath("test", api.CertificatesPath), "link-access-one"), &server))
+
+	out := &bytes.Buffer{}
+	assert.Assert(t, generateToken("test", "my-grant", "remote-site", out))
+	token := out.String()
+	assert.Assert(t, strings.Contains(token, "kind: Secret"), token)
+	assert.Assert(t, strings.Contains(token, "kind: Link"), token)
+	assert.Assert(t, strings.Contains(token, "name: my-grant"), token)
+	assert.Assert(t, strings.Contains(token, "tlsCredentials: my-grant"), token)
+
+	assert.ErrorContains(t, generateToken("other", "my-grant", "remote-site", out), "No active site in namespace other")
+}
+
+func TestPreferredToken(t *testing.T) {
+	token := func(host string) *api.Token {
+		return &api.Token{
+			Links: []*v2alpha1.Link{
+				{
+					Spec: v2alpha1.LinkSpec{
+						Endpoints: []v2alpha1.Endpoint{{Host: host}},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		hosts    []string
+		expected string
+	}{
+		{
+			name:     "first non loopback",
+			hosts:    []string{"127.0.0.1", "localhost", "10.0.0.1", "my.host"},
+			expected: "10.0.0.1",
+		},
+		{
+			name:     "only loopback",
+			hosts:    []string{"localhost", "127.0.0.1"},
+			expected: "localhost",
+		},
+		{
+			name: "none",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tokens []*api.Token
+			for _, host := range tt.hosts {
+				tokens = append(tokens, token(host))
+			}
+			selected := preferredToken(tokens)
+			if tt.expected == "" {
+				assert.Assert(t, selected == nil)
+			} else {
+				assert.Equal(t, selected.Links[0].Spec.Endpoints[0].Host, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"bytes"
+	"sync"
+)
+
+const (
+	defaultLogBufferLines = 1000
+	logSubscriberBacklog  = 256
+)
+
+// LogBuffer retains the most recent lines logged by the system
+// controller, so that they can be retrieved and followed through the
+// local API. Lines are dropped for followers that do not keep up,
+// rather than blocking the controller.
+type LogBuffer struct {
+	mutex       sync.Mutex
+	size        int
+	lines       []string
+	partial     []byte
+	subscribers map[chan string]struct{}
+}
+
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{
+		size:        size,
+		subscribers: map[chan string]struct{}{},
+	}
+}
+
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	data := append(b.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		b.add(string(data[:i]))
+		data = data[i+1:]
+	}
+	b.partial = append([]byte(nil), data...)
+	return len(p), nil
+}
+
+func (b *LogBuffer) add(line string) {
+	b.lines = append(b.lines, line)
+	if len(b.lines) > b.size {
+		b.lines = b.lines[len(b.lines)-b.size:]
+	}
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- line:
+		default:
+		}
+	}
+}
+
+// Lines returns the last tail lines retained, or all of them if tail
+// is not positive.
+func (b *LogBuffer) Lines(tail int) []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.tail(tail)
+}
+
+func (b *LogBuffer) tail(tail int) []string {
+	lines := b.lines
+	if tail > 0 && tail < len(lines) {
+		lines = lines[len(lines)-tail:]
+	}
+	return append([]string(nil), lines...)
+}
+
+// Follow returns the last tail lines retained along with a channel on
+// which subsequent lines are delivered, until the returned cancel
+// function is called.
+func (b *LogBuffer) Follow(tail int) ([]string, <-chan string, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	subscriber := make(chan string, logSubscriberBacklog)
+	b.subscribers[subscriber] = struct{}{}
+	cancel := func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		delete(b.subscribers, subscriber)
+	}
+	return b.tail(tail), subscriber, cancel
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLogBuffer(t *testing.T) {
+	b := NewLogBuffer(3)
+	_, err := b.Write([]byte("one\ntwo\nthr"))
+	assert.Assert(t, err)
+	assert.DeepEqual(t, b.Lines(0), []string{"one", "two"})
+
+	lines, updates, cancel := b.Follow(1)
+	assert.DeepEqual(t, lines, []string{"two"})
+	_, err = b.Write([]byte("ee\nfour\n"))
+	assert.Assert(t, err)
+	assert.Equal(t, <-updates, "three")
+	assert.Equal(t, <-updates, "four")
+	assert.DeepEqual(t, b.Lines(0), []string{"two", "three", "four"})
+	assert.DeepEqual(t, b.Lines(2), []string{"three", "four"})
+
+	cancel()
+	_, err = b.Write([]byte("five\n"))
+	assert.Assert(t, err)
+	assert.Equal(t, len(updates), 0)
+}
@@ -13,6 +13,7 @@ type NamespaceController struct {
 	stopCh  chan struct{}
 	logger  *slog.Logger
 	watcher *filesystem.FileWatcher
+	grants  *GrantServer
 	prepare func()
 }
 
@@ -38,9 +39,16 @@ func (w *NamespaceController) Start() {
 		routerStateHandler := NewRouterStateHandler(w.ns)
 		routerConfigHandler.AddCallback(routerStateHandler)
 		collectorLifecycleHandler := NewCollectorLifecycleHandler(w.ns)
-		routerStateHandler.SetCallback(collectorLifecycleHandler)
+		routerStatusHandler := NewRouterStatusHandler(w.ns)
+		routerSupervisor := NewRouterSupervisor(w.ns)
+		routerStateHandler.SetCallback(ActivationCallbacks{routerStatusHandler, collectorLifecycleHandler, routerSupervisor})
+		go routerSupervisor.Run(w.stopCh)
 		w.watcher.Add(api.GetInternalOutputPath(w.ns, api.RouterConfigPath), routerConfigHandler)
 		w.watcher.Add(api.GetInternalOutputPath(w.ns, api.RuntimeSiteStatePath), NewNetworkStatusHandler(w.ns))
+		w.watcher.Add(api.GetInternalOutputPath(w.ns, api.InputSiteStatePath), NewBridgeConfigHandler(w.stopCh, w.ns))
+		if w.grants != nil {
+			w.watcher.Add(api.GetInternalOutputPath(w.ns, api.RuntimeGrantsPath), w.grants.Handler(w.ns))
+		}
 	} else {
 		w.prepare()
 	}
@@ -17,6 +17,7 @@ type NamespacesHandler struct {
 	basePath   string
 	watcher    *filesystem.FileWatcher
 	namespaces map[string]*NamespaceController
+	grants     *GrantServer
 	mutex      sync.Mutex
 }
 
@@ -65,6 +66,17 @@ func (n *NamespacesHandler) wait(stop chan struct{}, wg *sync.WaitGroup) {
 	wg.Done()
 }
 
+// Namespaces returns the names of the namespaces being watched.
+func (n *NamespacesHandler) Namespaces() []string {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	var names []string
+	for name := range n.namespaces {
+		names = append(names, name)
+	}
+	return names
+}
+
 func (n *NamespacesHandler) loadExistingNamespaces() error {
 	entries, err := os.ReadDir(n.basePath)
 	if err != nil {
@@ -111,6 +123,7 @@ func (n *NamespacesHandler) OnCreate(name string) {
 				slog.String("namespace", ns),
 				slog.Any("error", err))
 		}
+		nsc.grants = n.grants
 		n.namespaces[ns] = nsc
 		nsc.Start()
 	}
@@ -9,7 +9,6 @@ import (
 	"sync"
 
 	"github.com/skupperproject/skupper/internal/network"
-	"github.com/skupperproject/skupper/internal/nonkube/common"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -67,19 +66,16 @@ func (n *NetworkStatusHandler) processEvents(done chan struct{}) {
 }
 
 func (n *NetworkStatusHandler) updateRuntimeSiteState(networkStatusInfo network.NetworkStatusInfo) {
-	runtimeSiteStatePath := api.GetInternalOutputPath(n.Namespace, api.RuntimeSiteStatePath)
-	siteStateLoader := &common.FileSystemSiteStateLoader{
-		Path: runtimeSiteStatePath,
-	}
-	siteState, err := siteStateLoader.Load()
+	err := withRuntimeSiteState(n.Namespace, func(runtimeSiteStatePath string, siteState *api.SiteState) error {
+		delete(siteState.ConfigMaps, "skupper-network-status")
+		siteState.UpdateStatus(networkStatusInfo)
+		if err := api.MarshalSiteState(*siteState, runtimeSiteStatePath); err != nil {
+			return fmt.Errorf("error marshaling runtime site state: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		n.logger.Warn("Error loading runtime site state", slog.Any("error", err))
-		return
-	}
-	delete(siteState.ConfigMaps, "skupper-network-status")
-	siteState.UpdateStatus(networkStatusInfo)
-	if err = api.MarshalSiteState(*siteState, runtimeSiteStatePath); err != nil {
-		n.logger.Error("Error marshaling runtime site state", slog.Any("error", err))
+		n.logger.Warn("Error updating runtime site state", slog.Any("error", err))
 		return
 	}
 	n.logger.Debug("Runtime site state updated")
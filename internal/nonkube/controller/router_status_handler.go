@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"log/slog"
+
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// RouterStatusHandler reflects the availability of the router, as
+// reported by the RouterStateHandler, in the status of the runtime Site.
+type RouterStatusHandler struct {
+	namespace string
+	logger    *slog.Logger
+}
+
+func NewRouterStatusHandler(namespace string) *RouterStatusHandler {
+	handler := &RouterStatusHandler{
+		namespace: namespace,
+	}
+	handler.logger = slog.Default().
+		With("component", handler.Id()).
+		With("namespace", namespace)
+	return handler
+}
+
+func (h *RouterStatusHandler) Start(stopCh <-chan struct{}) {
+	h.setRunning(true)
+}
+
+func (h *RouterStatusHandler) Stop() {
+	h.setRunning(false)
+}
+
+func (h *RouterStatusHandler) Id() string {
+	return "router.status.handler"
+}
+
+func (h *RouterStatusHandler) setRunning(running bool) {
+	err := withRuntimeSiteState(h.namespace, func(path string, siteState *api.SiteState) error {
+		if !siteState.SetRouterRunning(running) {
+			return nil
+		}
+		return api.MarshalResource(path, "Site", siteState.Site.Name, siteState.Site)
+	})
+	if err != nil {
+		h.logger.Warn("Unable to update site status", slog.Bool("running", running), slog.Any("error", err))
+	}
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+func TestRouterStatusHandler(t *testing.T) {
+	setDataHome(t)
+	siteState := fakeBridgeConfigSiteState()
+	siteState.Site.SetConfigured(nil)
+	assert.Assert(t, api.MarshalSiteState(*siteState, api.GetInternalOutputPath("test", api.RuntimeSiteStatePath)))
+	handler := NewRouterStatusHandler("test")
+
+	site := func() *v2alpha1.Site {
+		var site *v2alpha1.Site
+		assert.Assert(t, withRuntimeSiteState("test", func(path string, siteState *api.SiteState) error {
+			site = siteState.Site
+			return nil
+		}))
+		return site
+	}
+
+	handler.Start(make(chan struct{}))
+	assert.Assert(t, site().IsReady())
+	assert.Equal(t, site().Status.StatusType, v2alpha1.StatusReady)
+
+	handler.Stop()
+	assert.Assert(t, !site().IsReady())
+	assert.Equal(t, site().Status.StatusType, v2alpha1.StatusPending)
+	assert.Equal(t, site().Status.Message, "Router is not running")
+}
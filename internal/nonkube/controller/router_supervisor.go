@@ -0,0 +1,355 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/container"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	routerSupervisorInterval = 5 * time.Second
+	// routerDownGracePeriod leaves the router, or whatever manages it,
+	// a chance to come back on its own, i.e. while a site is reloaded
+	routerDownGracePeriod     = 15 * time.Second
+	routerRestartBackoff      = 10 * time.Second
+	routerRestartBackoffLimit = 5 * time.Minute
+	// routerStablePeriod is how long the router must stay up for its
+	// earlier restarts to be forgotten
+	routerStablePeriod        = 10 * time.Minute
+	routerCrashLoopRestarts   = 3
+	routerHealthInterval      = 30 * time.Second
+	routerLogLinesOnCrashLoop = 20
+)
+
+// routerProcess is the router of a namespace, as managed by the platform
+// it runs on.
+type routerProcess interface {
+	Running() (bool, error)
+	Restart() error
+	Logs(lines int) ([]string, error)
+}
+
+// RouterSupervisor restarts the router of a namespace when it stays
+// down, backing off while it keeps failing. The router is considered up
+// from the moment the RouterStateHandler reports it as such, through
+// the ActivationCallback interface. The health of the router is written
+// to the health file of the namespace, which doubles as a liveness
+// report for the system controller itself.
+type RouterSupervisor struct {
+	namespace   string
+	logger      *slog.Logger
+	mutex       sync.Mutex
+	up          bool
+	since       time.Time
+	restarts    int
+	total       int
+	lastRestart *time.Time
+	nextRestart time.Time
+	crashLoop   bool
+	logLines    []string
+	lastReport  time.Time
+	pending     bool
+	process     routerProcess
+	supervised  bool
+	resolved    bool
+	now         func() time.Time
+	resolve     func() (routerProcess, error)
+}
+
+func NewRouterSupervisor(namespace string) *RouterSupervisor {
+	supervisor := &RouterSupervisor{
+		namespace: namespace,
+		now:       time.Now,
+	}
+	supervisor.since = supervisor.now()
+	supervisor.resolve = supervisor.resolveProcess
+	supervisor.logger = slog.Default().
+		With("component", supervisor.Id()).
+		With("namespace", namespace)
+	return supervisor
+}
+
+func (s *RouterSupervisor) Id() string {
+	return "router.supervisor"
+}
+
+// Start is called once the router is up.
+func (s *RouterSupervisor) Start(stopCh <-chan struct{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.up = true
+	s.since = s.now()
+	s.pending = true
+}
+
+// Stop is called once the router is down.
+func (s *RouterSupervisor) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.up = false
+	s.since = s.now()
+	s.pending = true
+}
+
+// Run checks on the router until stopCh is closed.
+func (s *RouterSupervisor) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(routerSupervisorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			s.check()
+		}
+	}
+}
+
+// check restarts the router when needed and reports its health. The
+// mutex is not held while the platform is queried, so that the router
+// state changes reported meanwhile are not held up.
+func (s *RouterSupervisor) check() {
+	s.mutex.Lock()
+	resolved := s.resolved
+	s.mutex.Unlock()
+	if !resolved {
+		process, err := s.resolve()
+		s.mutex.Lock()
+		if err != nil {
+			s.logger.Debug("Unable to determine how the router is run", slog.Any("error", err))
+		} else {
+			s.process = process
+			s.supervised = process != nil
+			s.resolved = true
+		}
+		s.mutex.Unlock()
+	}
+
+	s.mutex.Lock()
+	now := s.now()
+	changed := false
+	var process routerProcess
+	if s.up {
+		if s.restarts > 0 && now.Sub(s.since) >= routerStablePeriod {
+			s.logger.Info("Router is stable again", slog.Int("restarts", s.restarts))
+			s.restarts = 0
+			s.crashLoop = false
+			s.logLines = nil
+			changed = true
+		}
+	} else if s.process != nil && now.Sub(s.since) >= routerDownGracePeriod && !now.Before(s.nextRestart) {
+		process = s.process
+	}
+	s.mutex.Unlock()
+
+	if process != nil {
+		changed = s.restart(process, now)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if changed || s.pending || now.Sub(s.lastReport) >= routerHealthInterval {
+		s.report(now)
+	}
+}
+
+// restart restarts the router unless it is running, or it has been
+// reported up in the meantime. It must be called without holding the
+// mutex.
+func (s *RouterSupervisor) restart(process routerProcess, now time.Time) bool {
+	running, err := process.Running()
+	if err != nil {
+		s.logger.Debug("Unable to verify if router is running", slog.Any("error", err))
+		return false
+	}
+	if running {
+		return false
+	}
+	logLines, err := process.Logs(routerLogLinesOnCrashLoop)
+	if err != nil {
+		s.logger.Debug("Unable to retrieve router logs", slog.Any("error", err))
+	}
+
+	s.mutex.Lock()
+	if s.up {
+		s.mutex.Unlock()
+		return false
+	}
+	s.restarts++
+	s.total++
+	s.lastRestart = &now
+	s.nextRestart = now.Add(routerBackoff(s.restarts))
+	s.logger.Warn("Restarting router",
+		slog.Int("restarts", s.restarts),
+		slog.Time("nextRestart", s.nextRestart))
+	s.mutex.Unlock()
+
+	if err := process.Restart(); err != nil {
+		s.logger.Error("Unable to restart router", slog.Any("error", err))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.restarts >= routerCrashLoopRestarts {
+		if !s.crashLoop {
+			s.logger.Error("Router is crash looping", slog.Int("restarts", s.restarts))
+		}
+		s.crashLoop = true
+		s.logLines = logLines
+		s.setCrashLooping()
+	}
+	return true
+}
+
+func (s *RouterSupervisor) setCrashLooping() {
+	err := withRuntimeSiteState(s.namespace, func(path string, siteState *api.SiteState) error {
+		if !siteState.SetRouterCrashLooping(s.restarts) {
+			return nil
+		}
+		return api.MarshalResource(path, "Site", siteState.Site.Name, siteState.Site)
+	})
+	if err != nil {
+		s.logger.Warn("Unable to update site status", slog.Any("error", err))
+	}
+}
+
+func (s *RouterSupervisor) report(now time.Time) {
+	health := &api.SiteHealth{
+		UpdatedAt: now.UTC(),
+		Router: api.RouterHealth{
+			Running:      s.up,
+			Supervised:   s.supervised,
+			Restarts:     s.total,
+			LastRestart:  s.lastRestart,
+			CrashLoop:    s.crashLoop,
+			LastLogLines: s.logLines,
+		},
+	}
+	if err := api.WriteSiteHealth(s.namespace, health); err != nil {
+		s.logger.Warn("Unable to write health file", slog.Any("error", err))
+		return
+	}
+	s.lastReport = now
+	s.pending = false
+}
+
+// routerBackoff doubles the delay before the next restart for each
+// consecutive one, up to routerRestartBackoffLimit.
+func routerBackoff(restarts int) time.Duration {
+	backoff := routerRestartBackoff
+	for i := 1; i < restarts && backoff < routerRestartBackoffLimit; i++ {
+		backoff *= 2
+	}
+	return min(backoff, routerRestartBackoffLimit)
+}
+
+// resolveProcess returns the router process based on the platform of the
+// namespace, or nil if the router cannot be restarted from here, as is
+// the case for a linux site while the controller runs in a container.
+func (s *RouterSupervisor) resolveProcess() (routerProcess, error) {
+	platformLoader := &common.NamespacePlatformLoader{}
+	platform, err := platformLoader.Load(s.namespace)
+	if err != nil {
+		return nil, err
+	}
+	if types.Platform(platform).IsContainerEngine() {
+		cli, err := newContainerClient(types.Platform(platform))
+		if err != nil {
+			return nil, err
+		}
+		return &containerRouterProcess{
+			client: cli,
+			name:   s.namespace + "-skupper-router",
+		}, nil
+	}
+	if api.IsRunningInContainer() {
+		return nil, nil
+	}
+	return &systemdRouterProcess{
+		service: fmt.Sprintf("skupper-%s.service", s.namespace),
+		user:    os.Getuid() != 0,
+	}, nil
+}
+
+type containerRouterProcess struct {
+	client container.Client
+	name   string
+}
+
+func (p *containerRouterProcess) Running() (bool, error) {
+	c, err := p.client.ContainerInspect(p.name)
+	if err != nil {
+		return false, err
+	}
+	return c.Running, nil
+}
+
+func (p *containerRouterProcess) Restart() error {
+	return p.client.ContainerStart(p.name)
+}
+
+func (p *containerRouterProcess) Logs(lines int) ([]string, error) {
+	logs, err := p.client.ContainerLogs(p.name)
+	if err != nil {
+		return nil, err
+	}
+	return lastLines(logs, lines), nil
+}
+
+type systemdRouterProcess struct {
+	service string
+	user    bool
+}
+
+func (p *systemdRouterProcess) command(name string, args ...string) *exec.Cmd {
+	if p.user {
+		args = append([]string{"--user"}, args...)
+	}
+	return exec.Command(name, args...)
+}
+
+func (p *systemdRouterProcess) Running() (bool, error) {
+	out, err := p.command("systemctl", "show", "--property=ActiveState", "--value", p.service).Output()
+	if err != nil {
+		return false, err
+	}
+	switch strings.TrimSpace(string(out)) {
+	case "active", "activating", "reloading":
+		return true, nil
+	}
+	return false, nil
+}
+
+func (p *systemdRouterProcess) Restart() error {
+	// systemd refuses to start a unit that hit its start limit
+	_ = p.command("systemctl", "reset-failed", p.service).Run()
+	return p.command("systemctl", "restart", p.service).Run()
+}
+
+func (p *systemdRouterProcess) Logs(lines int) ([]string, error) {
+	out, err := p.command("journalctl", "--unit", p.service, "--lines", fmt.Sprint(lines), "--no-pager", "--output", "cat").Output()
+	if err != nil {
+		return nil, err
+	}
+	return lastLines(string(out), lines), nil
+}
+
+func lastLines(text string, count int) []string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return nil
+	}
+	if len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+	return lines
+}
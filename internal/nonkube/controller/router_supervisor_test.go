@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+type fakeRouterProcess struct {
+	running  bool
+	restarts int
+	err      error
+	logs     []string
+	// checking is called while the state of the router is checked
+	checking func()
+}
+
+func (p *fakeRouterProcess) Running() (bool, error) {
+	if p.checking != nil {
+		p.checking()
+	}
+	return p.running, p.err
+}
+
+func (p *fakeRouterProcess) Restart() error {
+	p.restarts++
+	return nil
+}
+
+func (p *fakeRouterProcess) Logs(lines int) ([]string, error) {
+	return p.logs, nil
+}
+
+func TestRouterSupervisor(t *testing.T) {
+	setDataHome(t)
+	siteState := fakeBridgeConfigSiteState()
+	siteState.Site.SetConfigured(nil)
+	assert.Assert(t, api.MarshalSiteState(*siteState, api.GetInternalOutputPath("test", api.RuntimeSiteStatePath)))
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	process := &fakeRouterProcess{logs: []string{"starting", "fatal error"}}
+	supervisor := NewRouterSupervisor("test")
+	supervisor.now = func() time.Time { return now }
+	supervisor.since = now
+	supervisor.resolve = func() (routerProcess, error) {
+		return process, nil
+	}
+	advance := func(d time.Duration) {
+		now = now.Add(d)
+		supervisor.check()
+	}
+	health := func() *api.SiteHealth {
+		t.Helper()
+		health, err := api.LoadSiteHealth("test")
+		assert.Assert(t, err)
+		return health
+	}
+
+	// nothing is done during the grace period
+	advance(routerSupervisorInterval)
+	assert.Equal(t, process.restarts, 0)
+	assert.Assert(t, health().Router.Supervised)
+	assert.Assert(t, !health().Router.Running)
+
+	// the router is restarted once it stays down
+	advance(routerDownGracePeriod)
+	assert.Equal(t, process.restarts, 1)
+	assert.Equal(t, health().Router.Restarts, 1)
+	assert.Assert(t, !health().Router.CrashLoop)
+
+	// and again, backing off, while it keeps failing
+	advance(routerRestartBackoff / 2)
+	assert.Equal(t, process.restarts, 1)
+	advance(routerRestartBackoff / 2)
+	assert.Equal(t, process.restarts, 2)
+	advance(routerRestartBackoff)
+	assert.Equal(t, process.restarts, 2)
+	advance(routerRestartBackoff)
+	assert.Equal(t, process.restarts, 3)
+	assert.Assert(t, health().Router.CrashLoop)
+	assert.DeepEqual(t, health().Router.LastLogLines, process.logs)
+	assert.Assert(t, withRuntimeSiteState("test", func(path string, siteState *api.SiteState) error {
+		assert.Equal(t, siteState.Site.Status.StatusType, v2alpha1.StatusError)
+		assert.Equal(t, siteState.Site.Status.Message, "Router is crash looping (restarted 3 times)")
+		return nil
+	}))
+
+	// a router that is running is left alone, even if not yet reported as up
+	process.running = true
+	advance(routerRestartBackoffLimit)
+	assert.Equal(t, process.restarts, 3)
+
+	// the crash loop is over once the router stays up for long enough
+	supervisor.Start(nil)
+	advance(routerSupervisorInterval)
+	assert.Assert(t, health().Router.Running)
+	assert.Assert(t, health().Router.CrashLoop)
+	advance(routerStablePeriod)
+	assert.Assert(t, !health().Router.CrashLoop)
+	assert.Equal(t, len(health().Router.LastLogLines), 0)
+	assert.Equal(t, health().Router.Restarts, 3)
+
+	// nothing is restarted when the state of the router is unknown
+	supervisor.Stop()
+	process.running = false
+	process.err = fmt.Errorf("no such container")
+	advance(routerDownGracePeriod)
+	assert.Equal(t, process.restarts, 3)
+	assert.Assert(t, !health().IsStale(now))
+}
+
+func TestRouterSupervisorStartWhileChecking(t *testing.T) {
+	setDataHome(t)
+	assert.Assert(t, os.MkdirAll(api.GetInternalOutputPath("test", api.RuntimePath), 0755))
+	now := time.Now()
+	process := &fakeRouterProcess{}
+	supervisor := NewRouterSupervisor("test")
+	supervisor.now = func() time.Time { return now }
+	supervisor.since = now
+	supervisor.resolve = func() (routerProcess, error) {
+		return process, nil
+	}
+	called := false
+	// the router comes up while the platform is queried
+	process.checking = func() {
+		called = true
+		started := make(chan struct{})
+		go func() {
+			supervisor.Start(nil)
+			close(started)
+		}()
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("router state change held up while checking the router")
+		}
+	}
+	now = now.Add(routerDownGracePeriod)
+	supervisor.check()
+	assert.Assert(t, called)
+	assert.Equal(t, process.restarts, 0)
+	health, err := api.LoadSiteHealth("test")
+	assert.Assert(t, err)
+	assert.Assert(t, health.Router.Running)
+	assert.Equal(t, health.Router.Restarts, 0)
+}
+
+func TestRouterSupervisorUnsupervised(t *testing.T) {
+	setDataHome(t)
+	assert.Assert(t, os.MkdirAll(api.GetInternalOutputPath("test", api.RuntimePath), 0755))
+	now := time.Now()
+	supervisor := NewRouterSupervisor("test")
+	supervisor.now = func() time.Time { return now }
+	supervisor.resolve = func() (routerProcess, error) {
+		return nil, nil
+	}
+	now = now.Add(routerDownGracePeriod)
+	supervisor.check()
+	health, err := api.LoadSiteHealth("test")
+	assert.Assert(t, err)
+	assert.Assert(t, !health.Router.Supervised)
+	assert.Equal(t, health.Router.Restarts, 0)
+}
+
+func TestRouterBackoff(t *testing.T) {
+	tests := []struct {
+		restarts int
+		expected time.Duration
+	}{
+		{restarts: 1, expected: 10 * time.Second},
+		{restarts: 2, expected: 20 * time.Second},
+		{restarts: 4, expected: 80 * time.Second},
+		{restarts: 6, expected: 5 * time.Minute},
+		{restarts: 100, expected: 5 * time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.restarts), func(t *testing.T) {
+			assert.Equal(t, routerBackoff(test.restarts), test.expected)
+		})
+	}
+}
+
+func TestLastLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "empty", text: "", expected: nil},
+		{name: "fewer", text: "a\nb\n", expected: []string{"a", "b"}},
+		{name: "more", text: "a\nb\nc\nd", expected: []string{"c", "d"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.DeepEqual(t, lastLines(test.text, 2), test.expected)
+		})
+	}
+}
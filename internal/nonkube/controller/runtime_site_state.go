@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"sync"
+
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+var runtimeSiteStateLocks sync.Map
+
+// withRuntimeSiteState loads the runtime site state of a namespace and
+// hands it to update, along with the directory it was loaded from, so
+// that the changes made can be written back. The handlers of a namespace
+// all go through here, so that an update made by one of them is not
+// overwritten by another one working from a stale copy.
+func withRuntimeSiteState(namespace string, update func(path string, siteState *api.SiteState) error) error {
+	lock, _ := runtimeSiteStateLocks.LoadOrStore(namespace, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	runtimeSiteStatePath := api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)
+	siteStateLoader := &common.FileSystemSiteStateLoader{
+		Path: runtimeSiteStatePath,
+	}
+	siteState, err := siteStateLoader.Load()
+	if err != nil {
+		return err
+	}
+	return update(runtimeSiteStatePath, siteState)
+}
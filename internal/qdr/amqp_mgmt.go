@@ -1195,6 +1195,23 @@ func (a *Agent) UpdateConnectorConfig(changes *ConnectorDifference) error {
 	return nil
 }
 
+// UpdateLocalConnectors applies the changes to the router's connectors
+// without checking the files referenced by their ssl profiles, for use
+// where the router's filesystem is not visible to the caller.
+func (a *Agent) UpdateLocalConnectors(changes *ConnectorDifference) error {
+	for _, deleted := range changes.Deleted {
+		if err := a.Delete("io.skupper.router.connector", deleted.Name); err != nil {
+			return fmt.Errorf("Error deleting connectors: %s", err)
+		}
+	}
+	for _, added := range changes.Added {
+		if err := a.Create("io.skupper.router.connector", added.Name, added); err != nil {
+			return fmt.Errorf("Error adding connectors: %s", err)
+		}
+	}
+	return nil
+}
+
 func (a *Agent) GetLocalConnectorStatus() (map[string]ConnectorStatus, error) {
 	results, err := a.Query("io.skupper.router.connector", []string{})
 	if err != nil {
@@ -0,0 +1,44 @@
+package site
+
+import (
+	"hash/fnv"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+)
+
+// TargetSpread distributes the connectors for pod targets across a
+// number of router groups. Each pod is assigned to Redundancy
+// consecutive groups, starting from one chosen by hashing its UID,
+// so that the assignment is stable as other pods come and go.
+// Connectors with a host rather than a pod target are not affected.
+type TargetSpread struct {
+	Group      int
+	Groups     int
+	Redundancy int
+}
+
+func (s TargetSpread) Assigned(processID string) bool {
+	if processID == "" || s.Redundancy >= s.Groups {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(processID))
+	first := int(h.Sum32() % uint32(s.Groups))
+	return (s.Group-first+s.Groups)%s.Groups < s.Redundancy
+}
+
+// Apply removes any connectors for pod targets not assigned to the
+// group.
+func (s TargetSpread) Apply(config *qdr.RouterConfig) bool {
+	changed := false
+	for name, connector := range config.Bridges.TcpConnectors {
+		if !s.Assigned(connector.ProcessID) {
+			config.RemoveTcpConnector(name)
+			changed = true
+		}
+	}
+	if changed {
+		config.RemoveUnreferencedSslProfiles()
+	}
+	return changed
+}
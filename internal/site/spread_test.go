@@ -0,0 +1,94 @@
+package site
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+)
+
+func TestTargetSpreadAssigned(t *testing.T) {
+	tests := []struct {
+		name       string
+		groups     int
+		redundancy int
+	}{
+		{
+			name:       "single group",
+			groups:     1,
+			redundancy: 1,
+		},
+		{
+			name:       "ha pair",
+			groups:     2,
+			redundancy: 2,
+		},
+		{
+			name:       "four groups",
+			groups:     4,
+			redundancy: 1,
+		},
+		{
+			name:       "four groups with redundancy",
+			groups:     4,
+			redundancy: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := make([]int, tt.groups)
+			for i := 0; i < 100; i++ {
+				uid := fmt.Sprintf("pod-uid-%d", i)
+				assigned := 0
+				for g := 0; g < tt.groups; g++ {
+					if (TargetSpread{Group: g, Groups: tt.groups, Redundancy: tt.redundancy}).Assigned(uid) {
+						assigned++
+						counts[g]++
+					}
+				}
+				assert.Equal(t, assigned, tt.redundancy, uid)
+			}
+			for g, count := range counts {
+				assert.Assert(t, count > 0, "no targets assigned to group %d", g)
+			}
+			// connectors for hosts rather than pods are always assigned
+			for g := 0; g < tt.groups; g++ {
+				assert.Assert(t, (TargetSpread{Group: g, Groups: tt.groups, Redundancy: tt.redundancy}).Assigned(""))
+			}
+		})
+	}
+}
+
+func TestTargetSpreadApply(t *testing.T) {
+	config := qdr.InitialConfig("foo", "bar", "1.0", false, 3)
+	config.AddTcpConnector(qdr.TcpEndpoint{Name: "host", Host: "myservice", Port: "8080", Address: "x"})
+	for i := 0; i < 10; i++ {
+		config.AddTcpConnector(qdr.TcpEndpoint{
+			Name:      fmt.Sprintf("pod-%d", i),
+			Host:      fmt.Sprintf("10.0.0.%d", i),
+			Port:      "8080",
+			Address:   "x",
+			ProcessID: fmt.Sprintf("uid-%d", i),
+		})
+	}
+	seen := map[string]int{}
+	for g := 0; g < 3; g++ {
+		groupConfig := config
+		groupConfig.Bridges = qdr.BridgeConfig{TcpListeners: qdr.TcpEndpointMap{}, TcpConnectors: qdr.TcpEndpointMap{}}
+		for k, v := range config.Bridges.TcpConnectors {
+			groupConfig.Bridges.TcpConnectors[k] = v
+		}
+		spread := TargetSpread{Group: g, Groups: 3, Redundancy: 1}
+		spread.Apply(&groupConfig)
+		assert.Assert(t, !spread.Apply(&groupConfig), "second apply should not change anything")
+		for name := range groupConfig.Bridges.TcpConnectors {
+			seen[name]++
+		}
+	}
+	assert.Equal(t, seen["host"], 3)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, seen[fmt.Sprintf("pod-%d", i)], 1)
+	}
+}
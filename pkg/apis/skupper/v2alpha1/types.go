@@ -174,6 +174,18 @@ func (s *Site) GetSiteId() string {
 	return string(s.ObjectMeta.UID)
 }
 
+// GetRouterReplicas returns the number of router groups for the
+// site. HA implies at least two.
+func (s *Site) GetRouterReplicas() int {
+	if s.Spec.RouterReplicas > 1 {
+		return s.Spec.RouterReplicas
+	}
+	if s.Spec.HA {
+		return 2
+	}
+	return 1
+}
+
 func (s *Site) DefaultIssuer() string {
 	if s.Spec.DefaultIssuer != "" {
 		return s.Spec.DefaultIssuer
@@ -199,6 +211,7 @@ type SiteSpec struct {
 	DefaultIssuer  string            `json:"defaultIssuer,omitempty"`
 	Edge           bool              `json:"edge,omitempty"`
 	HA             bool              `json:"ha,omitempty"`
+	RouterReplicas int               `json:"routerReplicas,omitempty"`
 	Settings       map[string]string `json:"settings,omitempty"`
 }
 
@@ -289,6 +302,18 @@ type SiteStatus struct {
 	Network        []SiteRecord `json:"network,omitempty"`
 	DefaultIssuer  string       `json:"defaultIssuer,omitempty"`
 	Controller     *Controller  `json:"controller,omitempty"`
+	Sizing         *Sizing      `json:"sizing,omitempty"`
+}
+
+// Sizing records the size most recently selected for the site's
+// routers when the size setting is 'auto', along with the load that
+// led to that choice.
+type Sizing struct {
+	Size               string  `json:"size,omitempty"`
+	Reason             string  `json:"reason,omitempty"`
+	FlowRate           int64   `json:"flowRate,omitempty"`
+	OctetRate          int64   `json:"octetRate,omitempty"`
+	LastTransitionTime v1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 type Controller struct {
@@ -773,12 +798,21 @@ type SecuredAccessSpec struct {
 	Certificate string              `json:"certificate,omitempty"`
 	Issuer      string              `json:"issuer,omitempty"`
 	Settings    map[string]string   `json:"settings,omitempty"`
+
+	// AccessTypes is an ordered list of access types to try in
+	// turn. If endpoints are not resolved for one within
+	// ResolutionTimeout, the next is used. Takes precedence over
+	// AccessType.
+	AccessTypes       []string `json:"accessTypes,omitempty"`
+	ResolutionTimeout string   `json:"resolutionTimeout,omitempty"`
 }
 
 type SecuredAccessStatus struct {
 	Status    `json:",inline"`
 	Endpoints []Endpoint `json:"endpoints,omitempty"`
 	Ca        string     `json:"ca,omitempty"`
+	// AccessType is the access type actually in use.
+	AccessType string `json:"accessType,omitempty"`
 }
 
 func (s *SecuredAccessStatus) GetEndpointByName(name string) *Endpoint {
@@ -1165,6 +1165,11 @@ func (in *SecuredAccessSpec) DeepCopyInto(out *SecuredAccessSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.AccessTypes != nil {
+		in, out := &in.AccessTypes, &out.AccessTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1375,6 +1380,11 @@ func (in *SiteStatus) DeepCopyInto(out *SiteStatus) {
 		*out = new(Controller)
 		**out = **in
 	}
+	if in.Sizing != nil {
+		in, out := &in.Sizing, &out.Sizing
+		*out = new(Sizing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1388,6 +1398,23 @@ func (in *SiteStatus) DeepCopy() *SiteStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sizing) DeepCopyInto(out *Sizing) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sizing.
+func (in *Sizing) DeepCopy() *Sizing {
+	if in == nil {
+		return nil
+	}
+	out := new(Sizing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Status) DeepCopyInto(out *Status) {
 	*out = *in
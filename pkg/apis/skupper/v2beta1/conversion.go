@@ -0,0 +1,184 @@
+package v2beta1
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+type fieldType int
+
+const (
+	stringField fieldType = iota
+	intField
+	optionalIntField
+	boolField
+)
+
+// promotedSetting maps an entry in the v2alpha1 spec.settings of a
+// resource to the typed field in the v2beta1 spec that replaces it.
+type promotedSetting struct {
+	setting   string
+	field     string
+	fieldType fieldType
+}
+
+var promotedSettings = map[string][]promotedSetting{
+	"Site": {
+		{setting: "size", field: "size", fieldType: stringField},
+		{setting: "router-logging", field: "routerLogging", fieldType: stringField},
+		{setting: "router-data-connection-count", field: "routerDataConnectionCount", fieldType: intField},
+		{setting: "tls-prior-valid-revisions", field: "tlsPriorValidRevisions", fieldType: optionalIntField},
+		{setting: "disable-anti-affinity", field: "disableAntiAffinity", fieldType: boolField},
+	},
+	"SecuredAccess": {
+		{setting: "domain", field: "domain", fieldType: stringField},
+	},
+}
+
+// toField returns the typed representation of a setting value. A
+// value is only promoted if the typed field can represent it
+// exactly, i.e. converting it back yields the same string; anything
+// else is left in settings so that conversion is lossless.
+func (p promotedSetting) toField(value string) (interface{}, bool) {
+	switch p.fieldType {
+	case stringField:
+		return value, value != ""
+	case intField, optionalIntField:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || strconv.FormatInt(i, 10) != value {
+			return nil, false
+		}
+		if i == 0 && p.fieldType == intField {
+			return nil, false
+		}
+		return i, true
+	case boolField:
+		return true, value == "true"
+	}
+	return nil, false
+}
+
+func (p promotedSetting) toSetting(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case int64:
+		return strconv.FormatInt(v, 10), v != 0 || p.fieldType == optionalIntField
+	case float64:
+		return strconv.FormatInt(int64(v), 10), v != 0 || p.fieldType == optionalIntField
+	case bool:
+		return "true", v
+	}
+	return "", false
+}
+
+// ConvertToV2beta1 converts a skupper.io/v2alpha1 resource into its
+// skupper.io/v2beta1 representation, promoting settings for which
+// there is a typed field.
+func ConvertToV2beta1(in *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if in.GetAPIVersion() == SchemeGroupVersion.String() {
+		return in.DeepCopy(), nil
+	}
+	if in.GetAPIVersion() != v2alpha1.SchemeGroupVersion.String() {
+		return nil, fmt.Errorf("Cannot convert %s to %s", in.GetAPIVersion(), SchemeGroupVersion.String())
+	}
+	out := in.DeepCopy()
+	out.SetAPIVersion(SchemeGroupVersion.String())
+	settings, found, err := unstructured.NestedStringMap(out.Object, "spec", "settings")
+	if err != nil {
+		return nil, fmt.Errorf("Invalid settings for %s %s/%s: %s", in.GetKind(), in.GetNamespace(), in.GetName(), err)
+	}
+	if !found {
+		return out, nil
+	}
+	promoted := false
+	for _, p := range promotedSettings[in.GetKind()] {
+		value, ok := settings[p.setting]
+		if !ok {
+			continue
+		}
+		field, ok := p.toField(value)
+		if !ok {
+			continue
+		}
+		if err := unstructured.SetNestedField(out.Object, field, "spec", p.field); err != nil {
+			return nil, err
+		}
+		delete(settings, p.setting)
+		promoted = true
+	}
+	if promoted {
+		if err := setSettings(out, settings); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// ConvertToV2alpha1 converts a skupper.io/v2beta1 resource into its
+// skupper.io/v2alpha1 representation, moving typed fields that have
+// no v2alpha1 equivalent into settings. Where both a typed field and
+// the corresponding setting are specified, the typed field wins.
+func ConvertToV2alpha1(in *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if in.GetAPIVersion() == v2alpha1.SchemeGroupVersion.String() {
+		return in.DeepCopy(), nil
+	}
+	if in.GetAPIVersion() != SchemeGroupVersion.String() {
+		return nil, fmt.Errorf("Cannot convert %s to %s", in.GetAPIVersion(), v2alpha1.SchemeGroupVersion.String())
+	}
+	out := in.DeepCopy()
+	out.SetAPIVersion(v2alpha1.SchemeGroupVersion.String())
+	settings, _, err := unstructured.NestedStringMap(out.Object, "spec", "settings")
+	if err != nil {
+		return nil, fmt.Errorf("Invalid settings for %s %s/%s: %s", in.GetKind(), in.GetNamespace(), in.GetName(), err)
+	}
+	if settings == nil {
+		settings = map[string]string{}
+	}
+	demoted := false
+	for _, p := range promotedSettings[in.GetKind()] {
+		value, found, err := unstructured.NestedFieldNoCopy(out.Object, "spec", p.field)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		unstructured.RemoveNestedField(out.Object, "spec", p.field)
+		if setting, ok := p.toSetting(value); ok {
+			settings[p.setting] = setting
+		}
+		demoted = true
+	}
+	if demoted {
+		if err := setSettings(out, settings); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Convert converts a skupper.io resource to the requested API
+// version.
+func Convert(in *unstructured.Unstructured, apiVersion string) (*unstructured.Unstructured, error) {
+	switch apiVersion {
+	case SchemeGroupVersion.String():
+		return ConvertToV2beta1(in)
+	case v2alpha1.SchemeGroupVersion.String():
+		return ConvertToV2alpha1(in)
+	default:
+		return nil, fmt.Errorf("Unsupported API version %s", apiVersion)
+	}
+}
+
+func setSettings(obj *unstructured.Unstructured, settings map[string]string) error {
+	if len(settings) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "spec", "settings")
+		return nil
+	}
+	return unstructured.SetNestedStringMap(obj.Object, settings, "spec", "settings")
+}
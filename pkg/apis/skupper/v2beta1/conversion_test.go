@@ -0,0 +1,255 @@
+package v2beta1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func object(apiVersion string, kind string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":      "test",
+				"namespace": "test",
+			},
+		},
+	}
+	if spec != nil {
+		obj.Object["spec"] = spec
+	}
+	return obj
+}
+
+func TestConvertToV2beta1(t *testing.T) {
+	var tests = []struct {
+		name          string
+		input         *unstructured.Unstructured
+		expected      *unstructured.Unstructured
+		expectedError string
+	}{
+		{
+			name: "site settings promoted",
+			input: object("skupper.io/v2alpha1", "Site", map[string]interface{}{
+				"linkAccess": "default",
+				"settings": map[string]interface{}{
+					"size":                         "large",
+					"router-logging":               "debug",
+					"router-data-connection-count": "8",
+					"tls-prior-valid-revisions":    "0",
+					"disable-anti-affinity":        "true",
+				},
+			}),
+			expected: object("skupper.io/v2beta1", "Site", map[string]interface{}{
+				"linkAccess":                "default",
+				"size":                      "large",
+				"routerLogging":             "debug",
+				"routerDataConnectionCount": int64(8),
+				"tlsPriorValidRevisions":    int64(0),
+				"disableAntiAffinity":       true,
+			}),
+		},
+		{
+			name: "unrepresentable settings retained",
+			input: object("skupper.io/v2alpha1", "Site", map[string]interface{}{
+				"settings": map[string]interface{}{
+					"size":                         "small",
+					"router-data-connection-count": "auto",
+					"tls-prior-valid-revisions":    "03",
+					"disable-anti-affinity":        "false",
+					"some-other-setting":           "foo",
+				},
+			}),
+			expected: object("skupper.io/v2beta1", "Site", map[string]interface{}{
+				"size": "small",
+				"settings": map[string]interface{}{
+					"router-data-connection-count": "auto",
+					"tls-prior-valid-revisions":    "03",
+					"disable-anti-affinity":        "false",
+					"some-other-setting":           "foo",
+				},
+			}),
+		},
+		{
+			name: "secured access domain",
+			input: object("skupper.io/v2alpha1", "SecuredAccess", map[string]interface{}{
+				"accessType": "route",
+				"settings": map[string]interface{}{
+					"domain": "example.com",
+				},
+			}),
+			expected: object("skupper.io/v2beta1", "SecuredAccess", map[string]interface{}{
+				"accessType": "route",
+				"domain":     "example.com",
+			}),
+		},
+		{
+			name: "settings of other kinds untouched",
+			input: object("skupper.io/v2alpha1", "Listener", map[string]interface{}{
+				"settings": map[string]interface{}{
+					"size": "large",
+				},
+			}),
+			expected: object("skupper.io/v2beta1", "Listener", map[string]interface{}{
+				"settings": map[string]interface{}{
+					"size": "large",
+				},
+			}),
+		},
+		{
+			name:     "same version",
+			input:    object("skupper.io/v2beta1", "Site", map[string]interface{}{"size": "large"}),
+			expected: object("skupper.io/v2beta1", "Site", map[string]interface{}{"size": "large"}),
+		},
+		{
+			name:          "unknown version",
+			input:         object("skupper.io/v1", "Site", nil),
+			expectedError: "Cannot convert skupper.io/v1 to skupper.io/v2beta1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ConvertToV2beta1(tt.input)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.Assert(t, err)
+			assert.DeepEqual(t, actual, tt.expected)
+		})
+	}
+}
+
+func TestConvertToV2alpha1(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    *unstructured.Unstructured
+		expected *unstructured.Unstructured
+	}{
+		{
+			name: "site fields demoted",
+			input: object("skupper.io/v2beta1", "Site", map[string]interface{}{
+				"size":                      "large",
+				"routerLogging":             "debug",
+				"routerDataConnectionCount": int64(8),
+				"tlsPriorValidRevisions":    int64(0),
+				"disableAntiAffinity":       true,
+				"settings": map[string]interface{}{
+					"some-other-setting": "foo",
+				},
+			}),
+			expected: object("skupper.io/v2alpha1", "Site", map[string]interface{}{
+				"settings": map[string]interface{}{
+					"size":                         "large",
+					"router-logging":               "debug",
+					"router-data-connection-count": "8",
+					"tls-prior-valid-revisions":    "0",
+					"disable-anti-affinity":        "true",
+					"some-other-setting":           "foo",
+				},
+			}),
+		},
+		{
+			name: "typed field overrides setting",
+			input: object("skupper.io/v2beta1", "SecuredAccess", map[string]interface{}{
+				"domain": "example.com",
+				"settings": map[string]interface{}{
+					"domain": "example.org",
+				},
+			}),
+			expected: object("skupper.io/v2alpha1", "SecuredAccess", map[string]interface{}{
+				"settings": map[string]interface{}{
+					"domain": "example.com",
+				},
+			}),
+		},
+		{
+			name:     "zero values dropped",
+			input:    object("skupper.io/v2beta1", "Site", map[string]interface{}{"disableAntiAffinity": false}),
+			expected: object("skupper.io/v2alpha1", "Site", map[string]interface{}{}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ConvertToV2alpha1(tt.input)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, actual, tt.expected)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	var tests = []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "site with settings",
+			input: `{"apiVersion":"skupper.io/v2alpha1","kind":"Site","metadata":{"name":"a"},"spec":{"ha":true,"settings":{"size":"medium","router-logging":"trace","router-data-connection-count":"4","tls-prior-valid-revisions":"5","disable-anti-affinity":"true","other":"x"}}}`,
+		},
+		{
+			name:  "site with unparseable settings",
+			input: `{"apiVersion":"skupper.io/v2alpha1","kind":"Site","metadata":{"name":"a"},"spec":{"settings":{"router-data-connection-count":"0","tls-prior-valid-revisions":"-1x","disable-anti-affinity":"TRUE","size":""}}}`,
+		},
+		{
+			name:  "site with empty settings",
+			input: `{"apiVersion":"skupper.io/v2alpha1","kind":"Site","metadata":{"name":"a"},"spec":{"settings":{}}}`,
+		},
+		{
+			name:  "site without spec",
+			input: `{"apiVersion":"skupper.io/v2alpha1","kind":"Site","metadata":{"name":"a"}}`,
+		},
+		{
+			name:  "secured access",
+			input: `{"apiVersion":"skupper.io/v2alpha1","kind":"SecuredAccess","metadata":{"name":"a"},"spec":{"selector":{"a":"b"},"ports":[{"name":"x","port":8080}],"settings":{"domain":"example.com"}},"status":{"status":"Ready"}}`,
+		},
+		{
+			name:  "connector",
+			input: `{"apiVersion":"skupper.io/v2alpha1","kind":"Connector","metadata":{"name":"a"},"spec":{"routingKey":"x","port":8080,"settings":{"size":"large"}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := &unstructured.Unstructured{}
+			assert.Assert(t, original.UnmarshalJSON([]byte(tt.input)))
+			beta, err := ConvertToV2beta1(original)
+			assert.Assert(t, err)
+			// ensure the converted object is valid as a typed v2beta1 resource
+			data, err := beta.MarshalJSON()
+			assert.Assert(t, err)
+			assert.Assert(t, json.Unmarshal(data, &map[string]interface{}{}))
+			alpha, err := ConvertToV2alpha1(beta)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, alpha, original)
+		})
+	}
+}
+
+func TestTypedDecoding(t *testing.T) {
+	input := object("skupper.io/v2alpha1", "Site", map[string]interface{}{
+		"settings": map[string]interface{}{
+			"size":                         "large",
+			"router-data-connection-count": "8",
+			"tls-prior-valid-revisions":    "0",
+			"other":                        "x",
+		},
+	})
+	converted, err := Convert(input, "skupper.io/v2beta1")
+	assert.Assert(t, err)
+	data, err := converted.MarshalJSON()
+	assert.Assert(t, err)
+	site := &Site{}
+	assert.Assert(t, json.Unmarshal(data, site))
+	assert.Equal(t, site.Spec.Size, "large")
+	assert.Equal(t, site.Spec.RouterDataConnectionCount, 8)
+	assert.Assert(t, site.Spec.TlsPriorValidRevisions != nil)
+	assert.Equal(t, *site.Spec.TlsPriorValidRevisions, 0)
+	assert.DeepEqual(t, site.Spec.Settings, map[string]string{"other": "x"})
+
+	_, err = Convert(input, "skupper.io/v3")
+	assert.ErrorContains(t, err, "Unsupported API version skupper.io/v3")
+}
@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=skupper.io
+
+package v2beta1 // import "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
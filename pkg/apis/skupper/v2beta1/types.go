@@ -0,0 +1,517 @@
+package v2beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type StatusType string
+
+const (
+	StatusReady   StatusType = "Ready"
+	StatusPending StatusType = "Pending"
+	StatusError   StatusType = "Error"
+)
+
+const STATUS_OK = "OK"
+
+const CONDITION_TYPE_CONFIGURED = "Configured"
+const CONDITION_TYPE_RESOLVED = "Resolved"
+const CONDITION_TYPE_RUNNING = "Running"
+const CONDITION_TYPE_MATCHED = "Matched"
+const CONDITION_TYPE_PROCESSED = "Processed"
+const CONDITION_TYPE_REDEEMED = "Redeemed"
+const CONDITION_TYPE_OPERATIONAL = "Operational"
+const CONDITION_TYPE_READY = "Ready"
+
+type Status struct {
+	Conditions []v1.Condition `json:"conditions,omitempty"`
+	StatusType StatusType     `json:"status,omitempty"`
+	Message    string         `json:"message,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Site defines the location and configuration of a skupper site
+type Site struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          SiteSpec   `json:"spec,omitempty"`
+	Status        SiteStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SiteList contains a List of Site instances
+type SiteList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []Site `json:"items"`
+}
+
+type SiteSpec struct {
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	LinkAccess     string `json:"linkAccess,omitempty"`
+	DefaultIssuer  string `json:"defaultIssuer,omitempty"`
+	Edge           bool   `json:"edge,omitempty"`
+	HA             bool   `json:"ha,omitempty"`
+	// RouterReplicas is the number of router groups, each with
+	// its own deployment and configuration. HA implies at least
+	// two.
+	RouterReplicas int `json:"routerReplicas,omitempty"`
+	// Size names the site sizing ConfigMap used for router
+	// resources (the 'size' setting in v2alpha1).
+	Size string `json:"size,omitempty"`
+	// RouterLogging is the router log configuration, e.g.
+	// 'info' or 'ROUTER:debug,ROUTER_CORE:trace' (the
+	// 'router-logging' setting in v2alpha1).
+	RouterLogging string `json:"routerLogging,omitempty"`
+	// RouterDataConnectionCount is the number of inter-router
+	// data connections (the 'router-data-connection-count'
+	// setting in v2alpha1).
+	RouterDataConnectionCount int `json:"routerDataConnectionCount,omitempty"`
+	// TlsPriorValidRevisions is the number of previous
+	// revisions of TLS credentials that remain valid after a
+	// rotation (the 'tls-prior-valid-revisions' setting in
+	// v2alpha1).
+	TlsPriorValidRevisions *int `json:"tlsPriorValidRevisions,omitempty"`
+	// DisableAntiAffinity disables the pod anti-affinity rules
+	// applied to HA router pairs (the 'disable-anti-affinity'
+	// setting in v2alpha1).
+	DisableAntiAffinity bool              `json:"disableAntiAffinity,omitempty"`
+	Settings            map[string]string `json:"settings,omitempty"`
+}
+
+type SiteStatus struct {
+	Status         `json:",inline"`
+	Endpoints      []Endpoint   `json:"endpoints,omitempty"`
+	SitesInNetwork int          `json:"sitesInNetwork,omitempty"`
+	Network        []SiteRecord `json:"network,omitempty"`
+	DefaultIssuer  string       `json:"defaultIssuer,omitempty"`
+	Controller     *Controller  `json:"controller,omitempty"`
+	Sizing         *Sizing      `json:"sizing,omitempty"`
+}
+
+// Sizing records the size most recently selected for the site's
+// routers when the size setting is 'auto', along with the load that
+// led to that choice.
+type Sizing struct {
+	Size               string  `json:"size,omitempty"`
+	Reason             string  `json:"reason,omitempty"`
+	FlowRate           int64   `json:"flowRate,omitempty"`
+	OctetRate          int64   `json:"octetRate,omitempty"`
+	LastTransitionTime v1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type Controller struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Version   string `json:"version,omitempty"`
+}
+
+type Endpoint struct {
+	Name  string `json:"name,omitempty"`
+	Host  string `json:"host,omitempty"`
+	Port  string `json:"port,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+type SiteRecord struct {
+	Id        string          `json:"id,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Namespace string          `json:"namespace,omitempty"`
+	Platform  string          `json:"platform,omitempty"`
+	Version   string          `json:"version,omitempty"`
+	Links     []LinkRecord    `json:"links,omitempty"`
+	Services  []ServiceRecord `json:"services,omitempty"`
+}
+
+type ServiceRecord struct {
+	RoutingKey string   `json:"routingKey,omitempty"`
+	Connectors []string `json:"connectors,omitempty"`
+	Listeners  []string `json:"listeners,omitempty"`
+}
+
+type LinkRecord struct {
+	Name           string `json:"name,omitempty"`
+	RemoteSiteId   string `json:"remoteSiteId,omitempty"`
+	RemoteSiteName string `json:"remoteSiteName,omitempty"`
+	Operational    bool   `json:"operational,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Listener struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          ListenerSpec   `json:"spec,omitempty"`
+	Status        ListenerStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ListenerList contains a List of Listener instances
+type ListenerList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []Listener `json:"items"`
+}
+
+type ListenerSpec struct {
+	RoutingKey       string            `json:"routingKey"`
+	Host             string            `json:"host"`
+	Port             int               `json:"port"`
+	TlsCredentials   string            `json:"tlsCredentials,omitempty"`
+	Type             string            `json:"type,omitempty"`
+	ExposePodsByName bool              `json:"exposePodsByName,omitempty"`
+	Settings         map[string]string `json:"settings,omitempty"`
+}
+
+type ListenerStatus struct {
+	Status               `json:",inline"`
+	HasMatchingConnector bool `json:"hasMatchingConnector,omitempty"`
+}
+
+type ServicePort struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Connector struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          ConnectorSpec   `json:"spec,omitempty"`
+	Status        ConnectorStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConnectorList contains a List of Connector instances
+type ConnectorList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []Connector `json:"items"`
+}
+
+type ConnectorSpec struct {
+	RoutingKey          string            `json:"routingKey"`
+	Host                string            `json:"host,omitempty"`
+	Selector            string            `json:"selector,omitempty"`
+	Port                int               `json:"port"`
+	TlsCredentials      string            `json:"tlsCredentials,omitempty"`
+	UseClientCert       bool              `json:"useClientCert,omitempty"`
+	VerifyHostname      bool              `json:"verifyHostname,omitempty"`
+	Type                string            `json:"type,omitempty"`
+	ExposePodsByName    bool              `json:"exposePodsByName,omitempty"`
+	IncludeNotReadyPods bool              `json:"includeNotReadyPods,omitempty"`
+	Settings            map[string]string `json:"settings,omitempty"`
+}
+
+type PodDetails struct {
+	UID  string `json:"-"`
+	Name string `json:"name,omitempty"`
+	IP   string `json:"ip,omitempty"`
+}
+
+type ConnectorStatus struct {
+	Status              `json:",inline"`
+	SelectedPods        []PodDetails `json:"selectedPods,omitempty"`
+	HasMatchingListener bool         `json:"hasMatchingListener,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Link struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          LinkSpec   `json:"spec,omitempty"`
+	Status        LinkStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LinkList contains a List of Link instances
+type LinkList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []Link `json:"items"`
+}
+
+type LinkSpec struct {
+	Endpoints      []Endpoint        `json:"endpoints"`
+	TlsCredentials string            `json:"tlsCredentials,omitempty"`
+	Cost           int               `json:"cost,omitempty"`
+	Settings       map[string]string `json:"settings,omitempty"`
+}
+
+type LinkStatus struct {
+	Status         `json:",inline"`
+	RemoteSiteId   string `json:"remoteSiteId,omitempty"`
+	RemoteSiteName string `json:"remoteSiteName,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AccessToken struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          AccessTokenSpec   `json:"spec,omitempty"`
+	Status        AccessTokenStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessTokenList contains a List of AccessToken instances
+type AccessTokenList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []AccessToken `json:"items"`
+}
+
+type AccessTokenSpec struct {
+	Url      string            `json:"url"`
+	Code     string            `json:"code"`
+	Ca       string            `json:"ca"`
+	LinkCost int               `json:"linkCost,omitempty"`
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+type AccessTokenStatus struct {
+	Status   `json:",inline"`
+	Redeemed bool `json:"redeemed,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AccessGrant struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          AccessGrantSpec   `json:"spec,omitempty"`
+	Status        AccessGrantStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessGrantList contains a List of AccessGrant instances
+type AccessGrantList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []AccessGrant `json:"items"`
+}
+
+type AccessGrantSpec struct {
+	RedemptionsAllowed int               `json:"redemptionsAllowed,omitempty"`
+	ExpirationWindow   string            `json:"expirationWindow,omitempty"`
+	Code               string            `json:"code,omitempty"`
+	Issuer             string            `json:"issuer,omitempty"`
+	Settings           map[string]string `json:"settings,omitempty"`
+}
+
+type AccessGrantStatus struct {
+	Status         `json:",inline"`
+	Url            string `json:"url,omitempty"`
+	Code           string `json:"code,omitempty"`
+	Ca             string `json:"ca,omitempty"`
+	Redemptions    int    `json:"redemptions,omitempty"`
+	ExpirationTime string `json:"expirationTime,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecuredAccess struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          SecuredAccessSpec   `json:"spec,omitempty"`
+	Status        SecuredAccessStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecuredAccessList contains a List of SecuredAccess instances
+type SecuredAccessList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []SecuredAccess `json:"items"`
+}
+
+type SecuredAccessPort struct {
+	Name       string `json:"name"`
+	Port       int    `json:"port"`
+	TargetPort int    `json:"targetPort,omitempty"`
+	Protocol   string `json:"protocol,omitempty"`
+}
+
+type SecuredAccessSpec struct {
+	AccessType  string              `json:"accessType,omitempty"`
+	Selector    map[string]string   `json:"selector"`
+	Ports       []SecuredAccessPort `json:"ports"`
+	Certificate string              `json:"certificate,omitempty"`
+	Issuer      string              `json:"issuer,omitempty"`
+	// Domain is the domain under which route hostnames are
+	// generated (the 'domain' setting in v2alpha1).
+	Domain   string            `json:"domain,omitempty"`
+	Settings map[string]string `json:"settings,omitempty"`
+
+	// AccessTypes is an ordered list of access types to try in
+	// turn. If endpoints are not resolved for one within
+	// ResolutionTimeout, the next is used. Takes precedence over
+	// AccessType.
+	AccessTypes       []string `json:"accessTypes,omitempty"`
+	ResolutionTimeout string   `json:"resolutionTimeout,omitempty"`
+}
+
+type SecuredAccessStatus struct {
+	Status    `json:",inline"`
+	Endpoints []Endpoint `json:"endpoints,omitempty"`
+	Ca        string     `json:"ca,omitempty"`
+	// AccessType is the access type actually in use.
+	AccessType string `json:"accessType,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Certificate struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          CertificateSpec   `json:"spec,omitempty"`
+	Status        CertificateStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateList contains a List of Certificate instances
+type CertificateList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []Certificate `json:"items"`
+}
+
+type CertificateSpec struct {
+	Ca       string            `json:"ca"`
+	Subject  string            `json:"subject"`
+	Hosts    []string          `json:"hosts,omitempty"`
+	Client   bool              `json:"client,omitempty"`
+	Server   bool              `json:"server,omitempty"`
+	Signing  bool              `json:"signing,omitempty"`
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+type CertificateStatus struct {
+	Status     `json:",inline"`
+	Expiration string `json:"expiration,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RouterAccess struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          RouterAccessSpec   `json:"spec,omitempty"`
+	Status        RouterAccessStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RouterAccessList contains a List of RouterAccess instances
+type RouterAccessList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []RouterAccess `json:"items"`
+}
+
+type RouterAccessRole struct {
+	Name string `json:"name"`
+	Port int    `json:"port,omitempty"`
+}
+
+type RouterAccessSpec struct {
+	AccessType              string             `json:"accessType,omitempty"`
+	Roles                   []RouterAccessRole `json:"roles"`
+	TlsCredentials          string             `json:"tlsCredentials"`
+	GenerateTlsCredentials  bool               `json:"generateTlsCredentials,omitempty"`
+	Issuer                  string             `json:"issuer,omitempty"`
+	BindHost                string             `json:"bindHost,omitempty"`
+	SubjectAlternativeNames []string           `json:"subjectAlternativeNames,omitempty"`
+	Settings                map[string]string  `json:"settings,omitempty"`
+}
+
+type RouterAccessStatus struct {
+	Status    `json:",inline"`
+	Endpoints []Endpoint `json:"endpoints,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AttachedConnector struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          AttachedConnectorSpec   `json:"spec,omitempty"`
+	Status        AttachedConnectorStatus `json:"status,omitempty"`
+}
+
+type AttachedConnectorStatus struct {
+	Status       `json:",inline"`
+	SelectedPods []PodDetails `json:"selectedPods,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AttachedConnectorList contains a List of AttachedConnector instances
+type AttachedConnectorList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []AttachedConnector `json:"items"`
+}
+
+type AttachedConnectorSpec struct {
+	SiteNamespace       string            `json:"siteNamespace"`
+	Selector            string            `json:"selector"`
+	Port                int               `json:"port"`
+	TlsCredentials      string            `json:"tlsCredentials,omitempty"`
+	UseClientCert       bool              `json:"useClientCert,omitempty"`
+	Type                string            `json:"type,omitempty"`
+	IncludeNotReadyPods bool              `json:"includeNotReadyPods,omitempty"`
+	Settings            map[string]string `json:"settings,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AttachedConnectorBinding struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          AttachedConnectorBindingSpec   `json:"spec,omitempty"`
+	Status        AttachedConnectorBindingStatus `json:"status,omitempty"`
+}
+
+type AttachedConnectorBindingStatus struct {
+	Status              `json:",inline"`
+	HasMatchingListener bool `json:"hasMatchingListener,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AttachedConnectorBindingList contains a List of AttachedConnectorBinding instances
+type AttachedConnectorBindingList struct {
+	v1.TypeMeta `json:",inline"`
+	v1.ListMeta `json:"metadata,omitempty"`
+	Items       []AttachedConnectorBinding `json:"items"`
+}
+
+type AttachedConnectorBindingSpec struct {
+	ConnectorNamespace string            `json:"connectorNamespace"`
+	RoutingKey         string            `json:"routingKey"`
+	ExposePodsByName   bool              `json:"exposePodsByName,omitempty"`
+	Settings           map[string]string `json:"settings,omitempty"`
+}
@@ -28,6 +28,7 @@ type Client interface {
 	ContainerStart(id string) error
 	ContainerStop(id string) error
 	ContainerRestart(id string) error
+	ContainerEvents(ctx context.Context, since time.Time, handler func(event ContainerEvent)) error
 	ImageList() ([]*Image, error)
 	ImageInspect(id string) (*Image, error)
 	ImagePull(ctx context.Context, id string) error
@@ -83,6 +84,21 @@ type Container struct {
 	ExitCode       int
 }
 
+// ContainerEvent is a change to the state of a container, reported by
+// the container engine
+type ContainerEvent struct {
+	ID     string
+	Name   string
+	Action string
+	Time   time.Time
+}
+
+const (
+	ContainerEventStart   = "start"
+	ContainerEventDie     = "die"
+	ContainerEventDestroy = "destroy"
+)
+
 func (c *Container) FromEnv(env []string) {
 	if c.Env == nil {
 		c.Env = make(map[string]string)
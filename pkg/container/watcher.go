@@ -0,0 +1,125 @@
+package container
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+)
+
+const containerWatcherRetryDelay = 5 * time.Second
+
+// ContainerWatcher notifies an Informer about the running containers of
+// the container engine, as they are started and stopped. Containers that
+// are already running are reported once the watcher is started and those
+// that stop running are reported as deleted.
+type ContainerWatcher struct {
+	client     Client
+	informer   Informer[*Container]
+	containers map[string]*Container
+	retryDelay time.Duration
+	logger     *slog.Logger
+}
+
+func NewContainerWatcher(client Client, informer Informer[*Container]) *ContainerWatcher {
+	return &ContainerWatcher{
+		client:     client,
+		informer:   informer,
+		containers: map[string]*Container{},
+		retryDelay: containerWatcherRetryDelay,
+		logger:     slog.Default().With("component", "container.watcher"),
+	}
+}
+
+func (w *ContainerWatcher) Start(stopCh <-chan struct{}) {
+	go w.run(stopCh)
+}
+
+// run lists the running containers and then follows the events of the
+// container engine until stopCh is closed. If the event stream is
+// interrupted, containers are listed again once it is reestablished, so
+// that changes missed in between are not lost.
+func (w *ContainerWatcher) run(stopCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+	for {
+		since := time.Now()
+		err := w.resync()
+		if err == nil {
+			err = w.client.ContainerEvents(ctx, since, w.handle)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Error("Unable to watch containers, will retry", slog.Any("error", err))
+		}
+		select {
+		case <-stopCh:
+			return
+		case <-time.After(w.retryDelay):
+		}
+	}
+}
+
+func (w *ContainerWatcher) resync() error {
+	containers, err := w.client.ContainerList()
+	if err != nil {
+		return err
+	}
+	running := map[string]*Container{}
+	for _, c := range containers {
+		if c.Running {
+			running[c.ID] = c
+		}
+	}
+	for id, c := range w.containers {
+		if _, ok := running[id]; !ok {
+			w.delete(c)
+		}
+	}
+	for _, c := range running {
+		w.update(c)
+	}
+	return nil
+}
+
+func (w *ContainerWatcher) handle(event ContainerEvent) {
+	switch event.Action {
+	case ContainerEventStart:
+		c, err := w.client.ContainerInspect(event.ID)
+		if err != nil {
+			w.logger.Debug("Ignoring container event",
+				slog.String("name", event.Name),
+				slog.String("action", event.Action),
+				slog.Any("error", err))
+			return
+		}
+		if c.Running {
+			w.update(c)
+		}
+	case ContainerEventDie, ContainerEventDestroy:
+		if c, ok := w.containers[event.ID]; ok {
+			w.delete(c)
+		}
+	}
+}
+
+func (w *ContainerWatcher) update(c *Container) {
+	existing, ok := w.containers[c.ID]
+	w.containers[c.ID] = c
+	if !ok {
+		w.informer.OnAdd(c)
+	} else if !reflect.DeepEqual(existing.Labels, c.Labels) || !reflect.DeepEqual(existing.Networks, c.Networks) {
+		w.informer.OnUpdate(existing, c)
+	}
+}
+
+func (w *ContainerWatcher) delete(c *Container) {
+	delete(w.containers, c.ID)
+	w.informer.OnDelete(c)
+}
@@ -0,0 +1,113 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+type fakeEventsClient struct {
+	Client
+	mutex      sync.Mutex
+	containers map[string]*Container
+	events     chan ContainerEvent
+}
+
+func (f *fakeEventsClient) ContainerList() ([]*Container, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var containers []*Container
+	for _, c := range f.containers {
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
+
+func (f *fakeEventsClient) ContainerInspect(id string) (*Container, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	c, ok := f.containers[id]
+	if !ok {
+		return nil, fmt.Errorf("container %q not found", id)
+	}
+	return c, nil
+}
+
+func (f *fakeEventsClient) ContainerEvents(ctx context.Context, since time.Time, handler func(event ContainerEvent)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-f.events:
+			handler(event)
+		}
+	}
+}
+
+func (f *fakeEventsClient) set(c *Container) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.containers[c.ID] = c
+}
+
+func TestContainerWatcher(t *testing.T) {
+	client := &fakeEventsClient{
+		containers: map[string]*Container{
+			"1": {ID: "1", Name: "backend-1", Running: true},
+			"2": {ID: "2", Name: "backend-2"},
+		},
+		events: make(chan ContainerEvent),
+	}
+	notifications := make(chan string, 10)
+	informer := &InformerBase[*Container]{
+		Add: func(c *Container) {
+			notifications <- "add " + c.Name
+		},
+		Update: func(oldObj, newObj *Container) {
+			notifications <- "update " + newObj.Name
+		},
+		Delete: func(c *Container) {
+			notifications <- "delete " + c.Name
+		},
+	}
+	expect := func(notification string) {
+		t.Helper()
+		select {
+		case received := <-notifications:
+			assert.Equal(t, received, notification)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", notification)
+		}
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	NewContainerWatcher(client, informer).Start(stopCh)
+
+	expect("add backend-1")
+
+	client.set(&Container{ID: "2", Name: "backend-2", Running: true})
+	client.events <- ContainerEvent{ID: "2", Name: "backend-2", Action: ContainerEventStart}
+	expect("add backend-2")
+
+	client.set(&Container{ID: "2", Name: "backend-2", Running: true, Networks: map[string]ContainerNetworkInfo{
+		"skupper": {IPAddress: "10.88.0.5"},
+	}})
+	client.events <- ContainerEvent{ID: "2", Name: "backend-2", Action: ContainerEventStart}
+	expect("update backend-2")
+
+	client.events <- ContainerEvent{ID: "1", Name: "backend-1", Action: ContainerEventDie}
+	expect("delete backend-1")
+
+	// events about containers that are unknown or no longer running are ignored
+	client.events <- ContainerEvent{ID: "1", Name: "backend-1", Action: ContainerEventDestroy}
+	client.events <- ContainerEvent{ID: "3", Name: "backend-3", Action: ContainerEventStart}
+	client.set(&Container{ID: "2", Name: "backend-2"})
+	client.events <- ContainerEvent{ID: "2", Name: "backend-2", Action: ContainerEventStart}
+	client.events <- ContainerEvent{ID: "2", Name: "backend-2", Action: ContainerEventDie}
+	expect("delete backend-2")
+	assert.Equal(t, len(notifications), 0)
+}
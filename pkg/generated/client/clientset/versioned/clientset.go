@@ -19,10 +19,11 @@ limitations under the License.
 package versioned
 
 import (
-	"fmt"
-	"net/http"
+	fmt "fmt"
+	http "net/http"
 
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -31,12 +32,14 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	SkupperV2alpha1() skupperv2alpha1.SkupperV2alpha1Interface
+	SkupperV2beta1() skupperv2beta1.SkupperV2beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	skupperV2alpha1 *skupperv2alpha1.SkupperV2alpha1Client
+	skupperV2beta1  *skupperv2beta1.SkupperV2beta1Client
 }
 
 // SkupperV2alpha1 retrieves the SkupperV2alpha1Client
@@ -44,6 +47,11 @@ func (c *Clientset) SkupperV2alpha1() skupperv2alpha1.SkupperV2alpha1Interface {
 	return c.skupperV2alpha1
 }
 
+// SkupperV2beta1 retrieves the SkupperV2beta1Client
+func (c *Clientset) SkupperV2beta1() skupperv2beta1.SkupperV2beta1Interface {
+	return c.skupperV2beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -92,6 +100,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.skupperV2beta1, err = skupperv2beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -114,6 +126,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.skupperV2alpha1 = skupperv2alpha1.New(c)
+	cs.skupperV2beta1 = skupperv2beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -22,6 +22,9 @@ import (
 	clientset "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	fakeskupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1/fake"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	fakeskupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -49,9 +52,13 @@ func NewSimpleClientset(objects ...runtime.Object) *Clientset {
 	cs.discovery = &fakediscovery.FakeDiscovery{Fake: &cs.Fake}
 	cs.AddReactor("*", "*", testing.ObjectReaction(o))
 	cs.AddWatchReactor("*", func(action testing.Action) (handled bool, ret watch.Interface, err error) {
+		var opts metav1.ListOptions
+		if watchActcion, ok := action.(testing.WatchActionImpl); ok {
+			opts = watchActcion.ListOptions
+		}
 		gvr := action.GetResource()
 		ns := action.GetNamespace()
-		watch, err := o.Watch(gvr, ns)
+		watch, err := o.Watch(gvr, ns, opts)
 		if err != nil {
 			return false, nil, err
 		}
@@ -87,3 +94,8 @@ var (
 func (c *Clientset) SkupperV2alpha1() skupperv2alpha1.SkupperV2alpha1Interface {
 	return &fakeskupperv2alpha1.FakeSkupperV2alpha1{Fake: &c.Fake}
 }
+
+// SkupperV2beta1 retrieves the SkupperV2beta1Client
+func (c *Clientset) SkupperV2beta1() skupperv2beta1.SkupperV2beta1Interface {
+	return &fakeskupperv2beta1.FakeSkupperV2beta1{Fake: &c.Fake}
+}
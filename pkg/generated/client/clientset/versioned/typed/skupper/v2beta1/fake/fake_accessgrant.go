@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeAccessGrants implements AccessGrantInterface
+type fakeAccessGrants struct {
+	*gentype.FakeClientWithList[*v2beta1.AccessGrant, *v2beta1.AccessGrantList]
+	Fake *FakeSkupperV2beta1
+}
+
+func newFakeAccessGrants(fake *FakeSkupperV2beta1, namespace string) skupperv2beta1.AccessGrantInterface {
+	return &fakeAccessGrants{
+		gentype.NewFakeClientWithList[*v2beta1.AccessGrant, *v2beta1.AccessGrantList](
+			fake.Fake,
+			namespace,
+			v2beta1.SchemeGroupVersion.WithResource("accessgrants"),
+			v2beta1.SchemeGroupVersion.WithKind("AccessGrant"),
+			func() *v2beta1.AccessGrant { return &v2beta1.AccessGrant{} },
+			func() *v2beta1.AccessGrantList { return &v2beta1.AccessGrantList{} },
+			func(dst, src *v2beta1.AccessGrantList) { dst.ListMeta = src.ListMeta },
+			func(list *v2beta1.AccessGrantList) []*v2beta1.AccessGrant { return gentype.ToPointerSlice(list.Items) },
+			func(list *v2beta1.AccessGrantList, items []*v2beta1.AccessGrant) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
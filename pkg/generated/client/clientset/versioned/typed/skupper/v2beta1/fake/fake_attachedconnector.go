@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeAttachedConnectors implements AttachedConnectorInterface
+type fakeAttachedConnectors struct {
+	*gentype.FakeClientWithList[*v2beta1.AttachedConnector, *v2beta1.AttachedConnectorList]
+	Fake *FakeSkupperV2beta1
+}
+
+func newFakeAttachedConnectors(fake *FakeSkupperV2beta1, namespace string) skupperv2beta1.AttachedConnectorInterface {
+	return &fakeAttachedConnectors{
+		gentype.NewFakeClientWithList[*v2beta1.AttachedConnector, *v2beta1.AttachedConnectorList](
+			fake.Fake,
+			namespace,
+			v2beta1.SchemeGroupVersion.WithResource("attachedconnectors"),
+			v2beta1.SchemeGroupVersion.WithKind("AttachedConnector"),
+			func() *v2beta1.AttachedConnector { return &v2beta1.AttachedConnector{} },
+			func() *v2beta1.AttachedConnectorList { return &v2beta1.AttachedConnectorList{} },
+			func(dst, src *v2beta1.AttachedConnectorList) { dst.ListMeta = src.ListMeta },
+			func(list *v2beta1.AttachedConnectorList) []*v2beta1.AttachedConnector {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v2beta1.AttachedConnectorList, items []*v2beta1.AttachedConnector) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
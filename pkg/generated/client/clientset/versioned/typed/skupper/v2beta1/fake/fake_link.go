@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeLinks implements LinkInterface
+type fakeLinks struct {
+	*gentype.FakeClientWithList[*v2beta1.Link, *v2beta1.LinkList]
+	Fake *FakeSkupperV2beta1
+}
+
+func newFakeLinks(fake *FakeSkupperV2beta1, namespace string) skupperv2beta1.LinkInterface {
+	return &fakeLinks{
+		gentype.NewFakeClientWithList[*v2beta1.Link, *v2beta1.LinkList](
+			fake.Fake,
+			namespace,
+			v2beta1.SchemeGroupVersion.WithResource("links"),
+			v2beta1.SchemeGroupVersion.WithKind("Link"),
+			func() *v2beta1.Link { return &v2beta1.Link{} },
+			func() *v2beta1.LinkList { return &v2beta1.LinkList{} },
+			func(dst, src *v2beta1.LinkList) { dst.ListMeta = src.ListMeta },
+			func(list *v2beta1.LinkList) []*v2beta1.Link { return gentype.ToPointerSlice(list.Items) },
+			func(list *v2beta1.LinkList, items []*v2beta1.Link) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeListeners implements ListenerInterface
+type fakeListeners struct {
+	*gentype.FakeClientWithList[*v2beta1.Listener, *v2beta1.ListenerList]
+	Fake *FakeSkupperV2beta1
+}
+
+func newFakeListeners(fake *FakeSkupperV2beta1, namespace string) skupperv2beta1.ListenerInterface {
+	return &fakeListeners{
+		gentype.NewFakeClientWithList[*v2beta1.Listener, *v2beta1.ListenerList](
+			fake.Fake,
+			namespace,
+			v2beta1.SchemeGroupVersion.WithResource("listeners"),
+			v2beta1.SchemeGroupVersion.WithKind("Listener"),
+			func() *v2beta1.Listener { return &v2beta1.Listener{} },
+			func() *v2beta1.ListenerList { return &v2beta1.ListenerList{} },
+			func(dst, src *v2beta1.ListenerList) { dst.ListMeta = src.ListMeta },
+			func(list *v2beta1.ListenerList) []*v2beta1.Listener { return gentype.ToPointerSlice(list.Items) },
+			func(list *v2beta1.ListenerList, items []*v2beta1.Listener) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
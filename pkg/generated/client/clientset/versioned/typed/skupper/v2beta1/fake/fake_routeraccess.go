@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeRouterAccesses implements RouterAccessInterface
+type fakeRouterAccesses struct {
+	*gentype.FakeClientWithList[*v2beta1.RouterAccess, *v2beta1.RouterAccessList]
+	Fake *FakeSkupperV2beta1
+}
+
+func newFakeRouterAccesses(fake *FakeSkupperV2beta1, namespace string) skupperv2beta1.RouterAccessInterface {
+	return &fakeRouterAccesses{
+		gentype.NewFakeClientWithList[*v2beta1.RouterAccess, *v2beta1.RouterAccessList](
+			fake.Fake,
+			namespace,
+			v2beta1.SchemeGroupVersion.WithResource("routeraccesses"),
+			v2beta1.SchemeGroupVersion.WithKind("RouterAccess"),
+			func() *v2beta1.RouterAccess { return &v2beta1.RouterAccess{} },
+			func() *v2beta1.RouterAccessList { return &v2beta1.RouterAccessList{} },
+			func(dst, src *v2beta1.RouterAccessList) { dst.ListMeta = src.ListMeta },
+			func(list *v2beta1.RouterAccessList) []*v2beta1.RouterAccess {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v2beta1.RouterAccessList, items []*v2beta1.RouterAccess) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
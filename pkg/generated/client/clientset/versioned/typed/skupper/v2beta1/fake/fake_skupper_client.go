@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2beta1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeSkupperV2beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeSkupperV2beta1) AccessGrants(namespace string) v2beta1.AccessGrantInterface {
+	return newFakeAccessGrants(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) AccessTokens(namespace string) v2beta1.AccessTokenInterface {
+	return newFakeAccessTokens(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) AttachedConnectors(namespace string) v2beta1.AttachedConnectorInterface {
+	return newFakeAttachedConnectors(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) AttachedConnectorBindings(namespace string) v2beta1.AttachedConnectorBindingInterface {
+	return newFakeAttachedConnectorBindings(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) Certificates(namespace string) v2beta1.CertificateInterface {
+	return newFakeCertificates(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) Connectors(namespace string) v2beta1.ConnectorInterface {
+	return newFakeConnectors(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) Links(namespace string) v2beta1.LinkInterface {
+	return newFakeLinks(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) Listeners(namespace string) v2beta1.ListenerInterface {
+	return newFakeListeners(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) RouterAccesses(namespace string) v2beta1.RouterAccessInterface {
+	return newFakeRouterAccesses(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) SecuredAccesses(namespace string) v2beta1.SecuredAccessInterface {
+	return newFakeSecuredAccesses(c, namespace)
+}
+
+func (c *FakeSkupperV2beta1) Sites(namespace string) v2beta1.SiteInterface {
+	return newFakeSites(c, namespace)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeSkupperV2beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2beta1
+
+type AccessGrantExpansion interface{}
+
+type AccessTokenExpansion interface{}
+
+type AttachedConnectorExpansion interface{}
+
+type AttachedConnectorBindingExpansion interface{}
+
+type CertificateExpansion interface{}
+
+type ConnectorExpansion interface{}
+
+type LinkExpansion interface{}
+
+type ListenerExpansion interface{}
+
+type RouterAccessExpansion interface{}
+
+type SecuredAccessExpansion interface{}
+
+type SiteExpansion interface{}
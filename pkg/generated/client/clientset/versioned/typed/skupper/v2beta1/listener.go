@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2beta1
+
+import (
+	context "context"
+
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	scheme "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ListenersGetter has a method to return a ListenerInterface.
+// A group's client should implement this interface.
+type ListenersGetter interface {
+	Listeners(namespace string) ListenerInterface
+}
+
+// ListenerInterface has methods to work with Listener resources.
+type ListenerInterface interface {
+	Create(ctx context.Context, listener *skupperv2beta1.Listener, opts v1.CreateOptions) (*skupperv2beta1.Listener, error)
+	Update(ctx context.Context, listener *skupperv2beta1.Listener, opts v1.UpdateOptions) (*skupperv2beta1.Listener, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, listener *skupperv2beta1.Listener, opts v1.UpdateOptions) (*skupperv2beta1.Listener, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*skupperv2beta1.Listener, error)
+	List(ctx context.Context, opts v1.ListOptions) (*skupperv2beta1.ListenerList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *skupperv2beta1.Listener, err error)
+	ListenerExpansion
+}
+
+// listeners implements ListenerInterface
+type listeners struct {
+	*gentype.ClientWithList[*skupperv2beta1.Listener, *skupperv2beta1.ListenerList]
+}
+
+// newListeners returns a Listeners
+func newListeners(c *SkupperV2beta1Client, namespace string) *listeners {
+	return &listeners{
+		gentype.NewClientWithList[*skupperv2beta1.Listener, *skupperv2beta1.ListenerList](
+			"listeners",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *skupperv2beta1.Listener { return &skupperv2beta1.Listener{} },
+			func() *skupperv2beta1.ListenerList { return &skupperv2beta1.ListenerList{} },
+		),
+	}
+}
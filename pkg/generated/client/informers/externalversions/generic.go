@@ -19,9 +19,10 @@ limitations under the License.
 package externalversions
 
 import (
-	"fmt"
+	fmt "fmt"
 
 	v2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	v2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -76,6 +77,30 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v2alpha1.SchemeGroupVersion.WithResource("sites"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2alpha1().Sites().Informer()}, nil
 
+		// Group=skupper.io, Version=v2beta1
+	case v2beta1.SchemeGroupVersion.WithResource("accessgrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().AccessGrants().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("accesstokens"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().AccessTokens().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("attachedconnectors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().AttachedConnectors().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("attachedconnectorbindings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().AttachedConnectorBindings().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("certificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().Certificates().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("connectors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().Connectors().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("links"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().Links().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("listeners"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().Listeners().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("routeraccesses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().RouterAccesses().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("securedaccesses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().SecuredAccesses().Informer()}, nil
+	case v2beta1.SchemeGroupVersion.WithResource("sites"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Skupper().V2beta1().Sites().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
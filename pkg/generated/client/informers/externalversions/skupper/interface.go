@@ -21,12 +21,15 @@ package skupper
 import (
 	internalinterfaces "github.com/skupperproject/skupper/pkg/generated/client/informers/externalversions/internalinterfaces"
 	v2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/informers/externalversions/skupper/v2alpha1"
+	v2beta1 "github.com/skupperproject/skupper/pkg/generated/client/informers/externalversions/skupper/v2beta1"
 )
 
 // Interface provides access to each of this group's versions.
 type Interface interface {
 	// V2alpha1 provides access to shared informers for resources in V2alpha1.
 	V2alpha1() v2alpha1.Interface
+	// V2beta1 provides access to shared informers for resources in V2beta1.
+	V2beta1() v2beta1.Interface
 }
 
 type group struct {
@@ -44,3 +47,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (g *group) V2alpha1() v2alpha1.Interface {
 	return v2alpha1.New(g.factory, g.namespace, g.tweakListOptions)
 }
+
+// V2beta1 returns a new v2beta1.Interface.
+func (g *group) V2beta1() v2beta1.Interface {
+	return v2beta1.New(g.factory, g.namespace, g.tweakListOptions)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v2beta1
+
+import (
+	context "context"
+	time "time"
+
+	apisskupperv2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	versioned "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned"
+	internalinterfaces "github.com/skupperproject/skupper/pkg/generated/client/informers/externalversions/internalinterfaces"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/listers/skupper/v2beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AccessTokenInformer provides access to a shared informer and lister for
+// AccessTokens.
+type AccessTokenInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() skupperv2beta1.AccessTokenLister
+}
+
+type accessTokenInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewAccessTokenInformer constructs a new informer for AccessToken type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewAccessTokenInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredAccessTokenInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredAccessTokenInformer constructs a new informer for AccessToken type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredAccessTokenInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AccessTokens(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AccessTokens(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AccessTokens(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AccessTokens(namespace).Watch(ctx, options)
+			},
+		},
+		&apisskupperv2beta1.AccessToken{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *accessTokenInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredAccessTokenInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *accessTokenInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisskupperv2beta1.AccessToken{}, f.defaultInformer)
+}
+
+func (f *accessTokenInformer) Lister() skupperv2beta1.AccessTokenLister {
+	return skupperv2beta1.NewAccessTokenLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v2beta1
+
+import (
+	context "context"
+	time "time"
+
+	apisskupperv2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	versioned "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned"
+	internalinterfaces "github.com/skupperproject/skupper/pkg/generated/client/informers/externalversions/internalinterfaces"
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/generated/client/listers/skupper/v2beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AttachedConnectorInformer provides access to a shared informer and lister for
+// AttachedConnectors.
+type AttachedConnectorInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() skupperv2beta1.AttachedConnectorLister
+}
+
+type attachedConnectorInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewAttachedConnectorInformer constructs a new informer for AttachedConnector type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewAttachedConnectorInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredAttachedConnectorInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredAttachedConnectorInformer constructs a new informer for AttachedConnector type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredAttachedConnectorInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AttachedConnectors(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AttachedConnectors(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AttachedConnectors(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SkupperV2beta1().AttachedConnectors(namespace).Watch(ctx, options)
+			},
+		},
+		&apisskupperv2beta1.AttachedConnector{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *attachedConnectorInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredAttachedConnectorInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *attachedConnectorInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisskupperv2beta1.AttachedConnector{}, f.defaultInformer)
+}
+
+func (f *attachedConnectorInformer) Lister() skupperv2beta1.AttachedConnectorLister {
+	return skupperv2beta1.NewAttachedConnectorLister(f.Informer().GetIndexer())
+}
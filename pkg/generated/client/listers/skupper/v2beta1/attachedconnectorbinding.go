@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v2beta1
+
+import (
+	skupperv2beta1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AttachedConnectorBindingLister helps list AttachedConnectorBindings.
+// All objects returned here must be treated as read-only.
+type AttachedConnectorBindingLister interface {
+	// List lists all AttachedConnectorBindings in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*skupperv2beta1.AttachedConnectorBinding, err error)
+	// AttachedConnectorBindings returns an object that can list and get AttachedConnectorBindings.
+	AttachedConnectorBindings(namespace string) AttachedConnectorBindingNamespaceLister
+	AttachedConnectorBindingListerExpansion
+}
+
+// attachedConnectorBindingLister implements the AttachedConnectorBindingLister interface.
+type attachedConnectorBindingLister struct {
+	listers.ResourceIndexer[*skupperv2beta1.AttachedConnectorBinding]
+}
+
+// NewAttachedConnectorBindingLister returns a new AttachedConnectorBindingLister.
+func NewAttachedConnectorBindingLister(indexer cache.Indexer) AttachedConnectorBindingLister {
+	return &attachedConnectorBindingLister{listers.New[*skupperv2beta1.AttachedConnectorBinding](indexer, skupperv2beta1.Resource("attachedconnectorbinding"))}
+}
+
+// AttachedConnectorBindings returns an object that can list and get AttachedConnectorBindings.
+func (s *attachedConnectorBindingLister) AttachedConnectorBindings(namespace string) AttachedConnectorBindingNamespaceLister {
+	return attachedConnectorBindingNamespaceLister{listers.NewNamespaced[*skupperv2beta1.AttachedConnectorBinding](s.ResourceIndexer, namespace)}
+}
+
+// AttachedConnectorBindingNamespaceLister helps list and get AttachedConnectorBindings.
+// All objects returned here must be treated as read-only.
+type AttachedConnectorBindingNamespaceLister interface {
+	// List lists all AttachedConnectorBindings in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*skupperv2beta1.AttachedConnectorBinding, err error)
+	// Get retrieves the AttachedConnectorBinding from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*skupperv2beta1.AttachedConnectorBinding, error)
+	AttachedConnectorBindingNamespaceListerExpansion
+}
+
+// attachedConnectorBindingNamespaceLister implements the AttachedConnectorBindingNamespaceLister
+// interface.
+type attachedConnectorBindingNamespaceLister struct {
+	listers.ResourceIndexer[*skupperv2beta1.AttachedConnectorBinding]
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Skupper Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v2beta1
+
+// AccessGrantListerExpansion allows custom methods to be added to
+// AccessGrantLister.
+type AccessGrantListerExpansion interface{}
+
+// AccessGrantNamespaceListerExpansion allows custom methods to be added to
+// AccessGrantNamespaceLister.
+type AccessGrantNamespaceListerExpansion interface{}
+
+// AccessTokenListerExpansion allows custom methods to be added to
+// AccessTokenLister.
+type AccessTokenListerExpansion interface{}
+
+// AccessTokenNamespaceListerExpansion allows custom methods to be added to
+// AccessTokenNamespaceLister.
+type AccessTokenNamespaceListerExpansion interface{}
+
+// AttachedConnectorListerExpansion allows custom methods to be added to
+// AttachedConnectorLister.
+type AttachedConnectorListerExpansion interface{}
+
+// AttachedConnectorNamespaceListerExpansion allows custom methods to be added to
+// AttachedConnectorNamespaceLister.
+type AttachedConnectorNamespaceListerExpansion interface{}
+
+// AttachedConnectorBindingListerExpansion allows custom methods to be added to
+// AttachedConnectorBindingLister.
+type AttachedConnectorBindingListerExpansion interface{}
+
+// AttachedConnectorBindingNamespaceListerExpansion allows custom methods to be added to
+// AttachedConnectorBindingNamespaceLister.
+type AttachedConnectorBindingNamespaceListerExpansion interface{}
+
+// CertificateListerExpansion allows custom methods to be added to
+// CertificateLister.
+type CertificateListerExpansion interface{}
+
+// CertificateNamespaceListerExpansion allows custom methods to be added to
+// CertificateNamespaceLister.
+type CertificateNamespaceListerExpansion interface{}
+
+// ConnectorListerExpansion allows custom methods to be added to
+// ConnectorLister.
+type ConnectorListerExpansion interface{}
+
+// ConnectorNamespaceListerExpansion allows custom methods to be added to
+// ConnectorNamespaceLister.
+type ConnectorNamespaceListerExpansion interface{}
+
+// LinkListerExpansion allows custom methods to be added to
+// LinkLister.
+type LinkListerExpansion interface{}
+
+// LinkNamespaceListerExpansion allows custom methods to be added to
+// LinkNamespaceLister.
+type LinkNamespaceListerExpansion interface{}
+
+// ListenerListerExpansion allows custom methods to be added to
+// ListenerLister.
+type ListenerListerExpansion interface{}
+
+// ListenerNamespaceListerExpansion allows custom methods to be added to
+// ListenerNamespaceLister.
+type ListenerNamespaceListerExpansion interface{}
+
+// RouterAccessListerExpansion allows custom methods to be added to
+// RouterAccessLister.
+type RouterAccessListerExpansion interface{}
+
+// RouterAccessNamespaceListerExpansion allows custom methods to be added to
+// RouterAccessNamespaceLister.
+type RouterAccessNamespaceListerExpansion interface{}
+
+// SecuredAccessListerExpansion allows custom methods to be added to
+// SecuredAccessLister.
+type SecuredAccessListerExpansion interface{}
+
+// SecuredAccessNamespaceListerExpansion allows custom methods to be added to
+// SecuredAccessNamespaceLister.
+type SecuredAccessNamespaceListerExpansion interface{}
+
+// SiteListerExpansion allows custom methods to be added to
+// SiteLister.
+type SiteListerExpansion interface{}
+
+// SiteNamespaceListerExpansion allows custom methods to be added to
+// SiteNamespaceLister.
+type SiteNamespaceListerExpansion interface{}
@@ -21,6 +21,7 @@ const (
 	RuntimePath           InternalPath = "runtime"
 	RuntimeSiteStatePath  InternalPath = "runtime/resources"
 	RuntimeTokenPath      InternalPath = "runtime/links"
+	RuntimeGrantsPath     InternalPath = "runtime/grants"
 	InternalBasePath      InternalPath = "internal"
 	LoadedSiteStatePath   InternalPath = "internal/snapshot"
 	ScriptsPath           InternalPath = "internal/scripts"
@@ -181,6 +182,31 @@ func GetDefaultOutputBundlesPath() string {
 	return path.Join(GetDataHome(), "bundles")
 }
 
+// GetDefaultOutputGrantServerPath returns the directory in which the
+// system controller keeps the TLS credentials for its AccessGrant
+// server.
+func GetDefaultOutputGrantServerPath() string {
+	if IsRunningInContainer() {
+		outputStat, err := os.Stat("/output")
+		if err == nil && outputStat.IsDir() {
+			return path.Join("/output", "grant-server")
+		}
+	}
+	return path.Join(GetDataHome(), "grant-server")
+}
+
+// GetDefaultOutputApiSocketPath returns the path of the Unix socket on
+// which the system controller serves its local REST API.
+func GetDefaultOutputApiSocketPath() string {
+	if IsRunningInContainer() {
+		outputStat, err := os.Stat("/output")
+		if err == nil && outputStat.IsDir() {
+			return path.Join("/output", "system-controller.sock")
+		}
+	}
+	return path.Join(GetDataHome(), "system-controller.sock")
+}
+
 func GetInternalOutputPath(namespace string, internalPath InternalPath) string {
 	return path.Join(GetDefaultOutputPath(namespace), string(internalPath))
 }
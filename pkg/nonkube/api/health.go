@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// HealthFileName is the name of the file, in the runtime directory
+	// of a namespace, through which the system controller reports the
+	// health of the router it supervises.
+	HealthFileName = "health.yaml"
+	// HealthStaleAfter is how long a health file can go without being
+	// refreshed before the system controller is considered to be down.
+	HealthStaleAfter = 2 * time.Minute
+)
+
+// SiteHealth is periodically written by the system controller for each
+// namespace it handles.
+type SiteHealth struct {
+	UpdatedAt time.Time    `json:"updatedAt"`
+	Router    RouterHealth `json:"router"`
+}
+
+type RouterHealth struct {
+	Running bool `json:"running"`
+	// Supervised is false when the system controller is not able to
+	// restart the router, leaving it to the service manager instead.
+	Supervised   bool       `json:"supervised"`
+	Restarts     int        `json:"restarts,omitempty"`
+	LastRestart  *time.Time `json:"lastRestart,omitempty"`
+	CrashLoop    bool       `json:"crashLoop,omitempty"`
+	LastLogLines []string   `json:"lastLogLines,omitempty"`
+}
+
+// IsStale returns true if the health report has not been refreshed
+// recently enough for the system controller to be considered alive.
+func (h *SiteHealth) IsStale(now time.Time) bool {
+	return now.Sub(h.UpdatedAt) > HealthStaleAfter
+}
+
+func GetHealthFile(namespace string) string {
+	return path.Join(GetInternalOutputPath(namespace, RuntimePath), HealthFileName)
+}
+
+func LoadSiteHealth(namespace string) (*SiteHealth, error) {
+	data, err := os.ReadFile(GetHealthFile(namespace))
+	if err != nil {
+		return nil, err
+	}
+	health := &SiteHealth{}
+	if err = yaml.Unmarshal(data, health); err != nil {
+		return nil, fmt.Errorf("invalid health file: %w", err)
+	}
+	return health, nil
+}
+
+// WriteSiteHealth replaces the health file of a namespace, going through
+// a temporary file so that readers never see a partial report.
+func WriteSiteHealth(namespace string, health *SiteHealth) error {
+	data, err := yaml.Marshal(health)
+	if err != nil {
+		return err
+	}
+	// the runtime directory is not created here, as it is gone once
+	// the namespace is removed
+	fileName := GetHealthFile(namespace)
+	tmpFileName := fileName + ".tmp"
+	if err = os.WriteFile(tmpFileName, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFileName, fileName)
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSiteHealth(t *testing.T) {
+	if os.Getuid() == 0 {
+		DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	health := &SiteHealth{
+		UpdatedAt: updatedAt,
+		Router: RouterHealth{
+			Supervised:   true,
+			Restarts:     3,
+			LastRestart:  &updatedAt,
+			CrashLoop:    true,
+			LastLogLines: []string{"fatal error"},
+		},
+	}
+
+	// the health file is not written for a namespace that does not exist
+	assert.Assert(t, WriteSiteHealth("test", health) != nil)
+
+	assert.Assert(t, os.MkdirAll(GetInternalOutputPath("test", RuntimePath), 0755))
+	assert.Assert(t, WriteSiteHealth("test", health))
+	loaded, err := LoadSiteHealth("test")
+	assert.Assert(t, err)
+	assert.DeepEqual(t, loaded, health)
+
+	assert.Assert(t, !loaded.IsStale(updatedAt.Add(HealthStaleAfter)))
+	assert.Assert(t, loaded.IsStale(updatedAt.Add(HealthStaleAfter+time.Second)))
+}
@@ -277,6 +277,26 @@ func (s *SiteState) bindings(sslProfileBasePath string) *site.Bindings {
 	return b
 }
 
+// BridgeConfig returns the router configuration for the listeners and
+// connectors defined in the site state.
+func (s *SiteState) BridgeConfig() qdr.BridgeConfig {
+	return s.bindings("").ToBridgeConfig()
+}
+
+// LinkConnectors returns the router connectors for the links defined in
+// the site state, keyed by link name.
+func (s *SiteState) LinkConnectors(edge bool) map[string]qdr.Connector {
+	config := qdr.RouterConfig{
+		SslProfiles: map[string]qdr.SslProfile{},
+		Connectors:  map[string]qdr.Connector{},
+	}
+	if edge {
+		config.Metadata.Mode = qdr.ModeEdge
+	}
+	s.linkMap("").Apply(&config)
+	return config.Connectors
+}
+
 func (s *SiteState) ToRouterConfig(sslProfileBasePath string, platform string) qdr.RouterConfig {
 	if s.SiteId == "" {
 		s.SiteId = uuid.New().String()
@@ -352,11 +372,10 @@ func (s *SiteState) SetNamespace(namespace string) {
 
 func (s *SiteState) UpdateStatus(networkStatus network.NetworkStatusInfo) {
 	siteRecords := network.ExtractSiteRecords(networkStatus)
-	if reflect.DeepEqual(s.Site.Status.Network, siteRecords) {
-		return
+	if !reflect.DeepEqual(s.Site.Status.Network, siteRecords) {
+		s.Site.Status.Network = siteRecords
+		s.Site.Status.SitesInNetwork = len(siteRecords)
 	}
-	s.Site.Status.Network = siteRecords
-	s.Site.Status.SitesInNetwork = len(siteRecords)
 	linkRecords := network.GetLinkRecordsForSite(s.SiteId, siteRecords)
 
 	for _, linkRecord := range linkRecords {
@@ -382,13 +401,29 @@ func (s *SiteState) UpdateStatus(networkStatus network.NetworkStatusInfo) {
 	}
 }
 
+// SetRouterRunning updates the Running condition of the site, which
+// along with the Configured and Resolved conditions determines whether
+// the site is ready.
+func (s *SiteState) SetRouterRunning(running bool) bool {
+	if running {
+		return s.Site.SetRunning(v2alpha1.ReadyCondition())
+	}
+	return s.Site.SetRunning(v2alpha1.PendingCondition("Router is not running"))
+}
+
+// SetRouterCrashLooping reports, through the Running condition of the
+// site, that the router keeps failing after being restarted.
+func (s *SiteState) SetRouterCrashLooping(restarts int) bool {
+	return s.Site.SetRunning(v2alpha1.ErrorCondition(fmt.Errorf("Router is crash looping (restarted %d times)", restarts)))
+}
+
 func marshal(outputDirectory, resourceType, resourceName string, resource interface{}) error {
 	var err error
 	err = os.MkdirAll(outputDirectory, 0755)
 	if err != nil {
 		return fmt.Errorf("error creating directory %s: %w", outputDirectory, err)
 	}
-	fileName := path.Join(outputDirectory, fmt.Sprintf("%s-%s.yaml", resourceType, resourceName))
+	fileName := path.Join(outputDirectory, ResourceFileName(resourceType, resourceName))
 	file, err := os.Create(fileName)
 	defer file.Close()
 	if err != nil {
@@ -412,6 +447,18 @@ func marshalMap[V any](outputDirectory, resourceType string, resourceMap map[str
 	return nil
 }
 
+// MarshalResource writes a single resource to the output directory,
+// using the same file name MarshalSiteState would use for it.
+func MarshalResource(outputDirectory, resourceType, resourceName string, resource interface{}) error {
+	return marshal(outputDirectory, resourceType, resourceName, resource)
+}
+
+// ResourceFileName returns the name of the file a resource is
+// marshalled to.
+func ResourceFileName(resourceType, resourceName string) string {
+	return fmt.Sprintf("%s-%s.yaml", resourceType, resourceName)
+}
+
 func MarshalSiteState(siteState SiteState, outputDirectory string) error {
 	var err error
 	if siteState.Site != nil && siteState.Site.ObjectMeta.UID == "" {
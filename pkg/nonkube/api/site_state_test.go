@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/skupperproject/skupper/internal/network"
+	"github.com/skupperproject/skupper/internal/qdr"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -133,6 +134,28 @@ func TestSiteState_ToRouterConfig(t *testing.T) {
 	}
 }
 
+func TestSiteState_BridgeConfig(t *testing.T) {
+	ss := fakeSiteState()
+	bridgeConfig := ss.BridgeConfig()
+	assert.Equal(t, len(bridgeConfig.TcpListeners), 2)
+	assert.Equal(t, len(bridgeConfig.TcpConnectors), 1)
+	routerConfig := ss.ToRouterConfig("${SSL_PROFILE_BASE_PATH}", "podman")
+	assert.DeepEqual(t, bridgeConfig, routerConfig.Bridges)
+}
+
+func TestSiteState_LinkConnectors(t *testing.T) {
+	ss := fakeSiteState()
+	connectors := ss.LinkConnectors(false)
+	assert.Equal(t, len(connectors), len(ss.Links))
+	routerConfig := ss.ToRouterConfig("${SSL_PROFILE_BASE_PATH}", "podman")
+	for name, connector := range connectors {
+		assert.DeepEqual(t, connector, routerConfig.Connectors[name])
+	}
+	edgeConnector := ss.LinkConnectors(true)["link-one"]
+	assert.Equal(t, edgeConnector.Role, qdr.Role(qdr.RoleEdge))
+	assert.Equal(t, edgeConnector.Port, "45671")
+}
+
 func TestMarshalSiteState(t *testing.T) {
 	ss := fakeSiteState()
 	ss.CreateLinkAccessesCertificates()
@@ -180,6 +203,31 @@ func TestUpdateStatus(t *testing.T) {
 	assert.Equal(t, ss.Connectors["connector-one"].Status.HasMatchingListener, true)
 	assert.Equal(t, meta.IsStatusConditionTrue(ss.Links["link-one"].Status.Conditions, v2alpha1.CONDITION_TYPE_OPERATIONAL), true)
 	assert.Equal(t, meta.IsStatusConditionTrue(ss.Links["link-broken"].Status.Conditions, v2alpha1.CONDITION_TYPE_OPERATIONAL), false)
+
+	// resources added while the network is unchanged are updated too
+	ss.Listeners["listener-three"] = ss.Listeners["listener-one"].DeepCopy()
+	ss.Listeners["listener-three"].Status = v2alpha1.ListenerStatus{}
+	ss.UpdateStatus(networkStatus)
+	assert.Equal(t, ss.Listeners["listener-three"].Status.HasMatchingConnector, true)
+	assert.Assert(t, meta.IsStatusConditionTrue(ss.Listeners["listener-three"].Status.Conditions, v2alpha1.CONDITION_TYPE_MATCHED))
+}
+
+func TestSiteState_SetRouterRunning(t *testing.T) {
+	ss := fakeSiteState()
+	ss.Site.SetConfigured(nil)
+	assert.Assert(t, ss.SetRouterRunning(true))
+	assert.Assert(t, !ss.SetRouterRunning(true))
+	assert.Assert(t, ss.Site.IsReady())
+	assert.Equal(t, ss.Site.Status.StatusType, v2alpha1.StatusReady)
+
+	assert.Assert(t, ss.SetRouterRunning(false))
+	assert.Assert(t, !ss.Site.IsReady())
+	assert.Equal(t, ss.Site.Status.StatusType, v2alpha1.StatusPending)
+	assert.Equal(t, ss.Site.Status.Message, "Router is not running")
+
+	assert.Assert(t, ss.SetRouterCrashLooping(3))
+	assert.Equal(t, ss.Site.Status.StatusType, v2alpha1.StatusError)
+	assert.Equal(t, ss.Site.Status.Message, "Router is crash looping (restarted 3 times)")
 }
 
 func fakeNetworkStatusInfo() network.NetworkStatusInfo {
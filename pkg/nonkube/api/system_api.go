@@ -0,0 +1,22 @@
+package api
+
+// NamespaceInfo describes a namespace watched by the system controller,
+// as reported by its local API.
+type NamespaceInfo struct {
+	Name     string `json:"name"`
+	Platform string `json:"platform,omitempty"`
+	Active   bool   `json:"active"`
+}
+
+// ResourceRef identifies a resource applied to or deleted from a
+// namespace through the local API of the system controller.
+type ResourceRef struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// ApiError is the body of the responses through which the local API of
+// the system controller reports a failed request.
+type ApiError struct {
+	Message string `json:"error"`
+}
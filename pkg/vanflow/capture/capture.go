@@ -0,0 +1,97 @@
+/*
+Package capture records the vanflow messages sent by event sources to a file
+and replays them, so that the event stream seen by a collector can be looked
+at and reproduced offline.
+
+A capture is a stream of JSON documents, one per line, each holding a
+message as it was received along with the time it was received at and the
+address it was received on. Messages are kept in their AMQP encoding, so
+that a replay sends them exactly as they were captured.
+*/
+package capture
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	amqp "github.com/Azure/go-amqp"
+)
+
+// Entry is a single captured message
+type Entry struct {
+	Time    time.Time `json:"time"`
+	Address string    `json:"address"`
+	Subject string    `json:"subject,omitempty"`
+	// Source is the identity of the event source the message is from
+	Source string `json:"source,omitempty"`
+	// Message is the AMQP encoded message
+	Message []byte `json:"message"`
+}
+
+// NewEntry encodes a message received from source on address at time t
+func NewEntry(t time.Time, address, source string, msg *amqp.Message) (Entry, error) {
+	encoded, err := msg.MarshalBinary()
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to encode message: %w", err)
+	}
+	entry := Entry{
+		Time:    t,
+		Address: address,
+		Source:  source,
+		Message: encoded,
+	}
+	if msg.Properties != nil && msg.Properties.Subject != nil {
+		entry.Subject = *msg.Properties.Subject
+	}
+	return entry, nil
+}
+
+// Decode returns the captured message
+func (e Entry) Decode() (*amqp.Message, error) {
+	var msg amqp.Message
+	if err := msg.UnmarshalBinary(e.Message); err != nil {
+		return nil, fmt.Errorf("failed to decode message: %w", err)
+	}
+	return &msg, nil
+}
+
+// Writer writes entries to a capture. It is safe for concurrent use.
+type Writer struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{encoder: json.NewEncoder(w)}
+}
+
+func (w *Writer) Write(entry Entry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.encoder.Encode(entry)
+}
+
+// Reader reads entries from a capture in order
+type Reader struct {
+	decoder *json.Decoder
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{decoder: json.NewDecoder(r)}
+}
+
+// Next returns the next entry of the capture, or io.EOF once all entries
+// have been read.
+func (r *Reader) Next() (Entry, error) {
+	var entry Entry
+	if err := r.decoder.Decode(&entry); err != nil {
+		if err == io.EOF {
+			return entry, err
+		}
+		return entry, fmt.Errorf("invalid capture entry: %w", err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,166 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/eventsource"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Clone(b.buf.Bytes())
+}
+
+func readAll(t *testing.T, data []byte) []Entry {
+	t.Helper()
+	var entries []Entry
+	reader := NewReader(bytes.NewReader(data))
+	for {
+		entry, err := reader.Next()
+		if err != nil {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func testBeacon(id, sourceType string) vanflow.BeaconMessage {
+	return vanflow.BeaconMessage{
+		MessageProps: vanflow.MessageProps{To: "mc/sfe.all", Subject: "BEACON"},
+		Version:      1,
+		SourceType:   sourceType,
+		Address:      "mc/sfe." + id,
+		Direct:       "sfe." + id,
+		Identity:     id,
+	}
+}
+
+func TestCaptureAndReplay(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// capture the messages sent by two event sources, only one of which is selected
+	router := session.NewMockRouter()
+	source := session.NewMockContainer(router)
+	var buffer syncBuffer
+	recorderCtx, stopRecorder := context.WithCancel(ctx)
+	recorder := NewRecorder(session.NewMockContainer(router), NewWriter(&buffer), RecorderOptions{
+		Sources: []string{"ROUTER"},
+	})
+	recorded := make(chan error)
+	go func() {
+		recorded <- recorder.Run(recorderCtx)
+	}()
+
+	beacons := source.NewSender("mc/sfe.all", session.SenderOptions{})
+	assert.Assert(t, beacons.Send(ctx, testBeacon("controller", "CONTROLLER").Encode()))
+	assert.Assert(t, beacons.Send(ctx, testBeacon("router", "ROUTER").Encode()))
+
+	record := vanflow.RecordMessage{
+		MessageProps: vanflow.MessageProps{To: "mc/sfe.router", Subject: "RECORD"},
+		Records:      []vanflow.Record{vanflow.RouterRecord{BaseRecord: vanflow.BaseRecord{ID: "router-1"}}},
+	}
+	msg, err := record.Encode()
+	assert.Assert(t, err)
+	// blocks until the recorder listens to the address of the source
+	assert.Assert(t, source.NewSender("mc/sfe.router", session.SenderOptions{}).Send(ctx, msg))
+	heartbeat := vanflow.HeartbeatMessage{
+		MessageProps: vanflow.MessageProps{To: "mc/sfe.router.heartbeats", Subject: "HEARTBEAT"},
+		Identity:     "router",
+		Version:      1,
+		Now:          42,
+	}
+	assert.Assert(t, source.NewSender("mc/sfe.router.heartbeats", session.SenderOptions{}).Send(ctx, heartbeat.Encode()))
+
+	poll := func(expected int) []Entry {
+		t.Helper()
+		for {
+			entries := readAll(t, buffer.Bytes())
+			if len(entries) >= expected || ctx.Err() != nil {
+				return entries
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	entries := poll(3)
+	stopRecorder()
+	assert.Assert(t, <-recorded)
+	assert.Equal(t, len(entries), 3)
+	assert.Equal(t, entries[0].Subject, "BEACON")
+	assert.Equal(t, entries[0].Address, "mc/sfe.all")
+	assert.Equal(t, entries[0].Source, "router")
+	for _, entry := range entries {
+		assert.Assert(t, entry.Source != "controller")
+		assert.Assert(t, !entry.Time.IsZero())
+	}
+
+	// replay them to a client of the captured source
+	replayRouter := session.NewMockRouter()
+	client := eventsource.NewClient(session.NewMockContainer(replayRouter), eventsource.ClientOptions{
+		Source: eventsource.Info{ID: "router", Address: "mc/sfe.router"},
+	})
+	defer client.Close()
+	records := make(chan vanflow.RecordMessage, 8)
+	heartbeats := make(chan vanflow.HeartbeatMessage, 8)
+	client.OnRecord(func(m vanflow.RecordMessage) { records <- m })
+	client.OnHeartbeat(func(m vanflow.HeartbeatMessage) { heartbeats <- m })
+	assert.Assert(t, client.Listen(ctx, eventsource.FromSourceAddress()))
+	assert.Assert(t, client.Listen(ctx, eventsource.FromSourceAddressHeartbeats()))
+	beaconReceiver := session.NewMockContainer(replayRouter).NewReceiver("mc/sfe.all", session.ReceiverOptions{})
+
+	replayer := NewReplayer(session.NewMockContainer(replayRouter), ReplayOptions{Speed: 0})
+	stats, err := replayer.Replay(ctx, NewReader(bytes.NewReader(buffer.Bytes())))
+	assert.Assert(t, err)
+	assert.DeepEqual(t, stats, ReplayStats{Sent: 3})
+
+	beacon, err := beaconReceiver.Next(ctx)
+	assert.Assert(t, err)
+	assert.DeepEqual(t, vanflow.DecodeBeacon(beacon), testBeacon("router", "ROUTER"))
+	assert.DeepEqual(t, <-records, record)
+	assert.DeepEqual(t, <-heartbeats, vanflow.DecodeHeartbeat(heartbeat.Encode()))
+}
+
+func TestReplaySkipsUnsent(t *testing.T) {
+	ctx := context.Background()
+	var buffer bytes.Buffer
+	writer := NewWriter(&buffer)
+	captured := time.Now()
+	for i := 0; i < 2; i++ {
+		entry, err := NewEntry(captured.Add(time.Duration(i)*50*time.Millisecond), "mc/sfe.all", "router", testBeacon("router", "ROUTER").Encode())
+		assert.Assert(t, err)
+		assert.Assert(t, writer.Write(entry))
+	}
+
+	// nothing listens to the replayed address
+	replayer := NewReplayer(session.NewMockContainer(session.NewMockRouter()), ReplayOptions{
+		Speed:       1,
+		SendTimeout: 10 * time.Millisecond,
+	})
+	start := time.Now()
+	stats, err := replayer.Replay(ctx, NewReader(&buffer))
+	assert.Assert(t, err)
+	assert.DeepEqual(t, stats, ReplayStats{Skipped: 2})
+	// messages are replayed at the pace they were captured at
+	assert.Assert(t, time.Since(start) >= 50*time.Millisecond)
+}
@@ -0,0 +1,180 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	amqp "github.com/Azure/go-amqp"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/eventsource"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+)
+
+const beaconAddress = "mc/sfe.all"
+
+// RecorderOptions controls what a Recorder captures
+type RecorderOptions struct {
+	// Sources restricts the capture to the event sources with one of the
+	// given identities or types (i.e. ROUTER or CONTROLLER). All sources
+	// are captured when empty.
+	Sources []string
+	// Addresses of each source to capture, in addition to its beacons.
+	// Defaults to the source address along with its flows, logs and
+	// heartbeats addresses.
+	Addresses []eventsource.ListenerConfigProvider
+	// Flush asks each source to send all of its records once it is
+	// discovered, so that the capture starts with the full state of the
+	// source rather than only the changes that follow.
+	Flush bool
+}
+
+// Recorder discovers event sources and writes the messages they send to a
+// capture.
+type Recorder struct {
+	container session.Container
+	writer    *Writer
+	opts      RecorderOptions
+	now       func() time.Time
+	logger    *slog.Logger
+
+	mu      sync.Mutex
+	sources map[string]bool
+	wg      sync.WaitGroup
+}
+
+func NewRecorder(container session.Container, writer *Writer, opts RecorderOptions) *Recorder {
+	if len(opts.Addresses) == 0 {
+		opts.Addresses = []eventsource.ListenerConfigProvider{
+			eventsource.FromSourceAddress(),
+			eventsource.FromSourceAddressFlows(),
+			eventsource.FromSourceAddressLogs(),
+			eventsource.FromSourceAddressHeartbeats(),
+		}
+	}
+	return &Recorder{
+		container: container,
+		writer:    writer,
+		opts:      opts,
+		now:       time.Now,
+		sources:   make(map[string]bool),
+		logger: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "vanflow.capture.recorder"),
+		),
+	}
+}
+
+// Run records messages until the context is cancelled
+func (r *Recorder) Run(ctx context.Context) error {
+	defer r.wg.Wait()
+	receiver := r.container.NewReceiver(beaconAddress, session.ReceiverOptions{})
+	defer receiver.Close(context.Background())
+	for {
+		msg, err := receiver.Next(ctx)
+		if err != nil {
+			if errors.Is(err, ctx.Err()) {
+				return nil
+			}
+			return fmt.Errorf("error receiving beacon messages: %w", err)
+		}
+		if err := receiver.Accept(ctx, msg); err != nil {
+			r.logger.Error("error accepting message", slog.Any("error", err))
+		}
+		if msg.Properties == nil || msg.Properties.Subject == nil || *msg.Properties.Subject != "BEACON" {
+			continue
+		}
+		beacon := vanflow.DecodeBeacon(msg)
+		if !r.selected(beacon) {
+			continue
+		}
+		r.record(beaconAddress, beacon.Identity, msg)
+		r.discovered(ctx, beacon)
+	}
+}
+
+func (r *Recorder) selected(beacon vanflow.BeaconMessage) bool {
+	if len(r.opts.Sources) == 0 {
+		return true
+	}
+	return slices.Contains(r.opts.Sources, beacon.Identity) || slices.Contains(r.opts.Sources, beacon.SourceType)
+}
+
+func (r *Recorder) discovered(ctx context.Context, beacon vanflow.BeaconMessage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sources[beacon.Identity] {
+		return
+	}
+	r.sources[beacon.Identity] = true
+	source := eventsource.Info{
+		ID:      beacon.Identity,
+		Version: int(beacon.Version),
+		Type:    beacon.SourceType,
+		Address: beacon.Address,
+		Direct:  beacon.Direct,
+	}
+	r.logger.Info("capturing messages of event source",
+		slog.String("id", source.ID),
+		slog.String("type", source.Type))
+	for _, provider := range r.opts.Addresses {
+		r.wg.Add(1)
+		go r.listen(ctx, source, provider.Get(source))
+	}
+	if r.opts.Flush {
+		r.wg.Add(1)
+		go r.flush(ctx, source)
+	}
+}
+
+func (r *Recorder) listen(ctx context.Context, source eventsource.Info, cfg eventsource.ListenerConfig) {
+	defer r.wg.Done()
+	receiver := r.container.NewReceiver(cfg.Address, session.ReceiverOptions{Credit: cfg.Credit})
+	defer receiver.Close(context.Background())
+	for {
+		msg, err := receiver.Next(ctx)
+		if err != nil {
+			if errors.Is(err, ctx.Err()) {
+				return
+			}
+			r.logger.Error("error receiving message", slog.Any("error", err), slog.String("address", cfg.Address))
+			continue
+		}
+		if err := receiver.Accept(ctx, msg); err != nil {
+			if errors.Is(err, ctx.Err()) {
+				return
+			}
+			r.logger.Error("error accepting message", slog.Any("error", err), slog.String("address", cfg.Address))
+		}
+		r.record(cfg.Address, source.ID, msg)
+	}
+}
+
+func (r *Recorder) flush(ctx context.Context, source eventsource.Info) {
+	defer r.wg.Done()
+	// leave the listeners a chance to be attached before asking for records
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(time.Second):
+	}
+	client := eventsource.NewClient(r.container, eventsource.ClientOptions{Source: source})
+	if err := client.SendFlush(ctx); err != nil && !errors.Is(err, ctx.Err()) {
+		r.logger.Error("error sending flush", slog.Any("error", err), slog.String("source", source.ID))
+	}
+}
+
+func (r *Recorder) record(address, source string, msg *amqp.Message) {
+	entry, err := NewEntry(r.now(), address, source, msg)
+	if err != nil {
+		r.logger.Error("skipping message that could not be encoded", slog.Any("error", err))
+		return
+	}
+	if err := r.writer.Write(entry); err != nil {
+		r.logger.Error("error writing capture entry", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,146 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+)
+
+const defaultSendTimeout = 5 * time.Second
+
+// ReplayOptions controls the pace of a replay
+type ReplayOptions struct {
+	// Speed is the factor by which the delays between captured messages are
+	// divided. 1 replays messages as they were captured, while 0 sends them
+	// as fast as possible.
+	Speed float64
+	// SendTimeout is how long to wait for a message to be sent before
+	// skipping it, as happens when nothing is listening to its address yet.
+	// Defaults to 5 seconds.
+	SendTimeout time.Duration
+}
+
+// ReplayStats summarizes a replay
+type ReplayStats struct {
+	Sent    int
+	Skipped int
+}
+
+// Replayer sends the messages of a capture to the addresses they were
+// captured on, standing in for the event sources they were sent by.
+type Replayer struct {
+	container session.Container
+	opts      ReplayOptions
+	senders   map[string]session.Sender
+	logger    *slog.Logger
+}
+
+func NewReplayer(container session.Container, opts ReplayOptions) *Replayer {
+	if opts.SendTimeout <= 0 {
+		opts.SendTimeout = defaultSendTimeout
+	}
+	return &Replayer{
+		container: container,
+		opts:      opts,
+		senders:   make(map[string]session.Sender),
+		logger: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "vanflow.capture.replayer"),
+		),
+	}
+}
+
+// Replay sends all messages read from reader, until the end of the capture
+// is reached or the context is cancelled.
+func (r *Replayer) Replay(ctx context.Context, reader *Reader) (ReplayStats, error) {
+	var (
+		stats    ReplayStats
+		start    time.Time
+		captured time.Time
+	)
+	defer r.close()
+	for {
+		entry, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return stats, nil
+			}
+			return stats, err
+		}
+		if start.IsZero() {
+			start, captured = time.Now(), entry.Time
+		}
+		if err := r.wait(ctx, start, entry.Time.Sub(captured)); err != nil {
+			return stats, err
+		}
+		msg, err := entry.Decode()
+		if err != nil {
+			return stats, err
+		}
+		sendCtx, cancel := context.WithTimeout(ctx, r.opts.SendTimeout)
+		err = r.sender(entry.Address).Send(sendCtx, msg)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+			r.logger.Debug("skipping message that could not be sent",
+				slog.String("address", entry.Address),
+				slog.String("subject", entry.Subject),
+				slog.Any("error", err))
+			stats.Skipped++
+			continue
+		}
+		stats.Sent++
+	}
+}
+
+// wait until offset, the time elapsed between the first message of the
+// capture and the next one, has elapsed since start, scaled by the speed.
+func (r *Replayer) wait(ctx context.Context, start time.Time, offset time.Duration) error {
+	if r.opts.Speed <= 0 {
+		return ctx.Err()
+	}
+	delay := time.Until(start.Add(time.Duration(float64(offset) / r.opts.Speed)))
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+func (r *Replayer) sender(address string) session.Sender {
+	sender, ok := r.senders[address]
+	if !ok {
+		sender = r.container.NewSender(address, session.SenderOptions{})
+		r.senders[address] = sender
+	}
+	return sender
+}
+
+func (r *Replayer) close() {
+	for address, sender := range r.senders {
+		if err := sender.Close(context.Background()); err != nil {
+			r.logger.Debug("error closing sender", slog.String("address", address), slog.Any("error", err))
+		}
+	}
+}
+
+// ReplayFile replays the capture in the named file
+func ReplayFile(ctx context.Context, container session.Container, fileName string, opts ReplayOptions) (ReplayStats, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return ReplayStats{}, fmt.Errorf("failed to open capture: %w", err)
+	}
+	defer file.Close()
+	return NewReplayer(container, opts).Replay(ctx, NewReader(file))
+}
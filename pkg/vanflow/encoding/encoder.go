@@ -34,10 +34,59 @@ type RecordAttributeEncoder interface {
 // vanflow protocol. Only records types that have been registered with
 // MustRegisterRecord can be encoded.
 func Encode(record any) (RecordAttributeSet, error) {
+	return encode(record, true)
+}
+
+// EncodePartial encodes a record into a record attribute set like Encode,
+// without requiring its identifying attributes to be set. Used for records
+// that describe others, such as templates to match records against.
+func EncodePartial(record any) (RecordAttributeSet, error) {
+	return encode(record, false)
+}
+
+// Codepoints returns the record attribute codepoints of the named fields of
+// a record type registered with MustRegisterRecord.
+func Codepoints(record any, fields ...string) ([]uint32, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	encoding, _, err := encodingFor(record)
+	if err != nil {
+		return nil, err
+	}
+	codepoints := make([]uint32, 0, len(fields))
+	for _, name := range fields {
+		found := false
+		for _, field := range encoding.fields {
+			if field.Name == name {
+				codepoints = append(codepoints, field.Codepoint)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("record type %s has no vflow attribute field %q", encoding.t, name)
+		}
+	}
+	return codepoints, nil
+}
+
+func encode(record any, requireIdentity bool) (RecordAttributeSet, error) {
 	mu.RLock()
 	defer mu.RUnlock()
+	encoding, recordV, err := encodingFor(record)
+	if err != nil {
+		return nil, err
+	}
+	result, err := encoding.encode(recordV, requireIdentity)
+	if result != nil {
+		result[typeOfRecord] = encoding.codepoint
+	}
+	return result, err
+}
+
+func encodingFor(record any) (*typeEncoding, reflect.Value, error) {
 	if record == nil {
-		return nil, errors.New("cannot encode nil record")
+		return nil, reflect.Value{}, errors.New("cannot encode nil record")
 	}
 	recordV := reflect.ValueOf(record)
 	recordT := recordV.Type()
@@ -49,14 +98,10 @@ func Encode(record any) (RecordAttributeSet, error) {
 			encoding, ok = encodings[recordT]
 		}
 		if !ok {
-			return nil, fmt.Errorf("encode error: unregistered record type %T", record)
+			return nil, recordV, fmt.Errorf("encode error: unregistered record type %T", record)
 		}
 	}
-	result, err := encoding.encode(recordV)
-	if result != nil {
-		result[typeOfRecord] = encoding.codepoint
-	}
-	return result, err
+	return encoding, recordV, nil
 }
 
 type fieldEncoder interface {
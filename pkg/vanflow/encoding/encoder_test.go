@@ -158,6 +158,30 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestEncodePartial(t *testing.T) {
+	actual, err := encoding.EncodePartial(vanflow.ListenerRecord{Address: ptrTo("backend:8080")})
+	assert.Check(t, err)
+	assert.DeepEqual(t, map[any]any{
+		uint32(0):  uint32(4),
+		uint32(19): "backend:8080",
+	}, map[any]any(actual))
+
+	_, err = encoding.EncodePartial(testing.B{})
+	assert.Check(t, err != nil)
+}
+
+func TestCodepoints(t *testing.T) {
+	actual, err := encoding.Codepoints(vanflow.SiteRecord{}, "ID", "Location", "Name")
+	assert.Check(t, err)
+	assert.DeepEqual(t, []uint32{1, 9, 30}, actual)
+
+	_, err = encoding.Codepoints(&vanflow.SiteRecord{}, "Missing")
+	assert.Error(t, err, `record type vanflow.SiteRecord has no vflow attribute field "Missing"`)
+
+	_, err = encoding.Codepoints(testing.B{}, "N")
+	assert.Check(t, err != nil)
+}
+
 func BenchmarkEncodeDecode(b *testing.B) {
 	timeA := vanflow.Time{Time: time.UnixMicro(100)}
 	timeB := vanflow.Time{Time: time.UnixMicro(333)}
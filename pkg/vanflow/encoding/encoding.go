@@ -132,7 +132,7 @@ func parseFieldOpts(f reflect.StructField) (field, bool) {
 	return opts, true
 }
 
-func (e typeEncoding) encode(root reflect.Value) (RecordAttributeSet, error) {
+func (e typeEncoding) encode(root reflect.Value, requireIdentity bool) (RecordAttributeSet, error) {
 	attributeSet := make(RecordAttributeSet)
 	var v reflect.Value
 	for _, field := range e.fields {
@@ -143,7 +143,7 @@ func (e typeEncoding) encode(root reflect.Value) (RecordAttributeSet, error) {
 		out, err := field.encoder.encode(v)
 		if err != nil {
 			if errors.Is(err, ErrAttributeNotSet) {
-				if field.Identity {
+				if field.Identity && requireIdentity {
 					return attributeSet, fmt.Errorf("missing or empty required field %s: %s", field.Name, err)
 				}
 				continue // ignore if not required
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/skupperproject/skupper/pkg/vanflow"
 	"github.com/skupperproject/skupper/pkg/vanflow/session"
 )
@@ -95,42 +97,129 @@ func (c *Client) Listen(ctx context.Context, attributes ListenerConfigProvider)
 		receiver := c.container.NewReceiver(cfg.Address, session.ReceiverOptions{
 			Credit: cfg.Credit,
 		})
-		defer receiver.Close(ctx)
-		for {
-			amqpMsg, err := receiver.Next(ctx)
-			if err != nil {
-				if errors.Is(err, ctx.Err()) {
-					return
-				}
-				c.logger.Error("client error receiving message", slog.Any("error", err), slog.String("address", cfg.Address))
-				continue
+		c.receive(ctx, receiver, cfg)
+	}(listenerCtx)
+	return nil
+}
+
+// Subscribe instructs the Client to receive the records of the event source
+// selected by filter until the context is cancelled or client.Close() is
+// called.
+//
+// Event sources that accept subscriptions only send the records selected,
+// starting with all of those they hold, to an address dedicated to the
+// subscription. For other event sources, such as routers, the client listens
+// to the addresses given by fallback, or the source address when none are
+// given, and drops the records not selected before decoding them. These
+// sources still need to be flushed by the caller.
+func (c *Client) Subscribe(ctx context.Context, filter vanflow.RecordFilter, fallback ...ListenerConfigProvider) error {
+	if !c.eventSource.Subscriptions {
+		if len(fallback) == 0 {
+			fallback = append(fallback, FromSourceAddress())
+		}
+		for _, attributes := range fallback {
+			if err := c.Listen(ctx, WithFilter(attributes, filter)); err != nil {
+				return err
 			}
-			if err := receiver.Accept(ctx, amqpMsg); err != nil {
-				if errors.Is(err, ctx.Err()) {
-					return
-				}
-				c.logger.Error("client error accepting message", slog.Any("error", err), slog.String("address", cfg.Address))
-				continue
+		}
+		return nil
+	}
+
+	c.wg.Add(2)
+	subscriptionCtx, subscriptionCancel := context.WithCancel(ctx)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cleanup = append(c.cleanup, subscriptionCancel)
+
+	cfg := ListenerConfig{
+		Address: fmt.Sprintf("%s.%s", c.eventSource.Direct, uuid.New().String()),
+		Credit:  256,
+	}
+	// receive before subscribing so that the records sent by the source
+	// in response are not held up
+	receiver := c.container.NewReceiver(cfg.Address, session.ReceiverOptions{
+		Credit: cfg.Credit,
+	})
+	go func(ctx context.Context) {
+		defer c.wg.Done()
+		c.receive(ctx, receiver, cfg)
+	}(subscriptionCtx)
+	go func(ctx context.Context) {
+		defer c.wg.Done()
+		c.renewSubscription(ctx, vanflow.SubscribeMessage{
+			MessageProps: vanflow.MessageProps{
+				To:      c.eventSource.Direct,
+				ReplyTo: cfg.Address,
+			},
+			Filter: filter,
+			Lease:  subscriptionLease,
+		})
+	}(subscriptionCtx)
+	return nil
+}
+
+// renewSubscription sends the subscribe message to the event source until
+// the context is cancelled, often enough for the subscription not to expire.
+func (c *Client) renewSubscription(ctx context.Context, subscribe vanflow.SubscribeMessage) {
+	sender := c.container.NewSender(subscribe.To, session.SenderOptions{})
+	defer sender.Close(context.Background())
+	ticker := time.NewTicker(subscribe.Lease / 3)
+	defer ticker.Stop()
+	for {
+		if err := sendWithTimeout(ctx, subscribe.Lease/3, sender, subscribe.Encode()); err != nil {
+			if ctx.Err() != nil {
+				return
 			}
-			decoded, err := vanflow.Decode(amqpMsg)
-			if err != nil {
-				c.logger.Error("skipping message that could not be decoded", slog.Any("error", err))
-				continue
+			c.logger.Error("client error sending subscription", slog.Any("error", err), slog.String("address", subscribe.To))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) receive(ctx context.Context, receiver session.Receiver, cfg ListenerConfig) {
+	defer receiver.Close(ctx)
+	for {
+		amqpMsg, err := receiver.Next(ctx)
+		if err != nil {
+			if errors.Is(err, ctx.Err()) {
+				return
 			}
-			recordHandlers, heartbeatHandlers := c.getHandlers()
-			switch message := decoded.(type) {
-			case vanflow.RecordMessage:
-				for _, handler := range recordHandlers {
-					handler(message)
-				}
-			case vanflow.HeartbeatMessage:
-				for _, handler := range heartbeatHandlers {
-					handler(message)
-				}
+			c.logger.Error("client error receiving message", slog.Any("error", err), slog.String("address", cfg.Address))
+			continue
+		}
+		if err := receiver.Accept(ctx, amqpMsg); err != nil {
+			if errors.Is(err, ctx.Err()) {
+				return
 			}
+			c.logger.Error("client error accepting message", slog.Any("error", err), slog.String("address", cfg.Address))
+			continue
 		}
-	}(listenerCtx)
-	return nil
+		amqpMsg, selected := cfg.Filter.FilterMessage(amqpMsg)
+		if !selected {
+			continue
+		}
+		decoded, err := vanflow.Decode(amqpMsg)
+		if err != nil {
+			c.logger.Error("skipping message that could not be decoded", slog.Any("error", err))
+			continue
+		}
+		recordHandlers, heartbeatHandlers := c.getHandlers()
+		switch message := decoded.(type) {
+		case vanflow.RecordMessage:
+			for _, handler := range recordHandlers {
+				handler(message)
+			}
+		case vanflow.HeartbeatMessage:
+			for _, handler := range heartbeatHandlers {
+				handler(message)
+			}
+		}
+	}
 }
 
 // Close stops all listeners
@@ -160,6 +249,9 @@ func (c *Client) SendFlush(ctx context.Context) error {
 type ListenerConfig struct {
 	Address string
 	Credit  int
+	// Filter selects the records handled by the client. The records it
+	// does not select are dropped before being decoded.
+	Filter vanflow.RecordFilter
 }
 
 type ListenerConfigProvider interface {
@@ -187,3 +279,20 @@ func FromSourceAddressFlows() ListenerConfigProvider {
 func FromSourceAddressHeartbeats() ListenerConfigProvider {
 	return addresser(func(i Info) string { return i.Address + sourceSuffixHeartbeats })
 }
+
+type filtered struct {
+	attributes ListenerConfigProvider
+	filter     vanflow.RecordFilter
+}
+
+func (f filtered) Get(info Info) ListenerConfig {
+	cfg := f.attributes.Get(info)
+	cfg.Filter = f.filter
+	return cfg
+}
+
+// WithFilter has the records received from the listener dropped before being
+// decoded unless selected by filter.
+func WithFilter(attributes ListenerConfigProvider, filter vanflow.RecordFilter) ListenerConfigProvider {
+	return filtered{attributes: attributes, filter: filter}
+}
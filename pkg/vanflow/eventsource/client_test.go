@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -206,6 +207,87 @@ func TestClientFlush(t *testing.T) {
 
 }
 
+func TestClientSubscribe(t *testing.T) {
+	tstCtx, tstCancel := context.WithCancel(context.Background())
+	defer tstCancel()
+	factory, rtt := requireContainers(t)
+	ctr, tstCtr := factory.Create(), factory.Create()
+	ctr.Start(tstCtx)
+	tstCtr.Start(tstCtx)
+
+	sourceID := uniqueSuffix("test-subscribe")
+	filter := vanflow.RecordTypeFilter(vanflow.LinkRecord{})
+
+	t.Run("subscribes to sources accepting subscriptions", func(t *testing.T) {
+		source := Info{ID: sourceID, Address: mcsfe(sourceID), Direct: sfe(sourceID), Subscriptions: true}
+		receiver := tstCtr.NewReceiver(source.Direct, session.ReceiverOptions{})
+		defer receiver.Close(tstCtx)
+		client := NewClient(ctr, ClientOptions{Source: source})
+		defer client.Close()
+		records := make(chan vanflow.RecordMessage, 8)
+		client.OnRecord(func(m vanflow.RecordMessage) { records <- m })
+		assert.Check(t, client.Subscribe(tstCtx, filter))
+
+		msg, err := receiver.Next(tstCtx)
+		assert.Assert(t, err)
+		receiver.Accept(tstCtx, msg)
+		subscribe, err := vanflow.DecodeSubscribe(msg)
+		assert.Assert(t, err)
+		assert.DeepEqual(t, subscribe.Filter, filter)
+		assert.Equal(t, subscribe.Lease, subscriptionLease)
+		assert.Assert(t, strings.HasPrefix(subscribe.ReplyTo, source.Direct+"."))
+
+		record := vanflow.RecordMessage{
+			MessageProps: vanflow.MessageProps{To: subscribe.ReplyTo, Subject: "RECORD"},
+			Records:      []vanflow.Record{vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1")}},
+		}
+		encoded, err := record.Encode()
+		assert.Assert(t, err)
+		assert.Assert(t, tstCtr.NewSender(subscribe.ReplyTo, session.SenderOptions{}).Send(tstCtx, encoded))
+		select {
+		case actual := <-records:
+			assert.DeepEqual(t, actual, record)
+		case <-time.After(rtt * 100):
+			t.Error("expected record sent to the subscription address")
+		}
+	})
+
+	t.Run("filters records of other sources", func(t *testing.T) {
+		source := Info{ID: sourceID, Address: mcsfe(sourceID), Direct: sfe(sourceID)}
+		client := NewClient(ctr, ClientOptions{Source: source})
+		defer client.Close()
+		records := make(chan vanflow.RecordMessage, 8)
+		client.OnRecord(func(m vanflow.RecordMessage) { records <- m })
+		assert.Check(t, client.Subscribe(tstCtx, filter))
+
+		record := vanflow.RecordMessage{
+			MessageProps: vanflow.MessageProps{To: source.Address, Subject: "RECORD"},
+			Records: []vanflow.Record{
+				vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-1")},
+				vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1")},
+			},
+		}
+		sender := tstCtr.NewSender(source.Address, session.SenderOptions{})
+		for i := 0; i < 2; i++ {
+			encoded, err := record.Encode()
+			assert.Assert(t, err)
+			assert.Assert(t, sender.Send(tstCtx, encoded))
+			record.Records = record.Records[:1]
+		}
+		select {
+		case actual := <-records:
+			assert.DeepEqual(t, actual.Records, []vanflow.Record{vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1")}})
+		case <-time.After(rtt * 100):
+			t.Error("expected selected records")
+		}
+		select {
+		case actual := <-records:
+			t.Errorf("unexpected record message without selected records: %v", actual)
+		case <-time.After(rtt * 5):
+		}
+	})
+}
+
 func sendHeartbeatMessagesTo(t *testing.T, ctx context.Context, sender session.Sender) {
 	t.Helper()
 	for {
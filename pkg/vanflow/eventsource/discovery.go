@@ -278,6 +278,8 @@ func (d *Discovery) observe(beacon vanflow.BeaconMessage) {
 			Address:  beacon.Address,
 			Direct:   beacon.Direct,
 			LastSeen: tObserved,
+
+			Subscriptions: beacon.Subscriptions,
 		}
 		discovered = true
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
 	"time"
 
 	amqp "github.com/Azure/go-amqp"
@@ -47,24 +48,35 @@ type RecordUpdate struct {
 	Curr vanflow.Record
 }
 
+// Manager serves the records of an event source to its clients. Records
+// are sent to the source address, and to the subscribers selecting them.
 type Manager struct {
 	ManagerConfig
 	container session.Container
 
-	flushQueue  chan struct{}
-	changeQueue chan RecordUpdate
-	sendQueue   chan vanflow.RecordMessage
+	flushQueue     chan struct{}
+	subscribeQueue chan vanflow.SubscribeMessage
+	changeQueue    chan RecordUpdate
+	sendQueue      chan vanflow.RecordMessage
+
+	// subscriptions by address, only accessed by serve
+	subscriptions map[string]*subscription
+	// sourceOverflowed is set when records were dropped for the source
+	// address, until it is flushed again. Only accessed by serve.
+	sourceOverflowed bool
 
 	logger *slog.Logger
 }
 
 func NewManager(container session.Container, cfg ManagerConfig) *Manager {
 	return &Manager{
-		container:     container,
-		ManagerConfig: cfg,
-		flushQueue:    make(chan struct{}, 8),
-		changeQueue:   make(chan RecordUpdate, 256),
-		sendQueue:     make(chan vanflow.RecordMessage, 256),
+		container:      container,
+		ManagerConfig:  cfg,
+		flushQueue:     make(chan struct{}, 8),
+		subscribeQueue: make(chan vanflow.SubscribeMessage, 8),
+		changeQueue:    make(chan RecordUpdate, 256),
+		sendQueue:      make(chan vanflow.RecordMessage, 256),
+		subscriptions:  make(map[string]*subscription),
 		logger: slog.New(slog.Default().Handler()).With(
 			slog.String("component", "vanflow.eventsource.manager"),
 			slog.String("instance", cfg.Source.ID),
@@ -149,6 +161,8 @@ func (m *Manager) diffRecord(d RecordUpdate) (vanflow.Record, bool) {
 }
 
 func (m *Manager) serve(ctx context.Context) {
+	expiryTicker := time.NewTicker(subscriptionExpiryInterval)
+	defer expiryTicker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
@@ -167,23 +181,61 @@ func (m *Manager) serve(ctx context.Context) {
 
 			var record vanflow.RecordMessage
 			record.Records = make([]vanflow.Record, 0, len(buffer))
+			current := make([]vanflow.Record, 0, len(buffer))
 			for _, update := range buffer {
 				delta, changed := m.diffRecord(update)
 				if !changed {
 					continue
 				}
 				record.Records = append(record.Records, delta)
+				current = append(current, update.Curr)
 			}
 			if len(record.Records) == 0 {
 				m.logger.Debug("record changes buffered but none were changed", slog.Int("record_count", len(buffer)))
 				continue
 			}
+			for _, sub := range m.subscriptions {
+				sub.publish(record.Records, current)
+			}
+			if len(m.subscriptions) > 0 {
+				// subscribers are not held up by the source address. Records
+				// it misses are sent again by flushing it once it catches up.
+				if !m.sourceOverflowed && !m.trySend(record) {
+					m.logger.Info("source address is not keeping up, flushing it once it does")
+					m.sourceOverflowed = true
+				}
+				m.resumeSource()
+				continue
+			}
+			if m.sourceOverflowed {
+				if !m.flushSource(ctx) {
+					return
+				}
+				continue
+			}
 			select {
 			case <-ctx.Done():
 				return
 			case m.sendQueue <- record:
 			}
 
+		case subscribe := <-m.subscribeQueue:
+			m.subscribe(ctx, subscribe)
+
+		case now := <-expiryTicker.C:
+			for address, sub := range m.subscriptions {
+				if now.After(sub.expires) {
+					m.logger.Info("subscription expired", slog.String("address", address))
+					sub.cancel()
+					delete(m.subscriptions, address)
+				}
+			}
+			if len(m.subscriptions) > 0 {
+				m.resumeSource()
+			} else if m.sourceOverflowed && !m.flushSource(ctx) {
+				return
+			}
+
 		case <-m.flushQueue:
 			// handle a flush
 			if m.FlushDelay > 0 {
@@ -195,30 +247,103 @@ func (m *Manager) serve(ctx context.Context) {
 				}
 			}
 			m.logger.Info("servicing flush", slog.String("source", m.Source.ID))
-			for _, stor := range m.Stores {
-				entries := stor.List()
-
-				for len(entries) > 0 {
-					var batch []store.Entry
-					batch, entries = entries, entries[:0]
-					if len(batch) > m.FlushBatchSize && m.FlushBatchSize > 0 {
-						batch, entries = batch[:m.FlushBatchSize], batch[m.FlushBatchSize:]
-					}
-
-					var record vanflow.RecordMessage
-					record.Records = make([]vanflow.Record, len(batch))
-					for i, entry := range batch {
-						record.Records[i] = entry.Record
-					}
-					select {
-					case <-ctx.Done():
-						return
-					case m.sendQueue <- record:
-					}
-				}
+			if !m.flushSource(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// trySend queues a record message for the source address unless the queue
+// is full.
+func (m *Manager) trySend(record vanflow.RecordMessage) bool {
+	select {
+	case m.sendQueue <- record:
+		return true
+	default:
+		return false
+	}
+}
+
+// resumeSource flushes the source address once it has caught up after
+// records were dropped for it. Only records that fit in the send queue
+// are sent, otherwise it is tried again later.
+func (m *Manager) resumeSource() {
+	if !m.sourceOverflowed || len(m.sendQueue) > 0 {
+		return
+	}
+	for _, record := range m.flushMessages(nil) {
+		if !m.trySend(record) {
+			return
+		}
+	}
+	m.logger.Info("flushed source address after dropping records")
+	m.sourceOverflowed = false
+}
+
+// flushSource sends all records to the source address, returning false if
+// the context was cancelled first.
+func (m *Manager) flushSource(ctx context.Context) bool {
+	for _, record := range m.flushMessages(nil) {
+		select {
+		case <-ctx.Done():
+			return false
+		case m.sendQueue <- record:
+		}
+	}
+	m.sourceOverflowed = false
+	return true
+}
+
+// flushMessages returns record messages for all records held by the source
+// selected by filter.
+func (m *Manager) flushMessages(filter vanflow.RecordFilter) []vanflow.RecordMessage {
+	var messages []vanflow.RecordMessage
+	for _, stor := range m.Stores {
+		var records []vanflow.Record
+		for _, entry := range stor.List() {
+			if record, ok := filter.Match(entry.Record); ok {
+				records = append(records, record)
+			}
+		}
+
+		for len(records) > 0 {
+			var batch []vanflow.Record
+			batch, records = records, records[:0]
+			if len(batch) > m.FlushBatchSize && m.FlushBatchSize > 0 {
+				batch, records = batch[:m.FlushBatchSize], batch[m.FlushBatchSize:]
 			}
+			messages = append(messages, vanflow.RecordMessage{Records: batch})
 		}
 	}
+	return messages
+}
+
+func (m *Manager) subscribe(ctx context.Context, subscribe vanflow.SubscribeMessage) {
+	lease := subscribe.Lease
+	if lease <= 0 {
+		lease = subscriptionLease
+	}
+	sub, ok := m.subscriptions[subscribe.ReplyTo]
+	if ok && !slices.EqualFunc(sub.filter, subscribe.Filter, equalSelectors) {
+		// subscriptions are renewed with the same filter, start over
+		sub.cancel()
+		ok = false
+	}
+	if !ok {
+		m.logger.Info("new subscription",
+			slog.String("address", subscribe.ReplyTo),
+			slog.Int("selectors", len(subscribe.Filter)))
+		subCtx, cancel := context.WithCancel(ctx)
+		sub = newSubscription(m, subscribe.ReplyTo, subscribe.Filter, cancel)
+		m.subscriptions[subscribe.ReplyTo] = sub
+		go sub.run(subCtx)
+		sub.requestFlush()
+	} else if sub.overflowed.Swap(false) {
+		m.logger.Info("flushing subscription after dropping records", slog.String("address", subscribe.ReplyTo))
+		sub.requestFlush()
+	}
+	sub.expires = time.Now().Add(lease)
 }
 
 func (m *Manager) sendKeepalives(ctx context.Context) {
@@ -232,11 +357,12 @@ func (m *Manager) sendKeepalives(ctx context.Context) {
 	}
 
 	beaconMessage := vanflow.BeaconMessage{
-		Version:    uint32(m.Source.Version),
-		SourceType: m.Source.Type,
-		Address:    m.Source.Address,
-		Direct:     m.Source.Direct,
-		Identity:   m.Source.ID,
+		Version:       uint32(m.Source.Version),
+		SourceType:    m.Source.Type,
+		Address:       m.Source.Address,
+		Direct:        m.Source.Direct,
+		Identity:      m.Source.ID,
+		Subscriptions: true,
 	}
 	beaconMessage.To = beaconAddress
 	heartbeatMessage := vanflow.HeartbeatMessage{
@@ -322,6 +448,18 @@ func (m *Manager) listenFlushes(ctx context.Context) {
 			m.logger.Error("flush receive error", slog.Any("error", err))
 		}
 		flushReceiver.Accept(ctx, msg)
+		if msg != nil && msg.Properties != nil && msg.Properties.Subject != nil && *msg.Properties.Subject == "SUBSCRIBE" {
+			subscribe, err := vanflow.DecodeSubscribe(msg)
+			if err != nil {
+				m.logger.Error("skipping subscription that could not be decoded", slog.Any("error", err))
+				continue
+			}
+			select {
+			case m.subscribeQueue <- subscribe:
+			default: // drop subscription if queue is full, clients renew them
+			}
+			continue
+		}
 		select {
 		case m.flushQueue <- struct{}{}:
 		default: // drop flush if queue is full
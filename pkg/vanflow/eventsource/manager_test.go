@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
 	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
 	"gotest.tools/v3/poll"
 )
 
@@ -99,3 +102,242 @@ var ignoreLastUpdateAndOrder = []cmp.Option{
 		return strings.Compare(a.Record.Identity(), b.Record.Identity()) < 0
 	}),
 }
+
+func TestManagerSubscriptions(t *testing.T) {
+	tstCtx, tstCancel := context.WithCancel(context.Background())
+	defer tstCancel()
+
+	sourceID := uniqueSuffix("test-event-source-subscriptions")
+	factory, rtt := requireContainers(t)
+	ctr := factory.Create()
+	ctr.Start(tstCtx)
+	ctrClient := factory.Create()
+	ctrClient.Start(tstCtx)
+
+	sourceRef := store.SourceRef{ID: sourceID}
+	source := Info{ID: sourceID, Type: "test-event-source", Address: mcsfe(sourceID), Direct: sfe(sourceID), Subscriptions: true}
+	listenerStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	for i := 0; i < 8; i++ {
+		address := "backend"
+		if i%2 == 1 {
+			address = "frontend"
+		}
+		listenerStor.Add(vanflow.ListenerRecord{
+			BaseRecord: vanflow.NewBase(fmt.Sprintf("listener-%d", i)),
+			Name:       ptrTo(fmt.Sprintf("listener-%d", i)),
+			Address:    ptrTo(address),
+			DestPort:   ptrTo("8080"),
+		}, sourceRef)
+	}
+	manager := NewManager(ctr, ManagerConfig{
+		Source:            source,
+		Stores:            []store.Interface{listenerStor},
+		HeartbeatInterval: rtt * 10,
+		BeaconInterval:    rtt * 50,
+		FlushBatchSize:    3,
+	})
+	go manager.Run(tstCtx)
+
+	clientStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	listeners := RecordStoreRouter{
+		Source: sourceRef,
+		Stores: RecordStoreMap{vanflow.ListenerRecord{}.GetTypeMeta().String(): clientStor},
+	}
+	client := NewClient(ctrClient, ClientOptions{Source: source})
+	client.OnRecord(listeners.Route)
+	defer client.Close()
+	filter := vanflow.RecordFilter{
+		vanflow.MustRecordSelector(vanflow.ListenerRecord{Address: ptrTo("backend")}, "Name", "Address"),
+	}
+	assert.Check(t, client.Subscribe(tstCtx, filter))
+
+	expected := func() []store.Entry {
+		var entries []store.Entry
+		for _, entry := range listenerStor.List() {
+			if record, ok := filter.Match(entry.Record); ok {
+				entry.Record = record
+				entries = append(entries, entry)
+			}
+		}
+		return entries
+	}
+	t.Run("flushes selected records", func(t *testing.T) {
+		poll.WaitOn(t, func(t poll.LogT) poll.Result {
+			actual := clientStor.List()
+			if !cmp.Equal(actual, expected(), ignoreLastUpdateAndOrder...) {
+				return poll.Continue("waiting for selected records to be synced: %s", cmp.Diff(actual, expected(), ignoreLastUpdateAndOrder...))
+			}
+			return poll.Success()
+		}, poll.WithDelay(rtt), poll.WithTimeout(300*rtt))
+		assert.Equal(t, len(clientStor.List()), 4)
+	})
+
+	t.Run("publishes selected updates", func(t *testing.T) {
+		for _, id := range []string{"listener-1", "listener-2"} {
+			prevEntry, _ := listenerStor.Get(id)
+			updated := prevEntry.Record.(vanflow.ListenerRecord)
+			updated.Name = ptrTo(id + "-updated")
+			updated.DestPort = ptrTo("9090")
+			listenerStor.Update(updated)
+			manager.PublishUpdate(RecordUpdate{Prev: prevEntry.Record, Curr: updated})
+		}
+		poll.WaitOn(t, func(t poll.LogT) poll.Result {
+			actual, _ := clientStor.Get("listener-2")
+			expected := vanflow.ListenerRecord{
+				BaseRecord: vanflow.NewBase("listener-2"),
+				Name:       ptrTo("listener-2-updated"),
+				Address:    ptrTo("backend"),
+			}
+			if !cmp.Equal(actual.Record, expected) {
+				return poll.Continue("waiting for record 'listener-2' to be updated: %s", cmp.Diff(actual.Record, expected))
+			}
+			return poll.Success()
+		}, poll.WithDelay(rtt), poll.WithTimeout(100*rtt))
+		_, ok := clientStor.Get("listener-1")
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("subscriptions expire", func(t *testing.T) {
+		sender := ctrClient.NewSender(source.Direct, session.SenderOptions{})
+		defer sender.Close(tstCtx)
+		subscribe := vanflow.SubscribeMessage{
+			MessageProps: vanflow.MessageProps{To: source.Direct, ReplyTo: source.Direct + ".expiring"},
+			Filter:       filter,
+			Lease:        rtt,
+		}
+		receiver := ctrClient.NewReceiver(subscribe.ReplyTo, session.ReceiverOptions{})
+		defer receiver.Close(tstCtx)
+		assert.Check(t, sender.Send(tstCtx, subscribe.Encode()))
+		msg, err := receiver.Next(tstCtx)
+		assert.Check(t, err)
+		assert.Check(t, receiver.Accept(tstCtx, msg))
+
+		// publish an update once the subscription has expired
+		time.Sleep(subscriptionExpiryInterval + 2*rtt)
+		prevEntry, _ := listenerStor.Get("listener-0")
+		updated := prevEntry.Record.(vanflow.ListenerRecord)
+		updated.Name = ptrTo("listener-0-updated")
+		listenerStor.Update(updated)
+		manager.PublishUpdate(RecordUpdate{Prev: prevEntry.Record, Curr: updated})
+		poll.WaitOn(t, func(t poll.LogT) poll.Result {
+			actual, _ := clientStor.Get("listener-0")
+			if actual.Record.(vanflow.ListenerRecord).Name != nil && *actual.Record.(vanflow.ListenerRecord).Name == "listener-0-updated" {
+				return poll.Success()
+			}
+			return poll.Continue("waiting for record 'listener-0' to be updated")
+		}, poll.WithDelay(rtt), poll.WithTimeout(100*rtt))
+
+		nextCtx, cancel := context.WithTimeout(tstCtx, 10*rtt)
+		defer cancel()
+		for {
+			msg, err := receiver.Next(nextCtx)
+			if err != nil {
+				break
+			}
+			receiver.Accept(tstCtx, msg)
+			record, err := vanflow.DecodeRecord(msg)
+			assert.Check(t, err)
+			for _, r := range record.Records {
+				assert.Check(t, r.(vanflow.ListenerRecord).Name == nil || *r.(vanflow.ListenerRecord).Name != "listener-0-updated",
+					"expired subscription received update")
+			}
+		}
+	})
+}
+
+func TestManagerListenerAndSubscriber(t *testing.T) {
+	tstCtx, tstCancel := context.WithCancel(context.Background())
+	defer tstCancel()
+
+	sourceID := uniqueSuffix("test-event-source-listener-and-subscriber")
+	factory, rtt := requireContainers(t)
+	ctr := factory.Create()
+	ctr.Start(tstCtx)
+	ctrListener := factory.Create()
+	ctrListener.Start(tstCtx)
+	ctrSubscriber := factory.Create()
+	ctrSubscriber.Start(tstCtx)
+
+	discovery := NewDiscovery(ctrListener, DiscoveryOptions{})
+	go discovery.Run(tstCtx, DiscoveryHandlers{})
+
+	sourceRef := store.SourceRef{ID: sourceID}
+	source := Info{ID: sourceID, Type: "test-event-source", Address: mcsfe(sourceID), Direct: sfe(sourceID), Subscriptions: true}
+	listenerStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	for i := 0; i < 8; i++ {
+		address := "backend"
+		if i%2 == 1 {
+			address = "frontend"
+		}
+		listenerStor.Add(vanflow.ListenerRecord{
+			BaseRecord: vanflow.NewBase(fmt.Sprintf("listener-%d", i)),
+			Name:       ptrTo(fmt.Sprintf("listener-%d", i)),
+			Address:    ptrTo(address),
+		}, sourceRef)
+	}
+	manager := NewManager(ctr, ManagerConfig{
+		Source:            source,
+		Stores:            []store.Interface{listenerStor},
+		HeartbeatInterval: rtt * 10,
+		BeaconInterval:    rtt * 50,
+	})
+	go manager.Run(tstCtx)
+
+	newClient := func(ctr session.Container) (*Client, store.Interface) {
+		clientStor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+		router := RecordStoreRouter{
+			Source: sourceRef,
+			Stores: RecordStoreMap{vanflow.ListenerRecord{}.GetTypeMeta().String(): clientStor},
+		}
+		client := NewClient(ctr, ClientOptions{Source: source})
+		client.OnRecord(router.Route)
+		return client, clientStor
+	}
+	listener, listenerClientStor := newClient(ctrListener)
+	defer listener.Close()
+	listener.Listen(tstCtx, FromSourceAddress())
+	flushCtx, cancel := context.WithTimeout(tstCtx, rtt*100)
+	defer cancel()
+	assert.Assert(t, FlushOnFirstMessage(flushCtx, listener))
+
+	subscriber, subscriberStor := newClient(ctrSubscriber)
+	defer subscriber.Close()
+	filter := vanflow.RecordFilter{
+		vanflow.MustRecordSelector(vanflow.ListenerRecord{Address: ptrTo("backend")}, "Name", "Address"),
+	}
+	assert.Assert(t, subscriber.Subscribe(tstCtx, filter))
+
+	// publish more updates than can be queued for the source address
+	for i := 0; i < 1024; i++ {
+		id := fmt.Sprintf("listener-%d", i%8)
+		prevEntry, _ := listenerStor.Get(id)
+		updated := prevEntry.Record.(vanflow.ListenerRecord)
+		updated.Name = ptrTo(fmt.Sprintf("%s-%d", id, i))
+		listenerStor.Update(updated)
+		manager.PublishUpdate(RecordUpdate{Prev: prevEntry.Record, Curr: updated})
+	}
+
+	selected := func() []store.Entry {
+		var entries []store.Entry
+		for _, entry := range listenerStor.List() {
+			if record, ok := filter.Match(entry.Record); ok {
+				entry.Record = record
+				entries = append(entries, entry)
+			}
+		}
+		return entries
+	}
+	poll.WaitOn(t, func(t poll.LogT) poll.Result {
+		actual := listenerClientStor.List()
+		if !cmp.Equal(actual, listenerStor.List(), ignoreLastUpdateAndOrder...) {
+			return poll.Continue("waiting for listener to receive all updates: %s", cmp.Diff(actual, listenerStor.List(), ignoreLastUpdateAndOrder...))
+		}
+		actual = subscriberStor.List()
+		if !cmp.Equal(actual, selected(), ignoreLastUpdateAndOrder...) {
+			return poll.Continue("waiting for subscriber to receive selected updates: %s", cmp.Diff(actual, selected(), ignoreLastUpdateAndOrder...))
+		}
+		return poll.Success()
+	}, poll.WithDelay(rtt), poll.WithTimeout(500*rtt))
+}
+
+func ptrTo[T any](obj T) *T { return &obj }
@@ -12,4 +12,7 @@ type Info struct {
 	Address  string
 	Direct   string
 	LastSeen time.Time
+	// Subscriptions is set when the source accepts subscriptions to a
+	// subset of its records
+	Subscriptions bool
 }
@@ -0,0 +1,126 @@
+package eventsource
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+)
+
+const (
+	// subscriptionLease is how long subscriptions last unless renewed
+	subscriptionLease          = 30 * time.Second
+	subscriptionExpiryInterval = time.Second
+	subscriptionSendTimeout    = 5 * time.Second
+)
+
+// subscription sends the records of a Manager selected by a client to the
+// address of the subscription, apart from those sent to the source address.
+type subscription struct {
+	manager *Manager
+	address string
+	filter  vanflow.RecordFilter
+	cancel  context.CancelFunc
+
+	// expires is only accessed by the manager
+	expires time.Time
+	// overflowed is set when updates were dropped, until the subscription
+	// is flushed again
+	overflowed atomic.Bool
+
+	flush   chan struct{}
+	updates chan vanflow.RecordMessage
+	logger  *slog.Logger
+}
+
+func newSubscription(m *Manager, address string, filter vanflow.RecordFilter, cancel context.CancelFunc) *subscription {
+	return &subscription{
+		manager: m,
+		address: address,
+		filter:  filter,
+		cancel:  cancel,
+		flush:   make(chan struct{}, 1),
+		updates: make(chan vanflow.RecordMessage, 256),
+		logger:  m.logger.With(slog.String("subscription", address)),
+	}
+}
+
+func (s *subscription) run(ctx context.Context) {
+	sender := s.manager.container.NewSender(s.address, session.SenderOptions{})
+	defer sender.Close(context.Background())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.flush:
+			for _, record := range s.manager.flushMessages(s.filter) {
+				s.send(ctx, sender, record)
+			}
+		case record := <-s.updates:
+			s.send(ctx, sender, record)
+		}
+	}
+}
+
+func (s *subscription) send(ctx context.Context, sender session.Sender, record vanflow.RecordMessage) {
+	record.To = s.address
+	msg, err := record.Encode()
+	if err != nil {
+		s.logger.Error("skipping record message after encoding error:", slog.Any("error", err))
+		return
+	}
+	if err := sendWithTimeout(ctx, subscriptionSendTimeout, sender, msg); err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("error sending subscription record", slog.Any("error", err))
+		}
+	}
+}
+
+func (s *subscription) requestFlush() {
+	select {
+	case s.flush <- struct{}{}:
+	default: // already pending
+	}
+}
+
+// publish queues the record updates selected by the subscription. Records
+// are selected by their current state, and only the updated attributes of
+// those selected get sent.
+func (s *subscription) publish(deltas []vanflow.Record, current []vanflow.Record) {
+	var record vanflow.RecordMessage
+	for i, curr := range current {
+		selector, ok := s.filter.Selector(curr)
+		if !ok {
+			continue
+		}
+		delta, err := selector.Project(deltas[i])
+		if err != nil {
+			s.logger.Error("skipping record update that could not be projected", slog.Any("error", err))
+			continue
+		}
+		record.Records = append(record.Records, delta)
+	}
+	if len(record.Records) == 0 {
+		return
+	}
+	select {
+	case s.updates <- record:
+	default:
+		// the subscription gets flushed once renewed, rather than holding
+		// up the other clients of the manager
+		if !s.overflowed.Swap(true) {
+			s.logger.Info("dropping record updates for subscription that is not keeping up")
+		}
+	}
+}
+
+func equalSelectors(a, b vanflow.RecordSelector) bool {
+	return a.Type == b.Type &&
+		maps.Equal(a.Match, b.Match) &&
+		slices.Equal(a.Projection, b.Projection)
+}
@@ -0,0 +1,198 @@
+package vanflow
+
+import (
+	"fmt"
+
+	amqp "github.com/Azure/go-amqp"
+	"github.com/skupperproject/skupper/pkg/vanflow/encoding"
+)
+
+const (
+	attributeTypeOfRecord = uint32(0)
+	attributeIdentity     = uint32(1)
+)
+
+// RecordSelector selects records of a single type by the values of their
+// attributes, and optionally projects them onto a subset of their
+// attributes.
+type RecordSelector struct {
+	// Type is the codepoint of the record type selected
+	Type uint32
+	// Match holds the values selected records have for each attribute
+	// codepoint
+	Match map[uint32]any
+	// Projection lists the codepoints of the attributes selected records
+	// are reduced to. The type and identity of records are always kept.
+	// Records keep all of their attributes when empty.
+	Projection []uint32
+}
+
+// NewRecordSelector returns a selector for the records of the same type as
+// example, which have all the attributes set on example with the same
+// values. The records selected are reduced to the fields of the record type
+// named by project, if any.
+//
+// For example, the transport biflows of a listener reduced to their octet
+// counts are selected by:
+//
+//	NewRecordSelector(TransportBiflowRecord{Parent: &listenerID}, "Octets", "OctetsReverse")
+func NewRecordSelector(example Record, project ...string) (RecordSelector, error) {
+	attrs, err := encoding.EncodePartial(example)
+	if err != nil {
+		return RecordSelector{}, err
+	}
+	selector := RecordSelector{
+		Type:  attrs[attributeTypeOfRecord].(uint32),
+		Match: make(map[uint32]any, len(attrs)-1),
+	}
+	for key, value := range attrs {
+		if codepoint := key.(uint32); codepoint != attributeTypeOfRecord {
+			selector.Match[codepoint] = value
+		}
+	}
+	if len(project) > 0 {
+		selector.Projection, err = encoding.Codepoints(example, project...)
+		if err != nil {
+			return RecordSelector{}, err
+		}
+	}
+	return selector, nil
+}
+
+// MustRecordSelector is like NewRecordSelector but panics when the example
+// or projection is invalid.
+func MustRecordSelector(example Record, project ...string) RecordSelector {
+	selector, err := NewRecordSelector(example, project...)
+	if err != nil {
+		panic(fmt.Sprintf("invalid record selector: %s", err))
+	}
+	return selector
+}
+
+func (s RecordSelector) selects(attrs encoding.RecordAttributeSet) bool {
+	if codepoint, ok := attrs[attributeTypeOfRecord].(uint32); !ok || codepoint != s.Type {
+		return false
+	}
+	for codepoint, value := range s.Match {
+		if attrs[codepoint] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (s RecordSelector) project(attrs encoding.RecordAttributeSet) encoding.RecordAttributeSet {
+	if len(s.Projection) == 0 {
+		return attrs
+	}
+	projected := make(encoding.RecordAttributeSet, len(s.Projection)+2)
+	keep := func(codepoint uint32) {
+		if value, ok := attrs[codepoint]; ok {
+			projected[codepoint] = value
+		}
+	}
+	keep(attributeTypeOfRecord)
+	keep(attributeIdentity)
+	for _, codepoint := range s.Projection {
+		keep(codepoint)
+	}
+	return projected
+}
+
+// Project reduces record to the attributes listed by the selector
+func (s RecordSelector) Project(record Record) (Record, error) {
+	if len(s.Projection) == 0 {
+		return record, nil
+	}
+	attrs, err := encoding.EncodePartial(record)
+	if err != nil {
+		return nil, err
+	}
+	projected, err := encoding.Decode(s.project(attrs))
+	if err != nil {
+		return nil, err
+	}
+	return projected.(Record), nil
+}
+
+// RecordFilter selects the records selected by any of its selectors. An
+// empty filter selects all records.
+type RecordFilter []RecordSelector
+
+// RecordTypeFilter returns a filter selecting all records of the same types
+// as records.
+func RecordTypeFilter(records ...Record) RecordFilter {
+	filter := make(RecordFilter, 0, len(records))
+	for _, record := range records {
+		filter = append(filter, MustRecordSelector(record))
+	}
+	return filter
+}
+
+// Selector returns the first selector of the filter selecting record. The
+// record is evaluated against all of its attributes, whether or not they are
+// set on a record update.
+func (f RecordFilter) Selector(record Record) (RecordSelector, bool) {
+	if len(f) == 0 {
+		return RecordSelector{}, true
+	}
+	attrs, err := encoding.EncodePartial(record)
+	if err != nil {
+		return RecordSelector{}, false
+	}
+	return f.selector(attrs)
+}
+
+func (f RecordFilter) selector(attrs encoding.RecordAttributeSet) (RecordSelector, bool) {
+	if len(f) == 0 {
+		return RecordSelector{}, true
+	}
+	for _, selector := range f {
+		if selector.selects(attrs) {
+			return selector, true
+		}
+	}
+	return RecordSelector{}, false
+}
+
+// Match returns record projected by the first selector of the filter
+// selecting it, if any.
+func (f RecordFilter) Match(record Record) (Record, bool) {
+	selector, ok := f.Selector(record)
+	if !ok {
+		return nil, false
+	}
+	projected, err := selector.Project(record)
+	if err != nil {
+		return nil, false
+	}
+	return projected, true
+}
+
+// FilterMessage returns a copy of a raw record message without the records
+// the filter does not select, and with the others projected, so that they
+// do not need to be decoded. It reports whether any record remains. Messages
+// other than record messages are returned as is.
+func (f RecordFilter) FilterMessage(msg *amqp.Message) (*amqp.Message, bool) {
+	if len(f) == 0 || msg == nil || msg.Properties == nil || msg.Properties.Subject == nil || *msg.Properties.Subject != recordSubject {
+		return msg, true
+	}
+	values, ok := msg.Value.([]interface{})
+	if !ok {
+		// leave it to the decoder to report
+		return msg, true
+	}
+	var selected []interface{}
+	for _, value := range values {
+		attrs, ok := value.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		if selector, ok := f.selector(attrs); ok {
+			selected = append(selected, selector.project(attrs))
+		}
+	}
+	filtered := *msg
+	filtered.Value = selected
+	return &filtered, len(selected) > 0
+}
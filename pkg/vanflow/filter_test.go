@@ -0,0 +1,110 @@
+package vanflow
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRecordFilter(t *testing.T) {
+	octets := uint64(1024)
+	biflow := func(id string, parent string) TransportBiflowRecord {
+		return TransportBiflowRecord{
+			BaseRecord:    NewBase(id),
+			Parent:        ptrTo(parent),
+			SourceHost:    ptrTo("10.0.0.1"),
+			Octets:        &octets,
+			OctetsReverse: &octets,
+		}
+	}
+	filter := RecordFilter{
+		MustRecordSelector(SiteRecord{}),
+		MustRecordSelector(TransportBiflowRecord{Parent: ptrTo("listener-1")}, "Octets"),
+	}
+
+	testCases := []struct {
+		Name     string
+		Filter   RecordFilter
+		In       Record
+		Out      Record
+		Selected bool
+	}{
+		{
+			Name:     "empty filter",
+			In:       LinkRecord{BaseRecord: NewBase("link-1")},
+			Out:      LinkRecord{BaseRecord: NewBase("link-1")},
+			Selected: true,
+		}, {
+			Name:     "record type",
+			Filter:   filter,
+			In:       SiteRecord{BaseRecord: NewBase("site-1"), Name: ptrTo("west")},
+			Out:      SiteRecord{BaseRecord: NewBase("site-1"), Name: ptrTo("west")},
+			Selected: true,
+		}, {
+			Name:   "other record type",
+			Filter: filter,
+			In:     LinkRecord{BaseRecord: NewBase("link-1")},
+		}, {
+			Name:     "attribute value projected",
+			Filter:   filter,
+			In:       biflow("biflow-1", "listener-1"),
+			Out:      TransportBiflowRecord{BaseRecord: NewBase("biflow-1"), Octets: &octets},
+			Selected: true,
+		}, {
+			Name:   "other attribute value",
+			Filter: filter,
+			In:     biflow("biflow-2", "listener-2"),
+		}, {
+			Name:   "attribute not set",
+			Filter: filter,
+			In:     TransportBiflowRecord{BaseRecord: NewBase("biflow-3")},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, selected := tc.Filter.Match(tc.In)
+			assert.Equal(t, selected, tc.Selected)
+			assert.DeepEqual(t, actual, tc.Out)
+
+			record := RecordMessage{Records: []Record{tc.In}}
+			msg, err := record.Encode()
+			assert.Check(t, err)
+			filtered, selected := tc.Filter.FilterMessage(msg)
+			assert.Equal(t, selected, tc.Selected)
+			if selected {
+				decoded, err := DecodeRecord(filtered)
+				assert.Check(t, err)
+				assert.DeepEqual(t, decoded.Records, []Record{tc.Out})
+			}
+			// the original message is left untouched
+			decoded, err := DecodeRecord(msg)
+			assert.Check(t, err)
+			assert.DeepEqual(t, decoded.Records, []Record{tc.In})
+		})
+	}
+
+	t.Run("other messages", func(t *testing.T) {
+		msg := HeartbeatMessage{Identity: "source"}.Encode()
+		filtered, selected := filter.FilterMessage(msg)
+		assert.Assert(t, selected)
+		assert.Equal(t, filtered, msg)
+	})
+}
+
+func TestNewRecordSelector(t *testing.T) {
+	selector, err := NewRecordSelector(ListenerRecord{Address: ptrTo("backend")}, "Name", "Address")
+	assert.Check(t, err)
+	assert.DeepEqual(t, selector, RecordSelector{
+		Type:       4,
+		Match:      map[uint32]any{19: "backend"},
+		Projection: []uint32{30, 19},
+	})
+
+	_, err = NewRecordSelector(ListenerRecord{}, "Missing")
+	assert.ErrorContains(t, err, `no vflow attribute field "Missing"`)
+
+	assert.DeepEqual(t, RecordTypeFilter(SiteRecord{}, LinkRecord{}), RecordFilter{
+		{Type: 0, Match: map[uint32]any{}},
+		{Type: 2, Match: map[uint32]any{}},
+	})
+}
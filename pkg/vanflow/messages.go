@@ -3,6 +3,7 @@ package vanflow
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	amqp "github.com/Azure/go-amqp"
 	"github.com/skupperproject/skupper/pkg/vanflow/encoding"
@@ -14,10 +15,11 @@ var (
 	heartbeatSubject = "HEARTBEAT"
 	flushSubject     = "FLUSH"
 	recordSubject    = "RECORD"
+	subscribeSubject = "SUBSCRIBE"
 )
 
 // Decode a raw amqp message into one of BeaconMessage, FlushMessage,
-// HeartbeatMessage or RecordMessage.
+// HeartbeatMessage, RecordMessage or SubscribeMessage.
 func Decode(msg *amqp.Message) (interface{}, error) {
 	if msg == nil || msg.Properties == nil {
 		return nil, errors.New("cannot decode message with nil properties")
@@ -35,6 +37,8 @@ func Decode(msg *amqp.Message) (interface{}, error) {
 		return DecodeFlush(msg), nil
 	case "RECORD":
 		return DecodeRecord(msg)
+	case "SUBSCRIBE":
+		return DecodeSubscribe(msg)
 	default:
 		return nil, fmt.Errorf("cannot decode message with subject %q", subject)
 	}
@@ -47,6 +51,8 @@ type BeaconMessage struct {
 	Address    string
 	Direct     string
 	Identity   string
+	// Subscriptions is set by event sources that accept SubscribeMessages
+	Subscriptions bool
 }
 
 func DecodeBeacon(msg *amqp.Message) BeaconMessage {
@@ -75,11 +81,14 @@ func DecodeBeacon(msg *amqp.Message) BeaconMessage {
 	if identity, ok := msg.ApplicationProperties["id"].(string); ok {
 		m.Identity = identity
 	}
+	if subscriptions, ok := msg.ApplicationProperties["subscriptions"].(bool); ok {
+		m.Subscriptions = subscriptions
+	}
 	return m
 }
 
 func (m BeaconMessage) Encode() *amqp.Message {
-	return &amqp.Message{
+	msg := &amqp.Message{
 		Properties: &amqp.MessageProperties{
 			To:      &beaconAddress,
 			Subject: &beaconSubject,
@@ -92,6 +101,10 @@ func (m BeaconMessage) Encode() *amqp.Message {
 			"id":         m.Identity,
 		},
 	}
+	if m.Subscriptions {
+		msg.ApplicationProperties["subscriptions"] = true
+	}
+	return msg
 }
 
 type MessageProps struct {
@@ -171,6 +184,116 @@ func (m FlushMessage) Encode() *amqp.Message {
 	}
 }
 
+// SubscribeMessage is sent by a client to the direct address of an event
+// source to have the records selected by Filter sent to its ReplyTo
+// address, instead of listening to all records of the source. Subscriptions
+// expire unless renewed by a new SubscribeMessage before Lease has elapsed.
+type SubscribeMessage struct {
+	MessageProps
+	Filter RecordFilter
+	Lease  time.Duration
+}
+
+func DecodeSubscribe(msg *amqp.Message) (SubscribeMessage, error) {
+	var m SubscribeMessage
+	if msg.Properties.To != nil {
+		m.To = *msg.Properties.To
+	}
+	if msg.Properties.Subject != nil {
+		m.Subject = *msg.Properties.Subject
+	}
+	if msg.Properties.ReplyTo != nil {
+		m.ReplyTo = *msg.Properties.ReplyTo
+	}
+	if m.ReplyTo == "" {
+		return m, errors.New("subscribe message without reply-to address")
+	}
+	if lease, ok := msg.ApplicationProperties["lease"].(uint64); ok {
+		m.Lease = time.Duration(lease) * time.Millisecond
+	}
+	if msg.Value == nil {
+		return m, nil
+	}
+	values, ok := msg.Value.([]interface{})
+	if !ok {
+		return m, fmt.Errorf("unexpected type for subscribe message Value: %T", msg.Value)
+	}
+	for _, value := range values {
+		selector, err := decodeSelector(value)
+		if err != nil {
+			return m, err
+		}
+		m.Filter = append(m.Filter, selector)
+	}
+	return m, nil
+}
+
+func (m SubscribeMessage) Encode() *amqp.Message {
+	selectors := make([]interface{}, 0, len(m.Filter))
+	for _, selector := range m.Filter {
+		match := make(map[interface{}]interface{}, len(selector.Match))
+		for codepoint, value := range selector.Match {
+			match[codepoint] = value
+		}
+		encoded := map[string]interface{}{
+			"type":  selector.Type,
+			"match": match,
+		}
+		if len(selector.Projection) > 0 {
+			encoded["projection"] = selector.Projection
+		}
+		selectors = append(selectors, encoded)
+	}
+	return &amqp.Message{
+		Properties: &amqp.MessageProperties{
+			To:      &m.To,
+			ReplyTo: &m.ReplyTo,
+			Subject: &subscribeSubject,
+		},
+		ApplicationProperties: map[string]interface{}{
+			"lease": uint64(m.Lease.Milliseconds()),
+		},
+		Value: selectors,
+	}
+}
+
+func decodeSelector(value interface{}) (RecordSelector, error) {
+	var selector RecordSelector
+	encoded, ok := value.(map[string]interface{})
+	if !ok {
+		return selector, fmt.Errorf("unexpected type for record selector: %T", value)
+	}
+	if selector.Type, ok = encoded["type"].(uint32); !ok {
+		return selector, fmt.Errorf("unexpected type for record selector type: %T", encoded["type"])
+	}
+	selector.Match = make(map[uint32]any)
+	if match, ok := encoded["match"].(map[interface{}]interface{}); ok {
+		for key, value := range match {
+			codepoint, ok := key.(uint32)
+			if !ok {
+				return selector, fmt.Errorf("unexpected type for record selector attribute: %T", key)
+			}
+			selector.Match[codepoint] = value
+		}
+	}
+	switch projection := encoded["projection"].(type) {
+	case nil:
+	case []uint32:
+		selector.Projection = projection
+	case []interface{}:
+		for _, item := range projection {
+			codepoint, ok := item.(uint32)
+			if !ok {
+				return selector, fmt.Errorf("unexpected type for record selector projection: %T", item)
+			}
+			selector.Projection = append(selector.Projection, codepoint)
+		}
+	default:
+		return selector, fmt.Errorf("unexpected type for record selector projection: %T", projection)
+	}
+	return selector, nil
+}
+
 type RecordMessage struct {
 	MessageProps
 	Records []Record
@@ -122,6 +122,11 @@ func TestBeaconMessage(t *testing.T) {
 	dup := DecodeBeacon(msg)
 	dup.MessageProps = MessageProps{}
 	assert.DeepEqual(t, original, dup)
+
+	original.Subscriptions = true
+	dup = DecodeBeacon(original.Encode())
+	dup.MessageProps = MessageProps{}
+	assert.DeepEqual(t, original, dup)
 }
 
 func TestHeartbeatMessage(t *testing.T) {
@@ -185,4 +190,36 @@ func TestRecordMessage(t *testing.T) {
 	assert.DeepEqual(t, original, dupe)
 }
 
+func TestSubscribeMessage(t *testing.T) {
+	original := SubscribeMessage{
+		MessageProps: MessageProps{
+			To:      "sfe.source",
+			Subject: "ignored",
+			ReplyTo: "sfe.source.subscriber",
+		},
+		Filter: RecordFilter{
+			MustRecordSelector(LinkRecord{}),
+			MustRecordSelector(TransportBiflowRecord{Parent: ptrTo("listener-1")}, "Octets", "OctetsReverse"),
+		},
+		Lease: 30 * time.Second,
+	}
+	msgPropsExpected := MessageProps{
+		To:      "sfe.source",
+		Subject: "SUBSCRIBE",
+		ReplyTo: "sfe.source.subscriber",
+	}
+	// encode to the wire and back
+	data, err := original.Encode().MarshalBinary()
+	assert.Check(t, err)
+	var msg amqp.Message
+	assert.Check(t, msg.UnmarshalBinary(data))
+	decoded, err := Decode(&msg)
+	assert.Check(t, err)
+	original.MessageProps = msgPropsExpected
+	assert.DeepEqual(t, original, decoded)
+
+	_, err = DecodeSubscribe(SubscribeMessage{}.Encode())
+	assert.ErrorContains(t, err, "without reply-to address")
+}
+
 func ptrTo[T any](obj T) *T { return &obj }
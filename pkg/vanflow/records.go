@@ -278,6 +278,10 @@ type TransportBiflowRecord struct {
 	ProxyHost      *string `vflow:"62"`
 	ProxyPort      *string `vflow:"63"`
 
+	OctetsUnacked  *uint64 `vflow:"37"`
+	WindowClosures *uint64 `vflow:"38"`
+	WindowSize     *uint64 `vflow:"39"`
+
 	ErrorListener  *string `vflow:"64"`
 	ErrorConnector *string `vflow:"65"`
 }
@@ -22,6 +22,12 @@ func NewMockContainerFactory() ContainerFactory {
 	return mockFactory{Router: NewMockRouter()}
 }
 
+// NewMockRouterContainerFactory creates mock containers connected to the
+// given mock router, so that the caller can exchange messages with them.
+func NewMockRouterContainerFactory(router *MockRouter) ContainerFactory {
+	return mockFactory{Router: router}
+}
+
 type mockFactory struct {
 	Router *MockRouter
 }